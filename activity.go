@@ -0,0 +1,93 @@
+package goroyale
+
+import (
+	"sort"
+	"time"
+)
+
+// ActivityWeights controls how much each signal counts towards an ActivityScore.
+// Weights don't need to add up to 1, scores are divided by their total.
+type ActivityWeights struct {
+	Donations float64
+	War       float64
+	Recency   float64
+	// InactiveAfter is how long without a battle before Recency drops to 0.
+	InactiveAfter time.Duration
+}
+
+// DefaultActivityWeights are the weights used by ScoreClanMembers.
+var DefaultActivityWeights = ActivityWeights{
+	Donations:     1,
+	War:           1,
+	Recency:       1,
+	InactiveAfter: 7 * 24 * time.Hour,
+}
+
+// ActivityScore is how active a clan member has been. Every field but LastSeen is 0-1.
+type ActivityScore struct {
+	Tag  string
+	Name string
+	Role string
+
+	Score     float64
+	Donations float64 // donations compared to the clan's top donator
+	War       float64 // fraction of the wars in the war log the member took part in
+	Recency   float64 // 1 if they just played a battle, falling to 0 at InactiveAfter
+	LastSeen  time.Time
+}
+
+// ScoreClanMembers scores every member of clan using DefaultActivityWeights, least active first.
+// battles maps player tags to their battle logs, members without one are treated as never seen.
+func ScoreClanMembers(clan Clan, warlog []ClanWarLogEntry, battles map[string][]Battle) []ActivityScore {
+	return DefaultActivityWeights.ScoreClanMembers(clan, warlog, battles)
+}
+
+// ScoreClanMembers works like the function of the same name but with weights w.
+func (w ActivityWeights) ScoreClanMembers(clan Clan, warlog []ClanWarLogEntry, battles map[string][]Battle) []ActivityScore {
+	return w.ScoreClanMembersAt(clan, warlog, battles, time.Now())
+}
+
+// ScoreClanMembersAt works like ScoreClanMembers but scores recency as of now, ex: a Client's Clock.
+func (w ActivityWeights) ScoreClanMembersAt(clan Clan, warlog []ClanWarLogEntry, battles map[string][]Battle, now time.Time) []ActivityScore {
+	var topDonations int
+	for _, m := range clan.Members {
+		if m.Donations > topDonations {
+			topDonations = m.Donations
+		}
+	}
+
+	wars := make(map[string]int)
+	for _, war := range warlog {
+		for _, p := range war.Participants {
+			wars[p.Tag]++
+		}
+	}
+
+	total := w.Donations + w.War + w.Recency
+	scores := make([]ActivityScore, 0, len(clan.Members))
+	for _, m := range clan.Members {
+		s := ActivityScore{Tag: m.Tag, Name: m.Name, Role: m.Role}
+		if topDonations > 0 {
+			s.Donations = float64(m.Donations) / float64(topDonations)
+		}
+		if len(warlog) > 0 {
+			s.War = float64(wars[m.Tag]) / float64(len(warlog))
+		}
+		s.LastSeen = lastBattle(battles[m.Tag])
+		if !s.LastSeen.IsZero() && w.InactiveAfter > 0 {
+			s.Recency = 1 - float64(now.Sub(s.LastSeen))/float64(w.InactiveAfter)
+			if s.Recency < 0 {
+				s.Recency = 0
+			} else if s.Recency > 1 {
+				s.Recency = 1
+			}
+		}
+		if total > 0 {
+			s.Score = (s.Donations*w.Donations + s.War*w.War + s.Recency*w.Recency) / total
+		}
+		scores = append(scores, s)
+	}
+
+	sort.SliceStable(scores, func(i, j int) bool { return scores[i].Score < scores[j].Score })
+	return scores
+}
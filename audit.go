@@ -0,0 +1,79 @@
+package goroyale
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/url"
+	"sync"
+	"time"
+)
+
+// AuditRecord describes a request sent to the API.
+type AuditRecord struct {
+	Time  time.Time `json:"time"` // when the request was sent, after any ratelimit wait
+	Label string    `json:"label,omitempty"`
+	// RequestLabel is the label from the request's context, see Labeled.
+	RequestLabel string        `json:"request_label,omitempty"`
+	Path         string        `json:"path"`
+	Params       url.Values    `json:"params,omitempty"`
+	StatusCode   int           `json:"status_code"` // 0 if no response was received
+	Duration     time.Duration `json:"duration"`
+	Err          string        `json:"error,omitempty"`
+}
+
+// AuditSink receives a record of every request a Client sends.
+// Audit is called synchronously after each request, so it should be quick.
+type AuditSink interface {
+	Audit(AuditRecord)
+}
+
+// AuditFunc lets an ordinary function be used as an AuditSink.
+type AuditFunc func(AuditRecord)
+
+// Audit calls f(r).
+func (f AuditFunc) Audit(r AuditRecord) { f(r) }
+
+// JSONAuditSink writes each record to W as a line of JSON.
+type JSONAuditSink struct {
+	W io.Writer
+
+	mu sync.Mutex
+}
+
+// Audit writes r to s.W, write errors are dropped.
+func (s *JSONAuditSink) Audit(r AuditRecord) {
+	b, err := json.Marshal(r)
+	if err != nil {
+		return
+	}
+	s.mu.Lock()
+	s.W.Write(append(b, '\n'))
+	s.mu.Unlock()
+}
+
+// SetAuditSink sends a record of every request to sink, tagged with the client's Label.
+// Responses served from the cache aren't requests so they aren't recorded.
+// Pass nil to stop auditing.
+func (c *Client) SetAuditSink(sink AuditSink) {
+	c.audit = sink
+}
+
+func (c *Client) auditRequest(ctx context.Context, start time.Time, path string, params url.Values, statusCode int, err error) {
+	if c.audit == nil {
+		return
+	}
+	r := AuditRecord{
+		Time:         start,
+		Label:        c.Label,
+		RequestLabel: RequestLabel(ctx),
+		Path:         path,
+		Params:       params,
+		StatusCode:   statusCode,
+		Duration:     c.now().Sub(start),
+	}
+	if err != nil {
+		r.Err = err.Error()
+	}
+	c.audit.Audit(r)
+}
@@ -0,0 +1,69 @@
+package goroyale
+
+import "strings"
+
+// BadgeSVGDir is the directory of AssetsBaseURL holding the vector copies of the clan badges.
+var BadgeSVGDir = "badges-svg"
+
+// LookupBadge finds a clan badge in GameData by its ID, ex: 16000000.
+func LookupBadge(id int) (badge ConstantsAllianceBadge, ok bool) {
+	constants, _ := GameData()
+	for _, b := range constants.AllianceBadges {
+		if b.ID == id {
+			return b, true
+		}
+	}
+	return
+}
+
+// LookupBadgeName finds a clan badge in GameData by its asset name, ex: "Flame_01", ignoring case.
+func LookupBadgeName(name string) (badge ConstantsAllianceBadge, ok bool) {
+	constants, _ := GameData()
+	for _, b := range constants.AllianceBadges {
+		if strings.EqualFold(b.Name, name) {
+			return b, true
+		}
+	}
+	return
+}
+
+// AssetName returns the name of the badge's image files.
+// It's Name when the API sent one, otherwise it's looked up from ID, and "" if neither is known.
+func (b Badge) AssetName() string {
+	if b.Name != "" {
+		return b.Name
+	}
+	if badge, ok := LookupBadge(b.ID); ok {
+		return badge.Name
+	}
+	return ""
+}
+
+// ImageURL returns the badge's image on the CDN in the given size.
+// Image sometimes links to images that don't exist, this builds the link from the badge's name or ID instead.
+// It falls back to Image if the badge can't be identified.
+func (b Badge) ImageURL(size ImageSize) string {
+	if name := b.AssetName(); name != "" {
+		return assetURL("badges", size, name)
+	}
+	return b.Image
+}
+
+// ImageURLs returns the badge's image in every size, keyed by size.
+func (b Badge) ImageURLs() map[ImageSize]string {
+	urls := make(map[ImageSize]string, 3)
+	for _, size := range []ImageSize{ImageSizeFull, ImageSize150, ImageSize75} {
+		urls[size] = b.ImageURL(size)
+	}
+	return urls
+}
+
+// SVGURL returns the vector image of the badge, which can be drawn at any size.
+// It's "" if the badge can't be identified.
+func (b Badge) SVGURL() string {
+	name := b.AssetName()
+	if name == "" {
+		return ""
+	}
+	return AssetsBaseURL + "/" + BadgeSVGDir + "/" + name + ".svg"
+}
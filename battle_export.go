@@ -0,0 +1,175 @@
+package goroyale
+
+import (
+	"encoding/csv"
+	"io"
+	"reflect"
+	"strconv"
+	"time"
+)
+
+// BattleRow is a Battle flattened into a single row for dataframes, see WriteBattlesCSV and WriteBattlesParquet.
+// Each player is a BattleSlot, whose fields become columns prefixed by the slot, ex: "team_tag" and "opponent_card_1".
+// The Team2 and Opponent2 columns are only filled in for 2v2 battles.
+// The column tags name the columns, see BattleColumns.
+type BattleRow struct {
+	Time           time.Time `column:"time"`
+	Type           string    `column:"type"`
+	Mode           string    `column:"mode"`
+	TeamSize       int       `column:"team_size"`
+	Winner         int       `column:"winner"`
+	TeamCrowns     int       `column:"team_crowns"`
+	OpponentCrowns int       `column:"opponent_crowns"`
+	Arena          string    `column:"arena"`
+
+	Team      BattleSlot `column:"team"`
+	Team2     BattleSlot `column:"team2"`
+	Opponent  BattleSlot `column:"opponent"`
+	Opponent2 BattleSlot `column:"opponent2"`
+}
+
+// BattleSlot is one player's columns in a BattleRow.
+// Decks are spread into one column per card holding the card's key, "card_1" to "card_8".
+type BattleSlot struct {
+	Tag           string    `column:"tag"`
+	Name          string    `column:"name"`
+	ClanTag       string    `column:"clan_tag"`
+	StartTrophies int       `column:"start_trophies"`
+	TrophyChange  int       `column:"trophy_change"`
+	CrownsEarned  int       `column:"crowns_earned"`
+	Cards         [8]string `column:"card"`
+	Elixir        float64   `column:"elixir"` // the deck's average elixir
+}
+
+// newBattleSlot flattens members[i], the slot is empty if there aren't that many members.
+func newBattleSlot(members []TeamMember, i int) (slot BattleSlot) {
+	if i >= len(members) {
+		return
+	}
+	m := members[i]
+	slot = BattleSlot{
+		Tag:           m.Tag,
+		Name:          m.Name,
+		ClanTag:       m.Clan.Tag,
+		StartTrophies: m.StartTrophies,
+		TrophyChange:  m.TrophyChange,
+		CrownsEarned:  m.CrownsEarned,
+	}
+	var total int
+	for i, card := range m.Deck {
+		if i < len(slot.Cards) {
+			slot.Cards[i] = card.Key
+		}
+		total += card.Elixir
+	}
+	if len(m.Deck) > 0 {
+		slot.Elixir = float64(total) / float64(len(m.Deck))
+	}
+	return
+}
+
+// NewBattleRow flattens b.
+func NewBattleRow(b Battle) BattleRow {
+	return BattleRow{
+		Time:           time.Unix(int64(b.UTCTime), 0).UTC(),
+		Type:           b.Type,
+		Mode:           b.Mode.Name,
+		TeamSize:       b.TeamSize,
+		Winner:         b.Winner,
+		TeamCrowns:     b.TeamCrowns,
+		OpponentCrowns: b.OpponentCrowns,
+		Arena:          b.Arena.Name,
+		Team:           newBattleSlot(b.Team, 0),
+		Team2:          newBattleSlot(b.Team, 1),
+		Opponent:       newBattleSlot(b.Opponent, 0),
+		Opponent2:      newBattleSlot(b.Opponent, 1),
+	}
+}
+
+// NewBattleRows flattens every battle.
+func NewBattleRows(battles []Battle) []BattleRow {
+	rows := make([]BattleRow, len(battles))
+	for i, b := range battles {
+		rows[i] = NewBattleRow(b)
+	}
+	return rows
+}
+
+// battleColumn is a column of BattleRow, the field at index, and the element of an array field if it is one.
+type battleColumn struct {
+	name  string
+	index []int
+	elem  int // -1 if the field isn't an array
+	kind  reflect.Type
+}
+
+// battleColumns walks BattleRow's fields in order, going into BattleSlots and arrays.
+var battleColumns = func() (columns []battleColumn) {
+	var walk func(t reflect.Type, prefix string, index []int)
+	walk = func(t reflect.Type, prefix string, index []int) {
+		for i := 0; i < t.NumField(); i++ {
+			f := t.Field(i)
+			name := prefix + f.Tag.Get("column")
+			fieldIndex := append(append([]int(nil), index...), i)
+			switch {
+			case f.Type == reflect.TypeOf(BattleSlot{}):
+				walk(f.Type, name+"_", fieldIndex)
+			case f.Type.Kind() == reflect.Array:
+				for elem := 0; elem < f.Type.Len(); elem++ {
+					columns = append(columns, battleColumn{name + "_" + strconv.Itoa(elem+1), fieldIndex, elem, f.Type.Elem()})
+				}
+			default:
+				columns = append(columns, battleColumn{name, fieldIndex, -1, f.Type})
+			}
+		}
+	}
+	walk(reflect.TypeOf(BattleRow{}), "", nil)
+	return
+}()
+
+// value returns the column's value in row, a time.Time, string, int, or float64.
+func (c battleColumn) value(row reflect.Value) interface{} {
+	v := row.FieldByIndex(c.index)
+	if c.elem >= 0 {
+		v = v.Index(c.elem)
+	}
+	return v.Interface()
+}
+
+// BattleColumns returns the column names of BattleRow in order.
+func BattleColumns() []string {
+	names := make([]string, len(battleColumns))
+	for i, c := range battleColumns {
+		names[i] = c.name
+	}
+	return names
+}
+
+// WriteBattlesCSV writes battles as CSV with a header row, ready for pandas.read_csv or DuckDB's read_csv_auto.
+func WriteBattlesCSV(w io.Writer, battles []Battle) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write(BattleColumns()); err != nil {
+		return err
+	}
+	record := make([]string, len(battleColumns))
+	for _, b := range battles {
+		row := reflect.ValueOf(NewBattleRow(b))
+		for i, c := range battleColumns {
+			switch v := c.value(row).(type) {
+			case time.Time:
+				record[i] = v.Format(time.RFC3339)
+			case string:
+				record[i] = v
+			case int:
+				record[i] = strconv.Itoa(v)
+			case float64:
+				record[i] = strconv.FormatFloat(v, 'f', -1, 64)
+			}
+		}
+		if err := cw.Write(record); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
@@ -0,0 +1,57 @@
+package goroyale
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"testing"
+)
+
+// testdata/battles.parquet is WriteBattlesParquet of testdata/battles.json.
+// It was checked with github.com/parquet-go/parquet-go and Arrow's Go reader, which read the same values
+// as WriteBattlesCSV, so if the writer changes on purpose check the new file with a reader before replacing it.
+func TestWriteBattlesParquet(t *testing.T) {
+	var battles []Battle
+	if err := json.Unmarshal(readTestdata(t, "battles.json"), &battles); err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	if err := WriteBattlesParquet(&buf, battles); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(buf.Bytes(), readTestdata(t, "battles.parquet")) {
+		t.Error("WriteBattlesParquet(battles.json) doesn't match testdata/battles.parquet")
+	}
+
+	for _, battles := range [][]Battle{nil, battles} {
+		buf.Reset()
+		WriteBattlesParquet(&buf, battles)
+		file := buf.Bytes()
+		n := len(file)
+		if n < 12 || string(file[:4]) != parquetMagic || string(file[n-4:]) != parquetMagic {
+			t.Fatalf("%d battles: the file doesn't start and end with %q", len(battles), parquetMagic)
+		}
+		if meta := int(binary.LittleEndian.Uint32(file[n-8:])); meta <= 0 || meta > n-12 {
+			t.Errorf("%d battles: the footer says the metadata is %d bytes of a %d byte file", len(battles), meta, n)
+		}
+	}
+}
+
+func TestBattleColumns(t *testing.T) {
+	columns := BattleColumns()
+	if len(columns) != 8+4*15 {
+		t.Errorf("got %d columns, want %d", len(columns), 8+4*15)
+	}
+	seen := map[string]bool{}
+	for _, c := range columns {
+		if seen[c] {
+			t.Errorf("column %q is there twice", c)
+		}
+		seen[c] = true
+	}
+	for _, c := range []string{"time", "team_tag", "team_card_1", "team_card_8", "team2_elixir", "opponent2_crowns_earned"} {
+		if !seen[c] {
+			t.Errorf("there's no %q column", c)
+		}
+	}
+}
@@ -0,0 +1,39 @@
+package goroyale
+
+import "time"
+
+// Time returns when the battle was played.
+func (b Battle) Time() time.Time {
+	return time.Unix(int64(b.UTCTime), 0)
+}
+
+// FilterBattles returns the battles keep returns true for, in the same order.
+func FilterBattles(battles []Battle, keep func(Battle) bool) []Battle {
+	var kept []Battle
+	for _, b := range battles {
+		if keep(b) {
+			kept = append(kept, b)
+		}
+	}
+	return kept
+}
+
+// FilterBattlesSince returns the battles played at or after t.
+// The battles endpoint can't filter by time itself so this is done after the response comes back.
+func FilterBattlesSince(battles []Battle, t time.Time) []Battle {
+	return FilterBattles(battles, func(b Battle) bool { return !b.Time().Before(t) })
+}
+
+// FilterBattlesBetween returns the battles played at or after start and before end.
+func FilterBattlesBetween(battles []Battle, start, end time.Time) []Battle {
+	return FilterBattles(battles, func(b Battle) bool {
+		t := b.Time()
+		return !t.Before(start) && t.Before(end)
+	})
+}
+
+// BattlesInSeason returns the battles played since the season starting at seasonStart began,
+// see SeasonCalendar and SeasonRules for looking up season start times.
+func BattlesInSeason(battles []Battle, seasonStart time.Time) []Battle {
+	return FilterBattlesSince(battles, seasonStart)
+}
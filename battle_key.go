@@ -0,0 +1,41 @@
+package goroyale
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+	"fmt"
+	"sort"
+)
+
+// BattleKeyFunc returns a key identifying a battle, battles with the same key are treated as duplicates.
+type BattleKeyFunc func(Battle) string
+
+// BattleKey identifies a battle by when it happened, who played, and the crowns each side won.
+// The API doesn't give battles IDs, this gives the same key for a battle no matter whose battle log
+// it came from or what order the players were listed in.
+func BattleKey(b Battle) string {
+	var tags []string
+	for _, m := range b.Team {
+		tags = append(tags, m.Tag)
+	}
+	for _, m := range b.Opponent {
+		tags = append(tags, m.Tag)
+	}
+	sort.Strings(tags)
+
+	// crowns are sorted too, so a battle seen from the opponent's side has the same key
+	crowns := []int{b.TeamCrowns, b.OpponentCrowns}
+	sort.Ints(crowns)
+
+	h := sha1.New()
+	fmt.Fprintf(h, "%d|%v|%v", b.UTCTime, tags, crowns)
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// key returns fn's key for b, or BattleKey's if fn is nil.
+func (fn BattleKeyFunc) key(b Battle) string {
+	if fn == nil {
+		return BattleKey(b)
+	}
+	return fn(b)
+}
@@ -0,0 +1,297 @@
+package goroyale
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"math"
+	"reflect"
+	"time"
+)
+
+// The parts of the Parquet format WriteBattlesParquet uses, from
+// https://github.com/apache/parquet-format/blob/master/src/main/thrift/parquet.thrift
+const (
+	parquetMagic = "PAR1"
+
+	// physical types
+	parquetInt64     = 2
+	parquetDouble    = 5
+	parquetByteArray = 6
+
+	// converted types, for readers that don't know logical types
+	parquetUTF8            = 0
+	parquetTimestampMillis = 9
+	parquetInt64Converted  = 18
+
+	parquetRequired     = 0
+	parquetPlain        = 0
+	parquetRLE          = 3
+	parquetUncompressed = 0
+	parquetDataPage     = 0
+)
+
+// WriteBattlesParquet writes battles as a Parquet file with the columns of BattleRow, ready for
+// pandas.read_parquet, DuckDB, or Spark. Times are UTC timestamps in milliseconds, ints are 64 bits,
+// and every column is required, with empty strings and 0s for the slots of 1v1 battles.
+// The file is one uncompressed row group, which is fine for battle logs; compress the whole file if it's big.
+func WriteBattlesParquet(w io.Writer, battles []Battle) error {
+	rows := make([]reflect.Value, len(battles))
+	for i, b := range battles {
+		rows[i] = reflect.ValueOf(NewBattleRow(b))
+	}
+
+	var file bytes.Buffer
+	file.WriteString(parquetMagic)
+	var chunks []parquetChunk
+	if len(rows) > 0 {
+		for _, c := range battleColumns {
+			chunks = append(chunks, writeParquetChunk(&file, c, rows))
+		}
+	}
+
+	meta := parquetFileMetaData(chunks, len(rows))
+	file.Write(meta)
+	binary.Write(&file, binary.LittleEndian, uint32(len(meta)))
+	file.WriteString(parquetMagic)
+	_, err := w.Write(file.Bytes())
+	return err
+}
+
+// parquetChunk is where a column was written in the file.
+type parquetChunk struct {
+	column battleColumn
+	offset int64 // of the page header
+	size   int64 // page header and values
+}
+
+// parquetType returns the physical type of a column, and its converted type.
+func parquetType(c battleColumn) (physical, converted int32) {
+	switch c.kind {
+	case reflect.TypeOf(time.Time{}):
+		return parquetInt64, parquetTimestampMillis
+	case reflect.TypeOf(""):
+		return parquetByteArray, parquetUTF8
+	case reflect.TypeOf(0.0):
+		return parquetDouble, -1
+	}
+	return parquetInt64, parquetInt64Converted
+}
+
+// writeParquetChunk writes the column as a single PLAIN data page.
+// Columns are required and not nested, so the page has no repetition or definition levels, only values.
+func writeParquetChunk(file *bytes.Buffer, c battleColumn, rows []reflect.Value) parquetChunk {
+	var values bytes.Buffer
+	var n [8]byte
+	for _, row := range rows {
+		switch v := c.value(row).(type) {
+		case time.Time:
+			binary.LittleEndian.PutUint64(n[:], uint64(v.UnixMilli()))
+			values.Write(n[:])
+		case string:
+			binary.LittleEndian.PutUint32(n[:4], uint32(len(v)))
+			values.Write(n[:4])
+			values.WriteString(v)
+		case int:
+			binary.LittleEndian.PutUint64(n[:], uint64(int64(v)))
+			values.Write(n[:])
+		case float64:
+			binary.LittleEndian.PutUint64(n[:], math.Float64bits(v))
+			values.Write(n[:])
+		}
+	}
+
+	var header thriftWriter
+	header.begin()
+	header.i32(1, parquetDataPage)
+	header.i32(2, int32(values.Len())) // uncompressed_page_size
+	header.i32(3, int32(values.Len())) // compressed_page_size
+	header.beginStruct(5)              // data_page_header
+	header.i32(1, int32(len(rows)))    // num_values
+	header.i32(2, parquetPlain)
+	header.i32(3, parquetRLE) // definition_level_encoding
+	header.i32(4, parquetRLE) // repetition_level_encoding
+	header.end()
+	header.end()
+
+	chunk := parquetChunk{column: c, offset: int64(file.Len()), size: int64(header.buf.Len() + values.Len())}
+	file.Write(header.buf.Bytes())
+	file.Write(values.Bytes())
+	return chunk
+}
+
+// parquetFileMetaData encodes the file's footer, with a row group of chunks if there are rows.
+func parquetFileMetaData(chunks []parquetChunk, rows int) []byte {
+	var w thriftWriter
+	w.begin()
+	w.i32(1, 1) // version
+
+	w.list(2, compactStruct, len(battleColumns)+1) // schema
+	w.begin()
+	w.binary(4, "schema")
+	w.i32(5, int32(len(battleColumns))) // num_children
+	w.end()
+	for _, c := range battleColumns {
+		physical, converted := parquetType(c)
+		w.begin()
+		w.i32(1, physical)
+		w.i32(3, parquetRequired)
+		w.binary(4, c.name)
+		// doubles are plain DOUBLE, they don't have a converted or logical type
+		if converted >= 0 {
+			w.i32(6, converted)
+			w.beginStruct(10) // logicalType, a union
+			switch converted {
+			case parquetUTF8:
+				w.beginStruct(1) // STRING
+				w.end()
+			case parquetTimestampMillis:
+				w.beginStruct(8) // TIMESTAMP
+				w.bool(1, true)  // isAdjustedToUTC
+				w.beginStruct(2) // unit
+				w.beginStruct(1) // MILLIS
+				w.end()
+				w.end()
+				w.end()
+			case parquetInt64Converted:
+				w.beginStruct(10) // INTEGER
+				w.byte(1, 64)     // bitWidth
+				w.bool(2, true)   // isSigned
+				w.end()
+			}
+			w.end()
+		}
+		w.end()
+	}
+
+	w.i64(3, int64(rows))
+	if len(chunks) == 0 {
+		w.list(4, compactStruct, 0)
+	} else {
+		w.list(4, compactStruct, 1) // row_groups
+		w.begin()
+		var total int64
+		w.list(1, compactStruct, len(chunks)) // columns
+		for _, chunk := range chunks {
+			physical, _ := parquetType(chunk.column)
+			total += chunk.size
+			w.begin()
+			w.i64(2, chunk.offset) // file_offset
+			w.beginStruct(3)       // meta_data
+			w.i32(1, physical)
+			w.list(2, compactI32, 1) // encodings
+			w.zigzag(parquetPlain)
+			w.list(3, compactBinary, 1) // path_in_schema
+			w.varint(uint64(len(chunk.column.name)))
+			w.buf.WriteString(chunk.column.name)
+			w.i32(4, parquetUncompressed)
+			w.i64(5, int64(rows))  // num_values
+			w.i64(6, chunk.size)   // total_uncompressed_size
+			w.i64(7, chunk.size)   // total_compressed_size
+			w.i64(9, chunk.offset) // data_page_offset
+			w.end()
+			w.end()
+		}
+		w.i64(2, total) // total_byte_size
+		w.i64(3, int64(rows))
+		w.end()
+	}
+	w.binary(6, "goroyale") // created_by
+	w.end()
+	return w.buf.Bytes()
+}
+
+// Thrift compact protocol types.
+const (
+	compactTrue   = 1
+	compactFalse  = 2
+	compactByte   = 3
+	compactI32    = 5
+	compactI64    = 6
+	compactBinary = 8
+	compactList   = 9
+	compactStruct = 12
+)
+
+// thriftWriter writes structs in the Thrift compact protocol, which is how Parquet encodes its metadata.
+// Fields have to be written in increasing order of id within each struct.
+type thriftWriter struct {
+	buf  bytes.Buffer
+	last []int16 // the last field id written in each struct that's open
+}
+
+// begin starts a struct that isn't a field, ex: the top level one or an element of a list.
+func (w *thriftWriter) begin() {
+	w.last = append(w.last, 0)
+}
+
+// end writes the stop byte of the innermost open struct.
+func (w *thriftWriter) end() {
+	w.buf.WriteByte(0)
+	w.last = w.last[:len(w.last)-1]
+}
+
+func (w *thriftWriter) field(id int16, typ byte) {
+	last := &w.last[len(w.last)-1]
+	if delta := id - *last; delta > 0 && delta <= 15 {
+		w.buf.WriteByte(byte(delta)<<4 | typ)
+	} else {
+		w.buf.WriteByte(typ)
+		w.zigzag(int64(id))
+	}
+	*last = id
+}
+
+func (w *thriftWriter) varint(v uint64) {
+	var b [binary.MaxVarintLen64]byte
+	w.buf.Write(b[:binary.PutUvarint(b[:], v)])
+}
+
+func (w *thriftWriter) zigzag(v int64) {
+	w.varint(uint64(v<<1) ^ uint64(v>>63))
+}
+
+func (w *thriftWriter) beginStruct(id int16) {
+	w.field(id, compactStruct)
+	w.begin()
+}
+
+func (w *thriftWriter) bool(id int16, v bool) {
+	if v {
+		w.field(id, compactTrue)
+	} else {
+		w.field(id, compactFalse)
+	}
+}
+
+func (w *thriftWriter) byte(id int16, v int8) {
+	w.field(id, compactByte)
+	w.buf.WriteByte(byte(v))
+}
+
+func (w *thriftWriter) i32(id int16, v int32) {
+	w.field(id, compactI32)
+	w.zigzag(int64(v))
+}
+
+func (w *thriftWriter) i64(id int16, v int64) {
+	w.field(id, compactI64)
+	w.zigzag(v)
+}
+
+func (w *thriftWriter) binary(id int16, s string) {
+	w.field(id, compactBinary)
+	w.varint(uint64(len(s)))
+	w.buf.WriteString(s)
+}
+
+// list starts a list field of n elements of type elem, the elements are written after it without field headers.
+func (w *thriftWriter) list(id int16, elem byte, n int) {
+	w.field(id, compactList)
+	if n < 15 {
+		w.buf.WriteByte(byte(n)<<4 | elem)
+	} else {
+		w.buf.WriteByte(0xf0 | elem)
+		w.varint(uint64(n))
+	}
+}
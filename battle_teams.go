@@ -0,0 +1,97 @@
+package goroyale
+
+// side returns 1 if tag played on Team, -1 if they played on Opponent, and 0 if they didn't play.
+// Tags are compared with NormalizeTag so "#8L9L9GL" and "8l9l9gl" match.
+func (b Battle) side(tag string) int {
+	tag = NormalizeTag(tag)
+	for _, m := range b.Team {
+		if NormalizeTag(m.Tag) == tag {
+			return 1
+		}
+	}
+	for _, m := range b.Opponent {
+		if NormalizeTag(m.Tag) == tag {
+			return -1
+		}
+	}
+	return 0
+}
+
+// sides returns the side of the battle tag played on and the side they played against.
+func (b Battle) sides(tag string) (team, opponents []TeamMember, ok bool) {
+	switch b.side(tag) {
+	case 1:
+		return b.Team, b.Opponent, true
+	case -1:
+		return b.Opponent, b.Team, true
+	}
+	return nil, nil, false
+}
+
+// PlayerOf returns the player with the tag, whichever side they played on.
+func (b Battle) PlayerOf(tag string) (m TeamMember, ok bool) {
+	team, _, ok := b.sides(tag)
+	tag = NormalizeTag(tag)
+	for _, m = range team {
+		if NormalizeTag(m.Tag) == tag {
+			return m, true
+		}
+	}
+	return TeamMember{}, false
+}
+
+// TeammateOf returns the player on the same side as tag in a 2v2 battle.
+func (b Battle) TeammateOf(tag string) (m TeamMember, ok bool) {
+	team, _, _ := b.sides(tag)
+	tag = NormalizeTag(tag)
+	for _, m = range team {
+		if NormalizeTag(m.Tag) != tag {
+			return m, true
+		}
+	}
+	return TeamMember{}, false
+}
+
+// OpponentOf returns the players on the other side from tag.
+// It's nil if tag didn't play in the battle.
+func (b Battle) OpponentOf(tag string) []TeamMember {
+	_, opponents, _ := b.sides(tag)
+	return opponents
+}
+
+// CrownDiffFor works like Winner but from tag's side of the battle.
+// It's 0 if tag didn't play in the battle.
+func (b Battle) CrownDiffFor(tag string) int {
+	return b.side(tag) * b.Winner
+}
+
+// IsWinFor reports whether tag's side won the battle.
+func (b Battle) IsWinFor(tag string) bool {
+	return b.CrownDiffFor(tag) > 0
+}
+
+// IsLossFor reports whether tag's side lost the battle.
+func (b Battle) IsLossFor(tag string) bool {
+	return b.CrownDiffFor(tag) < 0
+}
+
+// Card returns the card in the member's deck with the key, ex: "hog-rider".
+func (m TeamMember) Card(key string) (c Card, ok bool) {
+	for _, c = range m.Deck {
+		if c.Key == key {
+			return c, true
+		}
+	}
+	return Card{}, false
+}
+
+// HasCard reports whether the member's deck has the card with the key.
+func (m TeamMember) HasCard(key string) bool {
+	_, ok := m.Card(key)
+	return ok
+}
+
+// DeckHash returns the DeckHash of the member's deck.
+func (m TeamMember) DeckHash() string {
+	return DeckHash(m.Deck)
+}
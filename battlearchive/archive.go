@@ -0,0 +1,302 @@
+// Package battlearchive stores battles in SQLite so stats can go further back than the API's battle log.
+//
+// It only uses database/sql, open the database with whichever SQLite driver you like:
+//
+//	db, err := sql.Open("sqlite3", "battles.db") // github.com/mattn/go-sqlite3
+//	archive, err := battlearchive.Open(ctx, db)
+//	added, err := archive.Ingest(ctx, battles)
+//
+// Tags are stored and looked up with goroyale.NormalizeTag, so "#abc" and "ABC" are the same player.
+package battlearchive
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/jegfish/goroyale"
+)
+
+const schema = `
+CREATE TABLE IF NOT EXISTS battles (
+	key      TEXT PRIMARY KEY,
+	utc_time INTEGER NOT NULL,
+	type     TEXT NOT NULL,
+	mode     TEXT NOT NULL,
+	winner   INTEGER NOT NULL,
+	data     TEXT NOT NULL
+);
+CREATE INDEX IF NOT EXISTS battles_utc_time ON battles (utc_time);
+CREATE TABLE IF NOT EXISTS battle_players (
+	battle_key TEXT NOT NULL REFERENCES battles (key),
+	tag        TEXT NOT NULL,
+	side       INTEGER NOT NULL,
+	PRIMARY KEY (battle_key, tag)
+);
+CREATE INDEX IF NOT EXISTS battle_players_tag ON battle_players (tag);
+CREATE TABLE IF NOT EXISTS battle_decks (
+	battle_key TEXT NOT NULL REFERENCES battles (key),
+	side       INTEGER NOT NULL,
+	deck_hash  TEXT NOT NULL,
+	cards      TEXT NOT NULL,
+	PRIMARY KEY (battle_key, side)
+);
+CREATE INDEX IF NOT EXISTS battle_decks_hash ON battle_decks (deck_hash);
+`
+
+// Sides of a battle in the battle_players table.
+const (
+	sideTeam     = 0
+	sideOpponent = 1
+)
+
+// Archive is a battle archive backed by a SQLite database.
+type Archive struct {
+	// Key identifies battles so they're only stored once, defaults to goroyale.BattleKey.
+	// Changing it for an existing archive means battles already stored may be ingested again.
+	Key goroyale.BattleKeyFunc
+
+	db *sql.DB
+}
+
+// Open creates the archive's tables in db if they don't exist yet.
+func Open(ctx context.Context, db *sql.DB) (*Archive, error) {
+	if _, err := db.ExecContext(ctx, schema); err != nil {
+		return nil, err
+	}
+	a := &Archive{db: db}
+	if err := a.migrate(ctx); err != nil {
+		return nil, err
+	}
+	return a, nil
+}
+
+// migrations bring the data of older archives up to date, migrations[i] takes an archive from version i to i+1.
+// The version is kept in SQLite's user_version, so each one only runs once.
+var migrations = []func(ctx context.Context, tx *sql.Tx) error{
+	indexDecks, // battle_decks was added after the first archives were made
+}
+
+func (a *Archive) migrate(ctx context.Context) (err error) {
+	var version int
+	if err = a.db.QueryRowContext(ctx, `PRAGMA user_version`).Scan(&version); err != nil || version >= len(migrations) {
+		return
+	}
+	tx, err := a.db.BeginTx(ctx, nil)
+	if err != nil {
+		return
+	}
+	defer func() {
+		if err != nil {
+			tx.Rollback()
+			return
+		}
+		err = tx.Commit()
+	}()
+	for ; version < len(migrations); version++ {
+		if err = migrations[version](ctx, tx); err != nil {
+			return
+		}
+	}
+	// PRAGMA doesn't take parameters
+	_, err = tx.ExecContext(ctx, fmt.Sprintf(`PRAGMA user_version = %d`, version))
+	return
+}
+
+func (a *Archive) key(b goroyale.Battle) string {
+	if a.Key == nil {
+		return goroyale.BattleKey(b)
+	}
+	return a.Key(b)
+}
+
+// Ingest stores battles, skipping ones already in the archive.
+// It returns how many battles were new.
+func (a *Archive) Ingest(ctx context.Context, battles []goroyale.Battle) (added int, err error) {
+	tx, err := a.db.BeginTx(ctx, nil)
+	if err != nil {
+		return
+	}
+	defer func() {
+		if err != nil {
+			tx.Rollback()
+			added = 0
+			return
+		}
+		err = tx.Commit()
+	}()
+
+	for _, b := range battles {
+		var data []byte
+		if data, err = json.Marshal(b); err != nil {
+			return
+		}
+		k := a.key(b)
+		var res sql.Result
+		res, err = tx.ExecContext(ctx,
+			`INSERT OR IGNORE INTO battles (key, utc_time, type, mode, winner, data) VALUES (?, ?, ?, ?, ?, ?)`,
+			k, b.UTCTime, b.Type, b.Mode.Name, b.Winner, string(data))
+		if err != nil {
+			return
+		}
+		if n, _ := res.RowsAffected(); n == 0 {
+			continue
+		}
+		added++
+
+		for side, members := range [][]goroyale.TeamMember{sideTeam: b.Team, sideOpponent: b.Opponent} {
+			for _, m := range members {
+				if _, err = tx.ExecContext(ctx,
+					`INSERT OR IGNORE INTO battle_players (battle_key, tag, side) VALUES (?, ?, ?)`,
+					k, goroyale.NormalizeTag(m.Tag), side); err != nil {
+					return
+				}
+			}
+		}
+		if err = insertDecks(ctx, tx, k, b); err != nil {
+			return
+		}
+	}
+	return
+}
+
+func (a *Archive) query(ctx context.Context, query string, args ...interface{}) (battles []goroyale.Battle, err error) {
+	rows, err := a.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var data string
+		if err = rows.Scan(&data); err != nil {
+			return
+		}
+		var b goroyale.Battle
+		if err = json.Unmarshal([]byte(data), &b); err != nil {
+			return
+		}
+		battles = append(battles, b)
+	}
+	err = rows.Err()
+	return
+}
+
+// Battles returns the battles tag played at or after since, oldest first.
+func (a *Archive) Battles(ctx context.Context, tag string, since time.Time) ([]goroyale.Battle, error) {
+	return a.query(ctx, `
+		SELECT b.data FROM battles b
+		JOIN battle_players p ON p.battle_key = b.key
+		WHERE p.tag = ? AND b.utc_time >= ?
+		ORDER BY b.utc_time`, goroyale.NormalizeTag(tag), since.Unix())
+}
+
+// All returns every battle in the archive played at or after since, oldest first.
+func (a *Archive) All(ctx context.Context, since time.Time) ([]goroyale.Battle, error) {
+	return a.query(ctx, `SELECT data FROM battles WHERE utc_time >= ? ORDER BY utc_time`, since.Unix())
+}
+
+// Rate adds every battle played at or after since to t, ex: to predict matchups with t.PredictOutcome.
+func (a *Archive) Rate(ctx context.Context, t *goroyale.RatingTable, since time.Time) error {
+	battles, err := a.All(ctx, since)
+	if err != nil {
+		return err
+	}
+	t.AddBattles(battles)
+	return nil
+}
+
+// BattlesBetween returns the battles tagA and tagB played against each other, oldest first.
+func (a *Archive) BattlesBetween(ctx context.Context, tagA, tagB string) ([]goroyale.Battle, error) {
+	return a.query(ctx, `
+		SELECT b.data FROM battles b
+		JOIN battle_players pa ON pa.battle_key = b.key AND pa.tag = ?
+		JOIN battle_players pb ON pb.battle_key = b.key AND pb.tag = ?
+		WHERE pa.side != pb.side
+		ORDER BY b.utc_time`, goroyale.NormalizeTag(tagA), goroyale.NormalizeTag(tagB))
+}
+
+// Record is a win/loss/draw record.
+type Record struct {
+	Wins, Losses, Draws int
+}
+
+// Played returns the number of battles in r.
+func (r Record) Played() int {
+	return r.Wins + r.Losses + r.Draws
+}
+
+// WinRate returns Wins out of every battle played, 0-1.
+func (r Record) WinRate() float64 {
+	if r.Played() == 0 {
+		return 0
+	}
+	return float64(r.Wins) / float64(r.Played())
+}
+
+// results returns +1, -1, or 0 for every battle tag played since the time, oldest first.
+func (a *Archive) results(ctx context.Context, tag string, since time.Time) (results []int, err error) {
+	rows, err := a.db.QueryContext(ctx, `
+		SELECT b.winner, p.side FROM battles b
+		JOIN battle_players p ON p.battle_key = b.key
+		WHERE p.tag = ? AND b.utc_time >= ?
+		ORDER BY b.utc_time`, goroyale.NormalizeTag(tag), since.Unix())
+	if err != nil {
+		return
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var winner, side int
+		if err = rows.Scan(&winner, &side); err != nil {
+			return
+		}
+		// winner is from the team's point of view
+		if side == sideOpponent {
+			winner = -winner
+		}
+		switch {
+		case winner > 0:
+			results = append(results, 1)
+		case winner < 0:
+			results = append(results, -1)
+		default:
+			results = append(results, 0)
+		}
+	}
+	err = rows.Err()
+	return
+}
+
+// WinRateSince returns tag's record in battles played at or after since.
+func (a *Archive) WinRateSince(ctx context.Context, tag string, since time.Time) (record Record, err error) {
+	results, err := a.results(ctx, tag, since)
+	for _, r := range results {
+		switch r {
+		case 1:
+			record.Wins++
+		case -1:
+			record.Losses++
+		default:
+			record.Draws++
+		}
+	}
+	return
+}
+
+// LongestStreak returns the most battles in a row tag has won.
+func (a *Archive) LongestStreak(ctx context.Context, tag string) (longest int, err error) {
+	results, err := a.results(ctx, tag, time.Time{})
+	var current int
+	for _, r := range results {
+		if r == 1 {
+			current++
+			if current > longest {
+				longest = current
+			}
+		} else {
+			current = 0
+		}
+	}
+	return
+}
@@ -0,0 +1,191 @@
+package battlearchive
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/jegfish/goroyale"
+)
+
+// execer is what insertDecks needs from a *sql.DB or *sql.Tx.
+type execer interface {
+	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
+}
+
+// insertDecks indexes the decks of a 1v1 battle by goroyale.DeckHash.
+// 2v2 battles are left out since a deck there depends on the teammate's.
+func insertDecks(ctx context.Context, db execer, key string, b goroyale.Battle) error {
+	if len(b.Team) != 1 || len(b.Opponent) != 1 {
+		return nil
+	}
+	for side, m := range []goroyale.TeamMember{sideTeam: b.Team[0], sideOpponent: b.Opponent[0]} {
+		if len(m.Deck) == 0 {
+			continue
+		}
+		keys := make([]string, len(m.Deck))
+		for i, c := range goroyale.CanonicalizeDeck(m.Deck) {
+			keys[i] = c.Key
+		}
+		if _, err := db.ExecContext(ctx,
+			`INSERT OR IGNORE INTO battle_decks (battle_key, side, deck_hash, cards) VALUES (?, ?, ?, ?)`,
+			key, side, goroyale.DeckHash(m.Deck), strings.Join(keys, ",")); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// indexDecks indexes the decks of battles stored before battle_decks existed.
+func indexDecks(ctx context.Context, tx *sql.Tx) (err error) {
+	rows, err := tx.QueryContext(ctx, `
+		SELECT b.key, b.data FROM battles b
+		WHERE NOT EXISTS (SELECT 1 FROM battle_decks d WHERE d.battle_key = b.key)
+		AND (SELECT COUNT(*) FROM battle_players p WHERE p.battle_key = b.key) = 2`)
+	if err != nil {
+		return
+	}
+	type stored struct {
+		key    string
+		battle goroyale.Battle
+	}
+	var missing []stored
+	for rows.Next() {
+		var s stored
+		var data string
+		if err = rows.Scan(&s.key, &data); err != nil {
+			rows.Close()
+			return
+		}
+		if err = json.Unmarshal([]byte(data), &s.battle); err != nil {
+			rows.Close()
+			return
+		}
+		missing = append(missing, s)
+	}
+	rows.Close()
+	if err = rows.Err(); err != nil {
+		return
+	}
+
+	for _, s := range missing {
+		if err = insertDecks(ctx, tx, s.key, s.battle); err != nil {
+			return
+		}
+	}
+	return
+}
+
+// CounterOptions tune SuggestCounters.
+type CounterOptions struct {
+	// MinBattles is how many battles a deck needs against the target to be suggested, DefaultMinBattles if it is 0.
+	MinBattles int
+	// Limit is how many counters to return, all of them if it is 0.
+	Limit int
+	// Since only counts battles played at or after the time.
+	Since time.Time
+	// Z is the z-score of the confidence interval, DefaultZ (95%) if it is 0.
+	Z float64
+}
+
+// Defaults used when CounterOptions fields are 0.
+const (
+	DefaultMinBattles = 10
+	DefaultZ          = 1.96
+)
+
+// Counter is a deck's record against the deck passed to SuggestCounters.
+// Draws count as half a win in the win rate and its confidence interval.
+type Counter struct {
+	DeckHash string
+	Cards    []string // card keys, sorted by card ID
+	Record   Record
+	WinRate  float64
+	// Lower and Upper are the Wilson score interval of WinRate.
+	Lower, Upper float64
+}
+
+// SuggestCounters returns the decks with the best record against the deck with the hash, see goroyale.DeckHash.
+// Decks are ranked by the lower end of their win rate's confidence interval, so a deck that won 3 of 3 battles
+// doesn't beat one that won 80 of 100. Mirror matches and 2v2 battles aren't counted.
+func (a *Archive) SuggestCounters(ctx context.Context, deckHash string, opts CounterOptions) (counters []Counter, err error) {
+	minBattles, z := opts.MinBattles, opts.Z
+	if minBattles == 0 {
+		minBattles = DefaultMinBattles
+	}
+	if z == 0 {
+		z = DefaultZ
+	}
+
+	rows, err := a.db.QueryContext(ctx, `
+		SELECT c.deck_hash, c.cards, c.side, b.winner FROM battle_decks t
+		JOIN battle_decks c ON c.battle_key = t.battle_key AND c.side != t.side
+		JOIN battles b ON b.key = t.battle_key
+		WHERE t.deck_hash = ? AND c.deck_hash != t.deck_hash AND b.utc_time >= ?`,
+		deckHash, opts.Since.Unix())
+	if err != nil {
+		return
+	}
+	defer rows.Close()
+
+	byHash := make(map[string]*Counter)
+	for rows.Next() {
+		var hash, cards string
+		var side, winner int
+		if err = rows.Scan(&hash, &cards, &side, &winner); err != nil {
+			return
+		}
+		c, ok := byHash[hash]
+		if !ok {
+			c = &Counter{DeckHash: hash, Cards: strings.Split(cards, ",")}
+			byHash[hash] = c
+		}
+		// winner is from the team's point of view
+		if side == sideOpponent {
+			winner = -winner
+		}
+		switch {
+		case winner > 0:
+			c.Record.Wins++
+		case winner < 0:
+			c.Record.Losses++
+		default:
+			c.Record.Draws++
+		}
+	}
+	if err = rows.Err(); err != nil {
+		return
+	}
+
+	for _, c := range byHash {
+		n := c.Record.Played()
+		if n < minBattles {
+			continue
+		}
+		c.WinRate = (float64(c.Record.Wins) + float64(c.Record.Draws)/2) / float64(n)
+		c.Lower, c.Upper = wilson(c.WinRate, float64(n), z)
+		counters = append(counters, *c)
+	}
+	sort.Slice(counters, func(i, j int) bool {
+		if counters[i].Lower != counters[j].Lower {
+			return counters[i].Lower > counters[j].Lower
+		}
+		return counters[i].DeckHash < counters[j].DeckHash
+	})
+	if opts.Limit > 0 && len(counters) > opts.Limit {
+		counters = counters[:opts.Limit]
+	}
+	return
+}
+
+// wilson returns the Wilson score interval of a proportion p out of n trials.
+func wilson(p, n, z float64) (lower, upper float64) {
+	z2 := z * z
+	center := (p + z2/(2*n)) / (1 + z2/n)
+	margin := z / (1 + z2/n) * math.Sqrt(p*(1-p)/n+z2/(4*n*n))
+	return math.Max(0, center-margin), math.Min(1, center+margin)
+}
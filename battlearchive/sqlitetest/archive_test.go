@@ -0,0 +1,165 @@
+package sqlitetest
+
+import (
+	"context"
+	"database/sql"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/jegfish/goroyale"
+	"github.com/jegfish/goroyale/battlearchive"
+	_ "modernc.org/sqlite"
+)
+
+var testStart = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+// openDB opens a new SQLite database in a temporary directory.
+func openDB(tb testing.TB) *sql.DB {
+	tb.Helper()
+	db, err := sql.Open("sqlite", filepath.Join(tb.TempDir(), "battles.db"))
+	if err != nil {
+		tb.Fatal(err)
+	}
+	tb.Cleanup(func() { db.Close() })
+	return db
+}
+
+func openArchive(tb testing.TB, db *sql.DB) *battlearchive.Archive {
+	tb.Helper()
+	a, err := battlearchive.Open(context.Background(), db)
+	if err != nil {
+		tb.Fatal(err)
+	}
+	return a
+}
+
+// battle is a 1v1 battle between team and opponent, minutes after testStart, that team won by winner crowns.
+func battle(minutes int, team, opponent string, winner int) goroyale.Battle {
+	b := goroyale.Battle{
+		Type:     "PvP",
+		UTCTime:  int(testStart.Add(time.Duration(minutes) * time.Minute).Unix()),
+		Mode:     goroyale.BattleMode{Name: "Ladder"},
+		Winner:   winner,
+		Team:     []goroyale.TeamMember{{Tag: team}},
+		Opponent: []goroyale.TeamMember{{Tag: opponent}},
+	}
+	if winner > 0 {
+		b.TeamCrowns = winner
+	} else {
+		b.OpponentCrowns = -winner
+	}
+	return b
+}
+
+func times(battles []goroyale.Battle) (utc []int) {
+	for _, b := range battles {
+		utc = append(utc, int(time.Unix(int64(b.UTCTime), 0).Sub(testStart)/time.Minute))
+	}
+	return
+}
+
+func TestIngest(t *testing.T) {
+	ctx := context.Background()
+	db := openDB(t)
+	a := openArchive(t, db)
+
+	battles := []goroyale.Battle{
+		battle(0, "2PP", "8L9L9GL", 1),
+		battle(1, "2PP", "8L9L9GL", -2),
+		battle(2, "8L9L9GL", "2PP", 3),
+	}
+	added, err := a.Ingest(ctx, battles)
+	if err != nil || added != 3 {
+		t.Fatalf("added %d, %v, want 3", added, err)
+	}
+	// the same battles again, one seen from the other side
+	flipped := battle(0, "8L9L9GL", "2PP", -1)
+	flipped.TeamCrowns, flipped.OpponentCrowns = 0, 1
+	if added, err = a.Ingest(ctx, append(battles, flipped)); err != nil || added != 0 {
+		t.Errorf("added %d, %v ingesting battles already in the archive, want 0", added, err)
+	}
+
+	// reopening keeps what's there
+	a = openArchive(t, db)
+	all, err := a.Battles(ctx, "8L9L9GL", time.Time{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := times(all); !reflect.DeepEqual(got, []int{0, 1, 2}) {
+		t.Errorf("Battles returned the battles at %v, want 0, 1, 2", got)
+	}
+	if all[0].Mode.Name != "Ladder" || all[0].Team[0].Tag != "2PP" {
+		t.Errorf("the stored battle came back as %+v", all[0])
+	}
+	if since, err := a.Battles(ctx, "8L9L9GL", testStart.Add(time.Minute)); err != nil || !reflect.DeepEqual(times(since), []int{1, 2}) {
+		t.Errorf("Battles since minute 1 returned %v, %v", times(since), err)
+	}
+}
+
+func TestTagsAreNormalized(t *testing.T) {
+	ctx := context.Background()
+	a := openArchive(t, openDB(t))
+	if _, err := a.Ingest(ctx, []goroyale.Battle{
+		battle(0, "#2pp", "8L9L9GL", 1),
+		battle(1, "2PP", "#8l9l9gl", 1),
+		battle(2, "2PP", "C0C", 1),
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, tag := range []string{"2PP", "#2PP", "2pp", " #2pp"} {
+		battles, err := a.Battles(ctx, tag, time.Time{})
+		if err != nil || len(battles) != 3 {
+			t.Errorf("Battles(%q) returned %d battles, %v, want 3", tag, len(battles), err)
+		}
+	}
+	if battles, err := a.BattlesBetween(ctx, "#2pp", "8l9l9gl"); err != nil || !reflect.DeepEqual(times(battles), []int{0, 1}) {
+		t.Errorf("BattlesBetween returned %v, %v, want the battles at 0 and 1", times(battles), err)
+	}
+	// NormalizeTag reads O as 0
+	if battles, err := a.Battles(ctx, "COC", time.Time{}); err != nil || len(battles) != 1 {
+		t.Errorf("Battles(COC) returned %d battles, %v, want C0C's", len(battles), err)
+	}
+	if record, err := a.WinRateSince(ctx, "#8l9l9gl", time.Time{}); err != nil || record != (battlearchive.Record{Losses: 2}) {
+		t.Errorf("WinRateSince returned %+v, %v, want 2 losses", record, err)
+	}
+}
+
+func TestRecords(t *testing.T) {
+	ctx := context.Background()
+	a := openArchive(t, openDB(t))
+	if _, err := a.Ingest(ctx, []goroyale.Battle{
+		battle(0, "2PP", "A", 1),
+		battle(1, "2PP", "B", 2),
+		battle(2, "C", "2PP", 1), // a loss, from the opponent's side
+		battle(3, "2PP", "D", 0),
+		battle(4, "E", "2PP", -1), // a win, from the opponent's side
+		battle(5, "2PP", "F", 3),
+		battle(6, "2PP", "G", 1),
+		battle(7, "2PP", "H", -1),
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	record, err := a.WinRateSince(ctx, "2PP", time.Time{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := (battlearchive.Record{Wins: 5, Losses: 2, Draws: 1}); record != want {
+		t.Errorf("got %+v, want %+v", record, want)
+	}
+	if record.Played() != 8 || record.WinRate() != 5.0/8 {
+		t.Errorf("played %d, win rate %v", record.Played(), record.WinRate())
+	}
+	if record, err = a.WinRateSince(ctx, "2PP", testStart.Add(5*time.Minute)); err != nil || record != (battlearchive.Record{Wins: 2, Losses: 1}) {
+		t.Errorf("since minute 5 got %+v, %v", record, err)
+	}
+	if streak, err := a.LongestStreak(ctx, "2PP"); err != nil || streak != 3 {
+		t.Errorf("the longest streak is %d, %v, want 3", streak, err)
+	}
+	if record, err := a.WinRateSince(ctx, "NOBODY", time.Time{}); err != nil || record.Played() != 0 || record.WinRate() != 0 {
+		t.Errorf("a player with no battles got %+v, %v", record, err)
+	}
+}
@@ -0,0 +1,226 @@
+package sqlitetest
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/jegfish/goroyale"
+	"github.com/jegfish/goroyale/battlearchive"
+)
+
+// deck makes a deck of cards with the IDs.
+func deck(ids ...int) (cards []goroyale.Card) {
+	for _, id := range ids {
+		cards = append(cards, goroyale.Card{ID: id, Key: fmt.Sprint("card-", id)})
+	}
+	return
+}
+
+var (
+	target  = deck(1, 2, 3, 4, 5, 6, 7, 8)
+	counter = deck(11, 12, 13, 14, 15, 16, 17, 18)
+	lucky   = deck(21, 22, 23, 24, 25, 26, 27, 28)
+)
+
+// deckBattle is a battle between the team playing teamDeck and the opponent playing opponentDeck, with a new pair of players each time.
+func deckBattle(minutes int, teamDeck, opponentDeck []goroyale.Card, winner int) goroyale.Battle {
+	b := battle(minutes, fmt.Sprint("T", minutes), fmt.Sprint("P", minutes), winner)
+	b.Team[0].Deck, b.Opponent[0].Deck = teamDeck, opponentDeck
+	return b
+}
+
+// record appends wins, losses, and draws of the counter deck against the target deck to battles,
+// alternating which side the counter deck is on.
+func record(battles []goroyale.Battle, counterDeck []goroyale.Card, wins, losses, draws int) []goroyale.Battle {
+	add := func(counterWon int) {
+		n := len(battles)
+		if n%2 == 0 {
+			battles = append(battles, deckBattle(n, counterDeck, target, counterWon))
+		} else {
+			battles = append(battles, deckBattle(n, target, counterDeck, -counterWon))
+		}
+	}
+	for i := 0; i < wins; i++ {
+		add(1)
+	}
+	for i := 0; i < losses; i++ {
+		add(-1)
+	}
+	for i := 0; i < draws; i++ {
+		add(0)
+	}
+	return battles
+}
+
+func near(a, b float64) bool {
+	return math.Abs(a-b) < 1e-4
+}
+
+func TestSuggestCounters(t *testing.T) {
+	ctx := context.Background()
+	a := openArchive(t, openDB(t))
+	battles := record(nil, counter, 9, 1, 1)
+	battles = record(battles, lucky, 3, 0, 0)
+	// mirror matches and 2v2 battles don't count
+	battles = append(battles, deckBattle(100, target, target, 1))
+	duo := deckBattle(101, counter, target, 1)
+	duo.Team = append(duo.Team, goroyale.TeamMember{Tag: "MATE", Deck: counter})
+	duo.Opponent = append(duo.Opponent, goroyale.TeamMember{Tag: "OTHER", Deck: target})
+	battles = append(battles, duo)
+	if _, err := a.Ingest(ctx, battles); err != nil {
+		t.Fatal(err)
+	}
+	// ingesting the battles again doesn't count their decks twice
+	if _, err := a.Ingest(ctx, battles); err != nil {
+		t.Fatal(err)
+	}
+
+	counters, err := a.SuggestCounters(ctx, goroyale.DeckHash(target), battlearchive.CounterOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(counters) != 1 {
+		t.Fatalf("got %d counters, want only the one with %d battles", len(counters), battlearchive.DefaultMinBattles)
+	}
+	c := counters[0]
+	if c.DeckHash != goroyale.DeckHash(counter) || c.Record != (battlearchive.Record{Wins: 9, Losses: 1, Draws: 1}) {
+		t.Errorf("got %s with %+v", c.DeckHash, c.Record)
+	}
+	if len(c.Cards) != 8 || c.Cards[0] != "card-11" || c.Cards[7] != "card-18" {
+		t.Errorf("the counter's cards are %v", c.Cards)
+	}
+	// draws are half a win, so 9.5 out of 11, and the Wilson interval of that at 95%
+	if !near(c.WinRate, 9.5/11) || !near(c.Lower, 0.5712) || !near(c.Upper, 0.9679) {
+		t.Errorf("win rate %v in [%v, %v], want 0.8636 in [0.5712, 0.9679]", c.WinRate, c.Lower, c.Upper)
+	}
+
+	// 3 wins out of 3 has a wider interval, so it ranks below 9.5 out of 11
+	counters, err = a.SuggestCounters(ctx, goroyale.DeckHash(target), battlearchive.CounterOptions{MinBattles: 3})
+	if err != nil || len(counters) != 2 {
+		t.Fatalf("got %v, %v, want both decks", counters, err)
+	}
+	if counters[0].DeckHash != goroyale.DeckHash(counter) || counters[1].WinRate != 1 || counters[1].Upper != 1 {
+		t.Errorf("got %+v, want the deck with more battles first", counters)
+	}
+	if counters, err = a.SuggestCounters(ctx, goroyale.DeckHash(target), battlearchive.CounterOptions{MinBattles: 3, Limit: 1}); err != nil || len(counters) != 1 {
+		t.Errorf("a Limit of 1 returned %d counters, %v", len(counters), err)
+	}
+	since := battlearchive.CounterOptions{MinBattles: 1, Since: testStart.Add(11 * time.Minute)}
+	if counters, err = a.SuggestCounters(ctx, goroyale.DeckHash(target), since); err != nil || len(counters) != 1 || counters[0].DeckHash != goroyale.DeckHash(lucky) {
+		t.Errorf("since the lucky deck's battles got %+v, %v", counters, err)
+	}
+}
+
+// oldSchema is the archive before battle_decks and migrations existed.
+const oldSchema = `
+CREATE TABLE battles (
+	key      TEXT PRIMARY KEY,
+	utc_time INTEGER NOT NULL,
+	type     TEXT NOT NULL,
+	mode     TEXT NOT NULL,
+	winner   INTEGER NOT NULL,
+	data     TEXT NOT NULL
+);
+CREATE INDEX battles_utc_time ON battles (utc_time);
+CREATE TABLE battle_players (
+	battle_key TEXT NOT NULL REFERENCES battles (key),
+	tag        TEXT NOT NULL,
+	side       INTEGER NOT NULL,
+	PRIMARY KEY (battle_key, tag)
+);
+CREATE INDEX battle_players_tag ON battle_players (tag);
+`
+
+// insertOld stores battles the way the old archive did.
+func insertOld(tb testing.TB, db *sql.DB, battles []goroyale.Battle) {
+	tb.Helper()
+	for _, b := range battles {
+		data, err := json.Marshal(b)
+		if err != nil {
+			tb.Fatal(err)
+		}
+		key := goroyale.BattleKey(b)
+		if _, err := db.Exec(`INSERT INTO battles (key, utc_time, type, mode, winner, data) VALUES (?, ?, ?, ?, ?, ?)`,
+			key, b.UTCTime, b.Type, b.Mode.Name, b.Winner, string(data)); err != nil {
+			tb.Fatal(err)
+		}
+		for side, members := range [][]goroyale.TeamMember{b.Team, b.Opponent} {
+			for _, m := range members {
+				if _, err := db.Exec(`INSERT INTO battle_players (battle_key, tag, side) VALUES (?, ?, ?)`, key, m.Tag, side); err != nil {
+					tb.Fatal(err)
+				}
+			}
+		}
+	}
+}
+
+func userVersion(tb testing.TB, db *sql.DB) (version int) {
+	tb.Helper()
+	if err := db.QueryRow(`PRAGMA user_version`).Scan(&version); err != nil {
+		tb.Fatal(err)
+	}
+	return
+}
+
+func countDecks(tb testing.TB, db *sql.DB) (n int) {
+	tb.Helper()
+	if err := db.QueryRow(`SELECT COUNT(*) FROM battle_decks`).Scan(&n); err != nil {
+		tb.Fatal(err)
+	}
+	return
+}
+
+func TestMigrateOldArchive(t *testing.T) {
+	ctx := context.Background()
+	db := openDB(t)
+	if _, err := db.Exec(oldSchema); err != nil {
+		t.Fatal(err)
+	}
+	old := record(nil, counter, 9, 1, 0)
+	duo := deckBattle(50, counter, target, 1)
+	duo.Team = append(duo.Team, goroyale.TeamMember{Tag: "MATE", Deck: counter})
+	duo.Opponent = append(duo.Opponent, goroyale.TeamMember{Tag: "OTHER", Deck: target})
+	insertOld(t, db, append(old, duo))
+	if v := userVersion(t, db); v != 0 {
+		t.Fatalf("the old archive is at version %d", v)
+	}
+
+	a := openArchive(t, db)
+	if v := userVersion(t, db); v != 1 {
+		t.Errorf("the migrated archive is at version %d, want 1", v)
+	}
+	// both decks of every 1v1 battle, none of the 2v2 one
+	if n := countDecks(t, db); n != 2*len(old) {
+		t.Errorf("the migration indexed %d decks, want %d", n, 2*len(old))
+	}
+	counters, err := a.SuggestCounters(ctx, goroyale.DeckHash(target), battlearchive.CounterOptions{})
+	if err != nil || len(counters) != 1 || counters[0].Record != (battlearchive.Record{Wins: 9, Losses: 1}) {
+		t.Errorf("counters after the migration are %+v, %v", counters, err)
+	}
+
+	// battles added after the migration are indexed by Ingest, and reopening doesn't migrate again
+	if _, err := a.Ingest(ctx, []goroyale.Battle{deckBattle(60, target, counter, 1)}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := db.Exec(`DELETE FROM battle_decks WHERE battle_key = ?`, goroyale.BattleKey(old[0])); err != nil {
+		t.Fatal(err)
+	}
+	openArchive(t, db)
+	if n := countDecks(t, db); n != 2*len(old) {
+		t.Errorf("reopening the archive left %d decks, want %d: it migrated again", n, 2*len(old))
+	}
+
+	// a migration running over battles that are already indexed leaves their decks alone
+	if _, err := db.Exec(`PRAGMA user_version = 0`); err != nil {
+		t.Fatal(err)
+	}
+	openArchive(t, db)
+	if n := countDecks(t, db); n != 2*len(old)+2 {
+		t.Errorf("migrating again left %d decks, want %d", n, 2*len(old)+2)
+	}
+}
@@ -0,0 +1,6 @@
+// Package sqlitetest tests battlearchive against a real SQLite database.
+//
+// It's its own module so goroyale doesn't depend on a SQLite driver, run its tests from this directory:
+//
+//	cd battlearchive/sqlitetest && go test
+package sqlitetest
@@ -0,0 +1,302 @@
+// Package brackets runs single elimination tournaments played as friendly battles.
+// Matches advance on their own when the battle feed shows a decisive friendly battle between the two players.
+//
+//	b, err := brackets.New("weekly cup", tags, 3)
+//	var m brackets.Manager
+//	m.Add(b)
+//	sub := goroyale.Subscribe[goroyale.NewBattle](bus, goroyale.SubscribeOptions{Tags: tags})
+//	go m.Run(ctx, sub.C)
+//	http.Handle("/brackets", &m)
+package brackets
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"sync"
+
+	"github.com/jegfish/goroyale"
+)
+
+// Match is a match in a bracket. A and B are empty until the players are known.
+type Match struct {
+	Round   int      `json:"round"` // 0 is the first round
+	Index   int      `json:"index"`
+	A       string   `json:"a"`
+	B       string   `json:"b"`
+	WinsA   int      `json:"wins_a"`
+	WinsB   int      `json:"wins_b"`
+	Winner  string   `json:"winner,omitempty"`
+	Battles []string `json:"battles,omitempty"` // keys of the battles counted towards the match
+}
+
+// Done reports whether the match has a winner.
+func (m Match) Done() bool {
+	return m.Winner != ""
+}
+
+// Bracket is a single elimination bracket.
+// It isn't safe for concurrent use, Manager handles that.
+type Bracket struct {
+	Name   string    `json:"name"`
+	BestOf int       `json:"best_of"` // battles per match, the first to win more than half wins the match
+	Rounds [][]Match `json:"rounds"`
+}
+
+// New creates a bracket of players seeded in the order given, the first seed playing the last.
+// Players are given byes when there aren't a power of two of them.
+func New(name string, tags []string, bestOf int) (*Bracket, error) {
+	if len(tags) < 2 {
+		return nil, errors.New("brackets: need at least 2 players")
+	}
+	if bestOf < 1 || bestOf%2 == 0 {
+		return nil, fmt.Errorf("brackets: best of %d, needs to be odd", bestOf)
+	}
+	seen := make(map[string]bool, len(tags))
+	for _, tag := range tags {
+		if tag == "" || seen[tag] {
+			return nil, fmt.Errorf("brackets: empty or duplicate tag %q", tag)
+		}
+		seen[tag] = true
+	}
+
+	size := 2
+	for size < len(tags) {
+		size *= 2
+	}
+	b := &Bracket{Name: name, BestOf: bestOf}
+	for n := size / 2; n >= 1; n /= 2 {
+		round := make([]Match, n)
+		for i := range round {
+			round[i] = Match{Round: len(b.Rounds), Index: i}
+		}
+		b.Rounds = append(b.Rounds, round)
+	}
+
+	seeds := seedOrder(size)
+	first := b.Rounds[0]
+	for i := range first {
+		if s := seeds[2*i]; s < len(tags) {
+			first[i].A = tags[s]
+		}
+		if s := seeds[2*i+1]; s < len(tags) {
+			first[i].B = tags[s]
+		}
+	}
+	// byes go straight through
+	for i := range first {
+		m := &first[i]
+		switch {
+		case m.A != "" && m.B == "":
+			b.finish(m, m.A)
+		case m.B != "" && m.A == "":
+			b.finish(m, m.B)
+		}
+	}
+	return b, nil
+}
+
+// seedOrder returns the seeds of each slot in the first round so the top seeds meet as late as possible.
+func seedOrder(size int) []int {
+	order := []int{0}
+	for n := 1; n < size; n *= 2 {
+		next := make([]int, 0, 2*n)
+		for _, s := range order {
+			next = append(next, s, 2*n-1-s)
+		}
+		order = next
+	}
+	return order
+}
+
+// finish sets the winner of m and moves them to their next match.
+func (b *Bracket) finish(m *Match, winner string) {
+	m.Winner = winner
+	if m.Round+1 >= len(b.Rounds) {
+		return
+	}
+	next := &b.Rounds[m.Round+1][m.Index/2]
+	if m.Index%2 == 0 {
+		next.A = winner
+	} else {
+		next.B = winner
+	}
+}
+
+// Champion returns the winner of the final, or "" if it hasn't been played.
+func (b *Bracket) Champion() string {
+	return b.Rounds[len(b.Rounds)-1][0].Winner
+}
+
+// Open returns the matches that have both players and no winner yet.
+func (b *Bracket) Open() (open []Match) {
+	for _, round := range b.Rounds {
+		for _, m := range round {
+			if m.A != "" && m.B != "" && !m.Done() {
+				open = append(open, m)
+			}
+		}
+	}
+	return
+}
+
+// Ingest counts battle towards the open match between its two players, if there is one.
+// Only decisive 1v1 friendly battles count, and each battle is only counted once.
+// It returns the match it updated.
+func (b *Bracket) Ingest(battle goroyale.Battle) (updated Match, ok bool) {
+	if battle.Type != "friendly" || len(battle.Team) != 1 || len(battle.Opponent) != 1 || battle.Winner == 0 {
+		return
+	}
+	winner, loser := battle.Team[0].Tag, battle.Opponent[0].Tag
+	if battle.Winner < 0 {
+		winner, loser = loser, winner
+	}
+	key := goroyale.BattleKey(battle)
+
+	for r := range b.Rounds {
+		for i := range b.Rounds[r] {
+			m := &b.Rounds[r][i]
+			if m.Done() || !(m.A == winner && m.B == loser || m.A == loser && m.B == winner) {
+				continue
+			}
+			for _, k := range m.Battles {
+				if k == key {
+					return
+				}
+			}
+			m.Battles = append(m.Battles, key)
+			if m.A == winner {
+				m.WinsA++
+			} else {
+				m.WinsB++
+			}
+			needed := b.BestOf/2 + 1
+			if m.WinsA >= needed {
+				b.finish(m, m.A)
+			} else if m.WinsB >= needed {
+				b.finish(m, m.B)
+			}
+			return *m, true
+		}
+	}
+	return
+}
+
+func (b *Bracket) clone() Bracket {
+	c := *b
+	c.Rounds = make([][]Match, len(b.Rounds))
+	for r, round := range b.Rounds {
+		c.Rounds[r] = make([]Match, len(round))
+		for i, m := range round {
+			m.Battles = append([]string(nil), m.Battles...)
+			c.Rounds[r][i] = m
+		}
+	}
+	return c
+}
+
+// Manager runs several brackets off one battle feed. The zero value is ready to use.
+type Manager struct {
+	// OnUpdate is called after a battle is counted towards a match, ex: to announce results.
+	OnUpdate func(bracket string, m Match)
+
+	mu       sync.Mutex
+	brackets map[string]*Bracket
+}
+
+// Add starts managing b, replacing any bracket with the same name.
+func (m *Manager) Add(b *Bracket) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.brackets == nil {
+		m.brackets = make(map[string]*Bracket)
+	}
+	m.brackets[b.Name] = b
+}
+
+// Remove stops managing the bracket with the name.
+func (m *Manager) Remove(name string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.brackets, name)
+}
+
+// Bracket returns a copy of the bracket with the name.
+func (m *Manager) Bracket(name string) (b Bracket, ok bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	bracket, ok := m.brackets[name]
+	if ok {
+		b = bracket.clone()
+	}
+	return
+}
+
+// Brackets returns copies of every bracket sorted by name.
+func (m *Manager) Brackets() []Bracket {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	brackets := make([]Bracket, 0, len(m.brackets))
+	for _, b := range m.brackets {
+		brackets = append(brackets, b.clone())
+	}
+	sort.Slice(brackets, func(i, j int) bool { return brackets[i].Name < brackets[j].Name })
+	return brackets
+}
+
+// Ingest passes battle to every bracket.
+func (m *Manager) Ingest(battle goroyale.Battle) {
+	type update struct {
+		bracket string
+		match   Match
+	}
+	var updates []update
+	m.mu.Lock()
+	for name, b := range m.brackets {
+		if match, ok := b.Ingest(battle); ok {
+			updates = append(updates, update{name, match})
+		}
+	}
+	m.mu.Unlock()
+
+	if m.OnUpdate != nil {
+		for _, u := range updates {
+			m.OnUpdate(u.bracket, u.match)
+		}
+	}
+}
+
+// Run ingests the battles sent on battles until it is closed or ctx is done.
+// The same battle showing up in both players' feeds is only counted once.
+func (m *Manager) Run(ctx context.Context, battles <-chan goroyale.NewBattle) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case e, ok := <-battles:
+			if !ok {
+				return nil
+			}
+			m.Ingest(e.Battle)
+		}
+	}
+}
+
+// ServeHTTP writes the brackets as JSON.
+// With a name query parameter only that bracket is written.
+func (m *Manager) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var v interface{} = m.Brackets()
+	if name := r.URL.Query().Get("name"); name != "" {
+		b, ok := m.Bracket(name)
+		if !ok {
+			http.Error(w, "bracket not found", http.StatusNotFound)
+			return
+		}
+		v = b
+	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(v)
+}
@@ -0,0 +1,332 @@
+package goroyale
+
+import (
+	"bytes"
+	"container/list"
+	"context"
+	"crypto/sha1"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+// DefaultCacheTTL is how long responses are cached when SetCache is given a ttl of 0.
+const DefaultCacheTTL = time.Minute
+
+// Cache stores response bodies by request, see Client.SetCache.
+// Implementations backed by something shared, like Redis or memcached, let several processes share cached data.
+type Cache interface {
+	// Get returns the value for key, ok is false if it isn't cached or has expired.
+	Get(ctx context.Context, key string) (value []byte, ok bool, err error)
+	Set(ctx context.Context, key string, value []byte, ttl time.Duration) error
+}
+
+// LRUCache is an in memory Cache that evicts the least recently used entry once it is full.
+type LRUCache struct {
+	mu      sync.Mutex
+	size    int
+	order   *list.List // front is most recently used
+	entries map[string]*list.Element
+}
+
+type lruEntry struct {
+	key     string
+	value   []byte
+	expires time.Time
+}
+
+// NewLRUCache creates an LRUCache that holds up to size entries.
+func NewLRUCache(size int) *LRUCache {
+	return &LRUCache{size: size, order: list.New(), entries: make(map[string]*list.Element)}
+}
+
+// Get returns the value for key if it is cached and hasn't expired.
+func (l *LRUCache) Get(ctx context.Context, key string) ([]byte, bool, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	el, ok := l.entries[key]
+	if !ok {
+		return nil, false, nil
+	}
+	entry := el.Value.(*lruEntry)
+	if time.Now().After(entry.expires) {
+		l.order.Remove(el)
+		delete(l.entries, key)
+		return nil, false, nil
+	}
+	l.order.MoveToFront(el)
+	return entry.value, true, nil
+}
+
+// Set caches value for ttl, evicting the least recently used entry if the cache is full.
+func (l *LRUCache) Set(ctx context.Context, key string, value []byte, ttl time.Duration) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	entry := &lruEntry{key, value, time.Now().Add(ttl)}
+	if el, ok := l.entries[key]; ok {
+		el.Value = entry
+		l.order.MoveToFront(el)
+		return nil
+	}
+	l.entries[key] = l.order.PushFront(entry)
+	for l.size > 0 && l.order.Len() > l.size {
+		oldest := l.order.Back()
+		l.order.Remove(oldest)
+		delete(l.entries, oldest.Value.(*lruEntry).key)
+	}
+	return nil
+}
+
+// Len returns the number of entries in the cache, including expired ones that haven't been evicted.
+func (l *LRUCache) Len() int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.order.Len()
+}
+
+// DirCache is a Cache that keeps entries as files in a directory, so they survive restarts.
+// Expired files are only removed when they're read.
+type DirCache struct {
+	Dir string
+}
+
+func (d DirCache) path(key string) string {
+	sum := sha1.Sum([]byte(key))
+	return filepath.Join(d.Dir, hex.EncodeToString(sum[:]))
+}
+
+// Get reads the entry for key.
+func (d DirCache) Get(ctx context.Context, key string) ([]byte, bool, error) {
+	path := d.path(key)
+	data, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, err
+	}
+	// the first 8 bytes are the expiry time in unix nanoseconds
+	if len(data) < 8 {
+		return nil, false, nil
+	}
+	if time.Now().UnixNano() > int64(binary.BigEndian.Uint64(data)) {
+		os.Remove(path)
+		return nil, false, nil
+	}
+	return data[8:], true, nil
+}
+
+// Set writes the entry for key, replacing the file atomically.
+func (d DirCache) Set(ctx context.Context, key string, value []byte, ttl time.Duration) error {
+	if err := os.MkdirAll(d.Dir, 0755); err != nil {
+		return err
+	}
+	data := make([]byte, 8, 8+len(value))
+	binary.BigEndian.PutUint64(data, uint64(time.Now().Add(ttl).UnixNano()))
+	data = append(data, value...)
+
+	path := d.path(key)
+	tmp := path + ".tmp"
+	if err := ioutil.WriteFile(tmp, data, 0644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
+
+// LayeredCache checks each of its layers in order, ex: an LRUCache in front of a shared store.
+// Hits in a later layer are copied into the earlier ones so hot keys stay local.
+type LayeredCache struct {
+	Layers []Cache
+	// LocalTTL is how long values found in a later layer are kept in the earlier ones.
+	// It should be short so local copies don't go too far out of date, DefaultCacheTTL if it is 0.
+	LocalTTL time.Duration
+}
+
+// Get returns the value from the first layer that has it.
+// A layer that errors is skipped, the error is only returned if no layer had the value.
+func (l LayeredCache) Get(ctx context.Context, key string) (value []byte, ok bool, err error) {
+	for i, layer := range l.Layers {
+		v, hit, layerErr := layer.Get(ctx, key)
+		if layerErr != nil {
+			err = layerErr
+			continue
+		}
+		if !hit {
+			continue
+		}
+		ttl := l.LocalTTL
+		if ttl == 0 {
+			ttl = DefaultCacheTTL
+		}
+		for _, earlier := range l.Layers[:i] {
+			earlier.Set(ctx, key, v, ttl)
+		}
+		return v, true, nil
+	}
+	return
+}
+
+// Set writes value to every layer, returning the first error.
+func (l LayeredCache) Set(ctx context.Context, key string, value []byte, ttl time.Duration) (err error) {
+	for _, layer := range l.Layers {
+		if layerErr := layer.Set(ctx, key, value, ttl); layerErr != nil && err == nil {
+			err = layerErr
+		}
+	}
+	return
+}
+
+// flightGroup makes concurrent calls for the same key share one result,
+// so a popular key expiring doesn't send a burst of identical requests to the API.
+type flightGroup struct {
+	mu    sync.Mutex
+	calls map[string]*flight
+}
+
+type flight struct {
+	done  chan struct{}
+	value []byte
+	err   error
+	panic interface{} // what fn panicked with, nil if it didn't
+
+	waiters int // callers still waiting, guarded by the group's mu
+	cancel  context.CancelFunc
+}
+
+// do calls fn once for concurrent calls with the same key and gives every caller its result.
+// fn runs on a context detached from the callers' so one of them going away doesn't fail the rest,
+// it's cancelled once none are left waiting. A caller whose ctx ends stops waiting and gets ctx.Err().
+// If fn panics the other callers get an error and the one that started the call panics with the same value.
+func (g *flightGroup) do(ctx context.Context, key string, fn func(context.Context) ([]byte, error)) ([]byte, error) {
+	g.mu.Lock()
+	if g.calls == nil {
+		g.calls = make(map[string]*flight)
+	}
+	f, joined := g.calls[key]
+	if !joined {
+		fctx, cancel := context.WithCancel(context.WithoutCancel(ctx))
+		f = &flight{done: make(chan struct{}), cancel: cancel}
+		g.calls[key] = f
+		go g.run(fctx, key, f, fn)
+	}
+	f.waiters++
+	g.mu.Unlock()
+
+	select {
+	case <-f.done:
+	case <-ctx.Done():
+		g.mu.Lock()
+		if f.waiters--; f.waiters == 0 {
+			// callers that come later start over instead of getting the cancelled call's error
+			if g.calls[key] == f {
+				delete(g.calls, key)
+			}
+			f.cancel()
+		}
+		g.mu.Unlock()
+		return nil, ctx.Err()
+	}
+	if f.panic != nil && !joined {
+		panic(f.panic)
+	}
+	return f.value, f.err
+}
+
+func (g *flightGroup) run(ctx context.Context, key string, f *flight, fn func(context.Context) ([]byte, error)) {
+	defer func() {
+		if r := recover(); r != nil {
+			f.panic = r
+			f.err = fmt.Errorf("goroyale: fetching %s panicked: %v", key, r)
+		}
+		g.mu.Lock()
+		if g.calls[key] == f {
+			delete(g.calls, key)
+		}
+		g.mu.Unlock()
+		f.cancel()
+		close(f.done)
+	}()
+	f.value, f.err = fn(ctx)
+}
+
+// CacheGroup gets values through a Cache, calling fetch on a miss.
+// Concurrent misses for the same key share one call to fetch, so a popular key expiring only sends one request
+// upstream. Client.SetCache uses one for responses, it's exported for caching anything else the same way,
+// ex: goroyale-proxy caches whole responses, headers included, with one.
+type CacheGroup struct {
+	Cache  Cache
+	flight flightGroup
+}
+
+// Get returns the value for key from the cache, or from fetch, caching what it returns for ttl.
+// Values with a ttl of 0 or less aren't cached. hit is true when the value came from the cache.
+// Errors from the cache are ignored and fetch is called, errors from fetch are returned and nothing is cached.
+//
+// fetch's context isn't ctx: it keeps ctx's values but is only cancelled once every caller sharing the fetch has
+// gone, so a caller that gives up doesn't fail the others. A caller whose ctx ends returns ctx.Err() straight away.
+// If fetch panics, the callers sharing it get an error and the one whose Get called fetch panics too.
+func (g *CacheGroup) Get(ctx context.Context, key string, fetch func(ctx context.Context) (value []byte, ttl time.Duration, err error)) (value []byte, hit bool, err error) {
+	if v, ok, cacheErr := g.Cache.Get(ctx, key); cacheErr == nil && ok {
+		return v, true, nil
+	}
+	value, err = g.flight.do(ctx, key, func(ctx context.Context) ([]byte, error) {
+		v, ttl, err := fetch(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if ttl > 0 {
+			g.Cache.Set(ctx, key, v, ttl)
+		}
+		return v, nil
+	})
+	return
+}
+
+type responseCache struct {
+	group CacheGroup
+	ttl   time.Duration
+}
+
+// SetCache caches successful responses in cache for ttl, DefaultCacheTTL if it is 0.
+// Concurrent requests for the same path and params share one request to the API.
+// Errors from the cache are ignored and the request goes to the API.
+// Pass a nil cache to turn caching off.
+func (c *Client) SetCache(cache Cache, ttl time.Duration) {
+	if cache == nil {
+		c.cache = nil
+		return
+	}
+	if ttl == 0 {
+		ttl = DefaultCacheTTL
+	}
+	c.cache = &responseCache{group: CacheGroup{Cache: cache}, ttl: ttl}
+}
+
+func cacheKey(path string, params url.Values) string {
+	if len(params) == 0 {
+		return path
+	}
+	return path + "?" + params.Encode()
+}
+
+// doCached works like do but goes through the cache.
+func (c *Client) doCached(ctx context.Context, cache *responseCache, path string, params url.Values, buf *bytes.Buffer) error {
+	b, _, err := cache.group.Get(ctx, cacheKey(path, params), func(ctx context.Context) ([]byte, time.Duration, error) {
+		var body bytes.Buffer
+		if err := c.doUncached(ctx, path, params, &body); err != nil {
+			return nil, 0, err
+		}
+		return body.Bytes(), cache.ttl, nil
+	})
+	if err != nil {
+		return err
+	}
+	buf.Write(b)
+	return nil
+}
@@ -0,0 +1,158 @@
+package goroyale
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+// waitWaiters waits until n callers are waiting on the call for key.
+func waitWaiters(tb testing.TB, g *CacheGroup, key string, n int) {
+	tb.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		g.flight.mu.Lock()
+		f := g.flight.calls[key]
+		waiting := f != nil && f.waiters >= n
+		g.flight.mu.Unlock()
+		if waiting {
+			return
+		}
+		if time.Now().After(deadline) {
+			tb.Fatalf("%d callers never waited on %q", n, key)
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+type getResult struct {
+	value []byte
+	err   error
+}
+
+func TestCacheGroupLeaderCancels(t *testing.T) {
+	g := &CacheGroup{Cache: NewLRUCache(10)}
+	release := make(chan struct{})
+	fetchErr := make(chan error, 1)
+	fetch := func(ctx context.Context) ([]byte, time.Duration, error) {
+		select {
+		case <-release:
+			return []byte("value"), time.Minute, nil
+		case <-ctx.Done():
+			fetchErr <- ctx.Err()
+			return nil, 0, ctx.Err()
+		}
+	}
+
+	leaderCtx, cancelLeader := context.WithCancel(context.Background())
+	leader := make(chan getResult, 1)
+	go func() {
+		v, _, err := g.Get(leaderCtx, "key", fetch)
+		leader <- getResult{v, err}
+	}()
+	waitWaiters(t, g, "key", 1)
+	waiter := make(chan getResult, 1)
+	go func() {
+		v, _, err := g.Get(context.Background(), "key", func(context.Context) ([]byte, time.Duration, error) {
+			t.Error("the second caller fetched instead of sharing the first's fetch")
+			return nil, 0, nil
+		})
+		waiter <- getResult{v, err}
+	}()
+	waitWaiters(t, g, "key", 2)
+
+	cancelLeader()
+	if r := <-leader; !errors.Is(r.err, context.Canceled) {
+		t.Errorf("the cancelled caller got %q, %v, want context.Canceled", r.value, r.err)
+	}
+	close(release)
+	if r := <-waiter; r.err != nil || string(r.value) != "value" {
+		t.Errorf("the caller that stayed got %q, %v, want the value", r.value, r.err)
+	}
+	select {
+	case err := <-fetchErr:
+		t.Errorf("the fetch was cancelled with %v while a caller was still waiting", err)
+	default:
+	}
+	if v, hit, _ := g.Get(context.Background(), "key", fetch); !hit || string(v) != "value" {
+		t.Errorf("got %q, hit %v, want the cached value", v, hit)
+	}
+}
+
+func TestCacheGroupEveryoneLeaves(t *testing.T) {
+	g := &CacheGroup{Cache: NewLRUCache(10)}
+	fetchErr := make(chan error, 1)
+	fetch := func(ctx context.Context) ([]byte, time.Duration, error) {
+		<-ctx.Done()
+		fetchErr <- ctx.Err()
+		return nil, 0, ctx.Err()
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 2)
+	for i := 0; i < 2; i++ {
+		go func() {
+			_, _, err := g.Get(ctx, "key", fetch)
+			done <- err
+		}()
+	}
+	waitWaiters(t, g, "key", 2)
+	cancel()
+	for i := 0; i < 2; i++ {
+		if err := <-done; !errors.Is(err, context.Canceled) {
+			t.Errorf("got %v, want context.Canceled", err)
+		}
+	}
+	select {
+	case <-fetchErr:
+	case <-time.After(5 * time.Second):
+		t.Fatal("the fetch wasn't cancelled after every caller left")
+	}
+
+	// the next caller starts a new fetch instead of getting the cancelled one's error
+	v, _, err := g.Get(context.Background(), "key", func(context.Context) ([]byte, time.Duration, error) {
+		return []byte("again"), 0, nil
+	})
+	if err != nil || string(v) != "again" {
+		t.Errorf("got %q, %v after the cancelled fetch", v, err)
+	}
+}
+
+func TestCacheGroupPanic(t *testing.T) {
+	g := &CacheGroup{Cache: NewLRUCache(10)}
+	release := make(chan struct{})
+	leader := make(chan interface{}, 1)
+	go func() {
+		defer func() { leader <- recover() }()
+		g.Get(context.Background(), "key", func(context.Context) ([]byte, time.Duration, error) {
+			<-release
+			panic("boom")
+		})
+	}()
+	waitWaiters(t, g, "key", 1)
+	waiter := make(chan error, 1)
+	go func() {
+		_, _, err := g.Get(context.Background(), "key", nil)
+		waiter <- err
+	}()
+	waitWaiters(t, g, "key", 2)
+	close(release)
+
+	if r := <-leader; r != "boom" {
+		t.Errorf("the caller that started the fetch recovered %v, want the fetch's panic", r)
+	}
+	select {
+	case err := <-waiter:
+		if err == nil {
+			t.Error("the waiting caller didn't get an error for the panic")
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("the waiting caller is stuck after the fetch panicked")
+	}
+	v, _, err := g.Get(context.Background(), "key", func(context.Context) ([]byte, time.Duration, error) {
+		return []byte("value"), 0, nil
+	})
+	if err != nil || string(v) != "value" {
+		t.Errorf("got %q, %v after the fetch panicked", v, err)
+	}
+}
@@ -0,0 +1,133 @@
+package goroyale
+
+import "sort"
+
+// CardUsage is how often a card shows up in a set of decks.
+type CardUsage struct {
+	Key   string
+	Name  string
+	Decks int     // weighted number of decks the card is in
+	Rate  float64 // Decks out of every deck, 0-1
+}
+
+// CardPair is how often two cards show up in the same deck.
+// A is always the key that sorts first.
+type CardPair struct {
+	A, B  string
+	Decks int
+	Rate  float64
+}
+
+// CardStats is card usage over a set of decks, for building tier lists.
+type CardStats struct {
+	Decks int // weighted number of decks counted
+	Cards []CardUsage
+	Pairs []CardPair
+}
+
+type cardStatsBuilder struct {
+	total int
+	cards map[string]*CardUsage
+	pairs map[[2]string]int
+}
+
+// add counts a deck, names maps each of the deck's card keys to the card's name.
+func (b *cardStatsBuilder) add(names map[string]string, weight int) {
+	if b.cards == nil {
+		b.cards = make(map[string]*CardUsage)
+		b.pairs = make(map[[2]string]int)
+	}
+	b.total += weight
+
+	keys := make([]string, 0, len(names))
+	for key := range names {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for i, key := range keys {
+		usage, ok := b.cards[key]
+		if !ok {
+			usage = &CardUsage{Key: key, Name: names[key]}
+			b.cards[key] = usage
+		}
+		usage.Decks += weight
+		for j := i + 1; j < len(keys); j++ {
+			b.pairs[[2]string{key, keys[j]}] += weight
+		}
+	}
+}
+
+func (b *cardStatsBuilder) build() (stats CardStats) {
+	stats.Decks = b.total
+	rate := func(n int) float64 {
+		if b.total == 0 {
+			return 0
+		}
+		return float64(n) / float64(b.total)
+	}
+	for _, usage := range b.cards {
+		usage.Rate = rate(usage.Decks)
+		stats.Cards = append(stats.Cards, *usage)
+	}
+	for pair, n := range b.pairs {
+		stats.Pairs = append(stats.Pairs, CardPair{A: pair[0], B: pair[1], Decks: n, Rate: rate(n)})
+	}
+	sort.Slice(stats.Cards, func(i, j int) bool {
+		if stats.Cards[i].Decks != stats.Cards[j].Decks {
+			return stats.Cards[i].Decks > stats.Cards[j].Decks
+		}
+		return stats.Cards[i].Key < stats.Cards[j].Key
+	})
+	sort.Slice(stats.Pairs, func(i, j int) bool {
+		a, b := stats.Pairs[i], stats.Pairs[j]
+		if a.Decks != b.Decks {
+			return a.Decks > b.Decks
+		}
+		if a.A != b.A {
+			return a.A < b.A
+		}
+		return a.B < b.B
+	})
+	return
+}
+
+// PairsWith returns the pairs that include the card key, most common first.
+func (s CardStats) PairsWith(key string) []CardPair {
+	pairs := []CardPair{}
+	for _, p := range s.Pairs {
+		if p.A == key || p.B == key {
+			pairs = append(pairs, p)
+		}
+	}
+	return pairs
+}
+
+// CardStatsFromPopularDecks counts card usage in decks, weighting each deck by its popularity.
+func CardStatsFromPopularDecks(decks []PopularDeck) CardStats {
+	var b cardStatsBuilder
+	for _, d := range decks {
+		names := make(map[string]string, len(d.Cards))
+		for _, card := range d.Cards {
+			names[card.Key] = card.Name
+		}
+		b.add(names, d.Popularity.Hits.Int())
+	}
+	return b.build()
+}
+
+// CardStatsFromPlayers counts card usage in the CurrentDeck of each player.
+// Use this with the players from TopPlayers to get usage at the top of the ladder.
+func CardStatsFromPlayers(players []Player) CardStats {
+	var b cardStatsBuilder
+	for _, p := range players {
+		if len(p.CurrentDeck) == 0 {
+			continue
+		}
+		names := make(map[string]string, len(p.CurrentDeck))
+		for _, card := range p.CurrentDeck {
+			names[card.Key] = card.Name
+		}
+		b.add(names, 1)
+	}
+	return b.build()
+}
@@ -0,0 +1,119 @@
+package goroyale
+
+import (
+	"math"
+	"strings"
+)
+
+// ChestContents is what a chest is expected to hold in an arena, from the treasure chests in GameData.
+// Card counts are averages so they aren't whole numbers.
+type ChestContents struct {
+	Chest string
+	Arena int // like ConstantsArena.Arena
+
+	Cards     float64
+	Common    float64
+	Rare      float64
+	Epic      float64
+	Legendary float64
+	// LegendaryChance is the probability of getting at least one legendary card, 0-1.
+	LegendaryChance float64
+
+	MinGold int
+	MaxGold int
+	// Gems is the chest's price in the shop, 0 if it isn't sold there.
+	Gems int
+}
+
+// CardsPerGem returns how many cards each gem spent on the chest buys, 0 if it isn't sold in the shop.
+func (o ChestContents) CardsPerGem() float64 {
+	if o.Gems == 0 {
+		return 0
+	}
+	return o.Cards / float64(o.Gems)
+}
+
+// chestName normalizes chest names so "Super Magical", "superMagical", and "Super Magical Chest" all match.
+func chestName(name string) string {
+	name = strings.ToLower(strings.Replace(name, " ", "", -1))
+	return strings.TrimSuffix(name, "chest")
+}
+
+// ChestOdds returns the odds of the chest in the arena, ex: ChestOdds("Magical", 10).
+// Arenas past the last one in GameData, like the leagues, use the odds of the last one.
+// ok is false if the chest isn't in GameData.
+func ChestOdds(chest string, arena int) (odds ChestContents, ok bool) {
+	constants, err := GameData()
+	if err != nil {
+		return
+	}
+	name := chestName(chest)
+	for _, c := range constants.TreasureChests.Cycle {
+		if chestName(c.Name) != name {
+			continue
+		}
+		best := -1
+		for i, a := range c.Arenas {
+			if a.Arena <= arena && (best < 0 || a.Arena > c.Arenas[best].Arena) {
+				best = i
+			}
+		}
+		if best < 0 {
+			return
+		}
+		a := c.Arenas[best]
+		odds = ChestContents{
+			Chest:     c.Name,
+			Arena:     a.Arena,
+			Cards:     a.CardCoundByArena,
+			Common:    a.CardCountCommon,
+			Rare:      a.CardCountRare,
+			Epic:      a.CardCountEpic,
+			Legendary: a.CardCountLegendary,
+		}
+		if odds.Common < 0 || odds.Rare < 0 || odds.Epic < 0 {
+			// chests of a single rarity, like Epic chests, list nonsense for the rarities below it
+			odds.fixedRarity()
+		}
+		multiplier := float64(a.ChestRewardMultiplier) / 100
+		odds.MinGold = int(float64(c.MinGold) * multiplier)
+		odds.MaxGold = int(float64(c.MaxGold) * multiplier)
+		ok = true
+		break
+	}
+	if !ok {
+		return
+	}
+
+	switch {
+	case odds.Legendary >= odds.Cards && odds.Cards > 0:
+		odds.LegendaryChance = 1
+	default:
+		// legendaries are rare enough per card for the count to be roughly Poisson distributed
+		odds.LegendaryChance = 1 - math.Exp(-odds.Legendary)
+	}
+	for _, c := range constants.TreasureChests.Shop {
+		if chestName(c.Name) == name {
+			odds.Gems = c.ShopPriceWithoutSpeedUp
+		}
+	}
+	return
+}
+
+// fixedRarity puts every card of the chest in the rarest rarity that has all of them.
+func (o *ChestContents) fixedRarity() {
+	counts := []*float64{&o.Legendary, &o.Epic, &o.Rare, &o.Common}
+	fixed := -1
+	for i, n := range counts {
+		if fixed < 0 && *n >= o.Cards {
+			fixed = i
+		}
+	}
+	for i, n := range counts {
+		if i == fixed {
+			*n = o.Cards
+		} else {
+			*n = 0
+		}
+	}
+}
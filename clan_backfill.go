@@ -0,0 +1,120 @@
+package goroyale
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// DefaultBackfillChunk is how much history BackfillClanHistory asks for per request.
+const DefaultBackfillChunk = 7 * 24 * time.Hour
+
+// DefaultBackfillGap is how many empty chunks in a row BackfillClanHistory will step over before deciding it has run out of history.
+const DefaultBackfillGap = 4
+
+// backfillRateLimitRetries is how many times a chunk is retried after a 429.
+const backfillRateLimitRetries = 5
+
+// BackfillOptions configures BackfillClanHistory.
+type BackfillOptions struct {
+	Since  time.Time     // oldest history wanted, zero goes back as far as the API has
+	Until  time.Time     // newest history wanted, the current time if it is zero
+	Chunk  time.Duration // DefaultBackfillChunk if it is 0
+	Weekly bool          // walk ClanWeeklyHistory instead of ClanHistory
+	// Gap is how many empty chunks in a row are treated as a hole in the history rather than its end.
+	// DefaultBackfillGap if it is 0.
+	Gap int
+}
+
+// BackfillClanHistory walks a clan's history backwards from opts.Until, one chunk at a time,
+// calling fn with every entry it finds.
+// Chunks are visited newest first but the entries within a chunk are passed to fn oldest first.
+// Each entry is only passed once, even if the API returns it in more than one chunk.
+//
+// Requests go through the Client's ratelimit, and a chunk that gets a 429 is
+// tried again once the ratelimit resets.
+// It stops when it reaches opts.Since, when opts.Gap chunks in a row are empty,
+// when fn returns an error, or when ctx is done, and returns how many entries were passed to fn.
+// A clan without tracking fails straight away with a NotTrackedError.
+func (c *Client) BackfillClanHistory(ctx context.Context, tag string, opts BackfillOptions, fn func(ClanHistoryEntry) error) (n int, err error) {
+	chunk := opts.Chunk
+	if chunk <= 0 {
+		chunk = DefaultBackfillChunk
+	}
+	gap := opts.Gap
+	if gap <= 0 {
+		gap = DefaultBackfillGap
+	}
+	end := opts.Until
+	if end.IsZero() {
+		end = c.now()
+	}
+
+	seen := make(map[int64]bool)
+	for empty := 0; empty < gap; {
+		if !opts.Since.IsZero() && !end.After(opts.Since) {
+			return
+		}
+		start := end.Add(-chunk)
+		if !opts.Since.IsZero() && start.Before(opts.Since) {
+			start = opts.Since
+		}
+
+		var history ClanHistory
+		if history, err = c.backfillChunk(ctx, tag, start, end, opts.Weekly); err != nil {
+			return
+		}
+		// the range is a hint, don't trust the API to stick to it
+		history = history.Between(start, end)
+
+		var found bool
+		for _, entry := range history {
+			if seen[entry.Time.Unix()] {
+				continue
+			}
+			seen[entry.Time.Unix()] = true
+			found = true
+			if err = fn(entry); err != nil {
+				return
+			}
+			n++
+		}
+		if found {
+			empty = 0
+		} else {
+			empty++
+		}
+		end = start
+	}
+	return
+}
+
+// backfillChunk requests the history from start up to end, waiting out 429s.
+func (c *Client) backfillChunk(ctx context.Context, tag string, start, end time.Time, weekly bool) (history ClanHistory, err error) {
+	params := url.Values{}
+	params.Set("from", strconv.FormatInt(start.Unix(), 10))
+	params.Set("to", strconv.FormatInt(end.Unix(), 10))
+
+	client := c.WithContext(ctx)
+	for retry := 0; ; retry++ {
+		if weekly {
+			history, err = client.ClanWeeklyHistory(tag, params)
+		} else {
+			history, err = client.ClanHistory(tag, params)
+		}
+		var apiErr APIError
+		if !errors.As(err, &apiErr) || apiErr.StatusCode != 429 || retry >= backfillRateLimitRetries {
+			return
+		}
+
+		wait := time.Second
+		if reset := c.Usage().Reset; reset.After(c.now()) {
+			wait = reset.Sub(c.now())
+		}
+		if sleepErr := sleepContext(ctx, c.clock(), wait); sleepErr != nil {
+			return
+		}
+	}
+}
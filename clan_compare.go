@@ -0,0 +1,137 @@
+package goroyale
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// ClanComparison puts two clans side by side, see CompareClans.
+type ClanComparison struct {
+	A, B       ClanStats
+	WarA, WarB WarTrend // zero if the clan's war log wasn't given
+	// Shared are the players who show up in both clans, as members or in their war logs, ordered by tag.
+	// Clan families use it to catch players hopping between feeder clans.
+	Shared []SharedMember
+}
+
+// WarTrend is how a clan has done in the wars of a war log.
+type WarTrend struct {
+	Wars     int
+	Trophies int   // war trophies after the newest war
+	Change   int   // war trophies won or lost over all the wars
+	Changes  []int // each war's change, oldest first
+	// Participants is the average number of members that took part in a war.
+	Participants float64
+}
+
+// SharedMember is a player who shows up in both clans of a ClanComparison.
+type SharedMember struct {
+	Tag                  string
+	Name                 string
+	MemberOfA, MemberOfB bool
+	WarsA, WarsB         int // wars they fought for each clan, in the given war logs
+}
+
+// CompareClans compares a and b.
+// warlogs are the war logs of a and then b, ex: from ClanWarLog, they can be left out to only compare members.
+func CompareClans(a, b Clan, warlogs ...[]ClanWarLogEntry) (cmp ClanComparison) {
+	cmp.A, cmp.B = AggregateClan(a), AggregateClan(b)
+	var warlogA, warlogB []ClanWarLogEntry
+	if len(warlogs) > 0 {
+		warlogA = warlogs[0]
+	}
+	if len(warlogs) > 1 {
+		warlogB = warlogs[1]
+	}
+	cmp.WarA, cmp.WarB = newWarTrend(a.Tag, warlogA), newWarTrend(b.Tag, warlogB)
+
+	shared := make(map[string]*SharedMember)
+	player := func(tag, name string) *SharedMember {
+		tag = NormalizeTag(tag)
+		s, ok := shared[tag]
+		if !ok {
+			s = &SharedMember{Tag: tag, Name: name}
+			shared[tag] = s
+		}
+		if s.Name == "" {
+			s.Name = name
+		}
+		return s
+	}
+	for _, m := range a.Members {
+		player(m.Tag, m.Name).MemberOfA = true
+	}
+	for _, m := range b.Members {
+		player(m.Tag, m.Name).MemberOfB = true
+	}
+	for _, war := range warlogA {
+		for _, p := range war.Participants {
+			player(p.Tag, p.Name).WarsA++
+		}
+	}
+	for _, war := range warlogB {
+		for _, p := range war.Participants {
+			player(p.Tag, p.Name).WarsB++
+		}
+	}
+	for _, s := range shared {
+		if (s.MemberOfA || s.WarsA > 0) && (s.MemberOfB || s.WarsB > 0) {
+			cmp.Shared = append(cmp.Shared, *s)
+		}
+	}
+	sort.Slice(cmp.Shared, func(i, j int) bool { return cmp.Shared[i].Tag < cmp.Shared[j].Tag })
+	return
+}
+
+func newWarTrend(tag string, warlog []ClanWarLogEntry) (trend WarTrend) {
+	wars := append([]ClanWarLogEntry(nil), warlog...)
+	sort.SliceStable(wars, func(i, j int) bool { return wars[i].CreatedDate < wars[j].CreatedDate })
+	tag = NormalizeTag(tag)
+	var participants int
+	for _, war := range wars {
+		for _, standing := range war.Standings {
+			if NormalizeTag(standing.Tag) != tag {
+				continue
+			}
+			trend.Wars++
+			trend.Trophies = standing.WarTrophies
+			trend.Change += standing.WarTrophiesChange
+			trend.Changes = append(trend.Changes, standing.WarTrophiesChange)
+			participants += standing.Participants
+			break
+		}
+	}
+	if trend.Wars > 0 {
+		trend.Participants = float64(participants) / float64(trend.Wars)
+	}
+	return
+}
+
+// String renders the comparison as plain text, one metric per line with a column per clan.
+func (cmp ClanComparison) String() string {
+	var b strings.Builder
+	row := func(name string, inA, inB interface{}) {
+		fmt.Fprintf(&b, "%-18s %16v %16v\n", name, inA, inB)
+	}
+	f := func(v float64) string { return fmt.Sprintf("%.1f", v) }
+
+	row("", cmp.A.Name, cmp.B.Name)
+	row("Members", cmp.A.Members, cmp.B.Members)
+	row("Average trophies", f(cmp.A.AverageTrophies), f(cmp.B.AverageTrophies))
+	row("Median trophies", f(cmp.A.MedianTrophies), f(cmp.B.MedianTrophies))
+	row("Average donations", f(cmp.A.AverageDonations), f(cmp.B.AverageDonations))
+	row("Median donations", f(cmp.A.MedianDonations), f(cmp.B.MedianDonations))
+	if cmp.WarA.Wars > 0 || cmp.WarB.Wars > 0 {
+		row("War trophies", cmp.WarA.Trophies, cmp.WarB.Trophies)
+		row("War trophy change", fmt.Sprintf("%+d", cmp.WarA.Change), fmt.Sprintf("%+d", cmp.WarB.Change))
+		row("War participants", f(cmp.WarA.Participants), f(cmp.WarB.Participants))
+	}
+	if len(cmp.Shared) > 0 {
+		fmt.Fprintf(&b, "In both clans (%d):\n", len(cmp.Shared))
+		for _, s := range cmp.Shared {
+			fmt.Fprintf(&b, "%s (#%s)\n", s.Name, s.Tag)
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,164 @@
+package goroyale
+
+import (
+	"encoding/json"
+	"math"
+	"sort"
+	"strconv"
+	"time"
+)
+
+// ClanHistory is the time series returned by the clan history endpoints.
+// The API returns an object keyed by timestamp, ClanHistory turns that into a slice sorted from oldest to newest.
+type ClanHistory []ClanHistoryEntry
+
+// UnmarshalJSON parses the timestamp keys into ClanHistoryEntry.Time and sorts the entries.
+func (h *ClanHistory) UnmarshalJSON(b []byte) error {
+	var raw map[string]ClanHistoryEntry
+	if err := json.Unmarshal(b, &raw); err != nil {
+		return err
+	}
+
+	history := make(ClanHistory, 0, len(raw))
+	for key, entry := range raw {
+		t, err := parseHistoryKey(key)
+		if err != nil {
+			return err
+		}
+		entry.Time = t
+		history = append(history, entry)
+	}
+	history.Sort()
+	*h = history
+	return nil
+}
+
+// MarshalJSON writes h back out in the same shape as the API, keyed by unix timestamp.
+func (h ClanHistory) MarshalJSON() ([]byte, error) {
+	raw := make(map[string]ClanHistoryEntry, len(h))
+	for _, entry := range h {
+		raw[strconv.FormatInt(entry.Time.Unix(), 10)] = entry
+	}
+	return json.Marshal(raw)
+}
+
+// parseHistoryKey accepts both unix timestamps and RFC 3339 dates since the API has used both.
+func parseHistoryKey(key string) (time.Time, error) {
+	if sec, err := strconv.ParseInt(key, 10, 64); err == nil {
+		return time.Unix(sec, 0).UTC(), nil
+	}
+	if t, err := time.Parse(time.RFC3339, key); err == nil {
+		return t, nil
+	}
+	return time.Parse("2006-01-02T15:04:05", key)
+}
+
+// Sort orders the entries from oldest to newest.
+func (h ClanHistory) Sort() {
+	sort.SliceStable(h, func(i, j int) bool {
+		return h[i].Time.Before(h[j].Time)
+	})
+}
+
+// Between returns the entries from start up to but not including end.
+// h must be sorted.
+func (h ClanHistory) Between(start, end time.Time) ClanHistory {
+	i := sort.Search(len(h), func(i int) bool { return !h[i].Time.Before(start) })
+	j := sort.Search(len(h), func(i int) bool { return !h[i].Time.Before(end) })
+	if j < i {
+		return ClanHistory{}
+	}
+	return h[i:j]
+}
+
+// Since returns the entries at or after t.
+// h must be sorted.
+func (h ClanHistory) Since(t time.Time) ClanHistory {
+	i := sort.Search(len(h), func(i int) bool { return !h[i].Time.Before(t) })
+	return h[i:]
+}
+
+// Last returns the n newest entries.
+func (h ClanHistory) Last(n int) ClanHistory {
+	if n >= len(h) {
+		return h
+	}
+	if n < 0 {
+		n = 0
+	}
+	return h[len(h)-n:]
+}
+
+// Latest returns the newest entry.
+// ok will be false if h is empty.
+func (h ClanHistory) Latest() (entry ClanHistoryEntry, ok bool) {
+	if len(h) == 0 {
+		return
+	}
+	return h[len(h)-1], true
+}
+
+// MemberSnapshot is one member's stats at a single point in a ClanHistory.
+type MemberSnapshot struct {
+	ClanHistoryMember
+
+	Time time.Time
+	// Interpolated is true if the member was missing from this snapshot and the stats were estimated
+	// from the snapshots on either side of it.
+	Interpolated bool
+}
+
+// PivotMembers reshapes history into a series of snapshots per member, keyed by member tag.
+// Each series is ordered oldest to newest.
+// If interpolate is true, snapshots a member is missing from are filled in,
+// but only between two snapshots they appear in since they may not have been in the clan before or after.
+func PivotMembers(history ClanHistory, interpolate bool) map[string][]MemberSnapshot {
+	// index of every entry the member was seen in, so gaps can be found
+	seen := make(map[string][]int)
+	series := make(map[string][]MemberSnapshot)
+	for i, entry := range history {
+		for _, m := range entry.Members {
+			seen[m.Tag] = append(seen[m.Tag], i)
+			series[m.Tag] = append(series[m.Tag], MemberSnapshot{ClanHistoryMember: m, Time: entry.Time})
+		}
+	}
+	if !interpolate {
+		return series
+	}
+
+	for tag, indexes := range seen {
+		known := series[tag]
+		filled := make([]MemberSnapshot, 0, indexes[len(indexes)-1]-indexes[0]+1)
+		for k := range known {
+			filled = append(filled, known[k])
+			if k == len(known)-1 {
+				break
+			}
+			from, to := indexes[k], indexes[k+1]
+			for i := from + 1; i < to; i++ {
+				frac := float64(i-from) / float64(to-from)
+				filled = append(filled, interpolateMember(known[k], known[k+1], history[i].Time, frac))
+			}
+		}
+		series[tag] = filled
+	}
+	return series
+}
+
+func interpolateMember(a, b MemberSnapshot, t time.Time, frac float64) MemberSnapshot {
+	lerp := func(x, y int) int {
+		return x + int(math.Round(float64(y-x)*frac))
+	}
+	return MemberSnapshot{
+		ClanHistoryMember: ClanHistoryMember{
+			ClanRank:  lerp(a.ClanRank, b.ClanRank),
+			Crowns:    lerp(a.Crowns, b.Crowns),
+			Donations: lerp(a.Donations, b.Donations),
+			Name:      a.Name,
+			Tag:       a.Tag,
+			Trophies:  lerp(a.Trophies, b.Trophies),
+		},
+		Time:         t,
+		Interpolated: true,
+	}
+}
@@ -0,0 +1,38 @@
+package goroyale
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func FuzzClanHistory(f *testing.F) {
+	for _, seed := range []string{
+		`{"1527854400":{"donations":1200,"memberCount":50},"1527768000":{"donations":900,"memberCount":49}}`,
+		`{"2018-06-01T12:00:00Z":{"donations":1200},"2018-05-31T12:00:00+02:00":{}}`,
+		`{"2018-06-01T12:00:00":{"members":[{"tag":"2PP","name":"Bob"}]}}`,
+		`{"yesterday":{}}`,
+		`{}`,
+		`[]`,
+	} {
+		f.Add([]byte(seed))
+	}
+	f.Fuzz(func(t *testing.T, data []byte) {
+		var h ClanHistory
+		if err := json.Unmarshal(data, &h); err != nil {
+			return
+		}
+		for i := 1; i < len(h); i++ {
+			if h[i].Time.Before(h[i-1].Time) {
+				t.Fatalf("entry %d at %v is before entry %d at %v", i, h[i].Time, i-1, h[i-1].Time)
+			}
+		}
+		out, err := json.Marshal(h)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var again ClanHistory
+		if err := json.Unmarshal(out, &again); err != nil {
+			t.Fatalf("can't decode %q, from MarshalJSON: %v", out, err)
+		}
+	})
+}
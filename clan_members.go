@@ -0,0 +1,85 @@
+package goroyale
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+)
+
+// clanMembersWorkers is how many Player requests ClanMembersFull has in flight at once.
+// The ratelimit is still what decides how fast they go out.
+const clanMembersWorkers = 5
+
+// MembersError is returned by ClanMembersFull when some members couldn't be fetched.
+type MembersError struct {
+	Clan   string
+	Errors map[string]error // by member tag
+}
+
+func (err MembersError) Error() string {
+	tags := make([]string, 0, len(err.Errors))
+	for tag := range err.Errors {
+		tags = append(tags, tag)
+	}
+	sort.Strings(tags)
+	return fmt.Sprintf("%d members of clan %s couldn't be fetched, %s: %v", len(tags), err.Clan, tags[0], err.Errors[tags[0]])
+}
+
+// Unwrap returns the error of every member that couldn't be fetched.
+func (err MembersError) Unwrap() []error {
+	errs := make([]error, 0, len(err.Errors))
+	for _, e := range err.Errors {
+		errs = append(errs, e)
+	}
+	return errs
+}
+
+// ClanMembersFull returns the full Player of every member of the clan, in the same order as Clan.Members.
+// If some members can't be fetched the rest are still returned along with a MembersError.
+// Use WithContext to stop it early.
+func (c *Client) ClanMembersFull(tag string) (players []Player, err error) {
+	clan, err := c.Clan(tag, nil)
+	if err != nil {
+		return
+	}
+
+	fetched := make([]Player, len(clan.Members))
+	ok := make([]bool, len(clan.Members))
+	failed := MembersError{Clan: tag, Errors: make(map[string]error)}
+	var mu sync.Mutex
+
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < clanMembersWorkers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				memberTag := clan.Members[i].Tag
+				p, err := c.Player(memberTag, nil)
+				if err != nil {
+					mu.Lock()
+					failed.Errors[memberTag] = err
+					mu.Unlock()
+					continue
+				}
+				fetched[i], ok[i] = p, true
+			}
+		}()
+	}
+	for i := range clan.Members {
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+
+	for i, p := range fetched {
+		if ok[i] {
+			players = append(players, p)
+		}
+	}
+	if len(failed.Errors) > 0 {
+		err = failed
+	}
+	return
+}
@@ -0,0 +1,227 @@
+package goroyale
+
+import (
+	"sort"
+)
+
+// MaxClanMembers is how many members a clan can have.
+const MaxClanMembers = 50
+
+// Clan types used by the API.
+const (
+	ClanOpen       = "open"
+	ClanInviteOnly = "inviteOnly"
+	ClanClosed     = "closed"
+)
+
+// ClanRecommendWeights are how much each part of a ClanRecommendation counts towards its Score.
+type ClanRecommendWeights struct {
+	Fit      float64
+	Activity float64
+	War      float64
+	Language float64
+}
+
+// DefaultClanRecommendWeights are used when ClanRecommendOptions.Weights is zero.
+var DefaultClanRecommendWeights = ClanRecommendWeights{Fit: 0.4, Activity: 0.3, War: 0.2, Language: 0.1}
+
+// ClanRecommendOptions describe the player looking for a clan.
+type ClanRecommendOptions struct {
+	Trophies int
+	// Language is the player's language, clans in countries where it's spoken rank higher.
+	// The API doesn't say what language a clan speaks so this is only a guess.
+	Language Locale
+	// Location is anything LocationKey accepts, "" searches every location.
+	Location string
+	// Limit is how many clans to return, 10 if it is 0.
+	Limit int
+	// WarTrophies also fetches the war log of the best clans to rank them by war trophies,
+	// it costs one request per returned clan.
+	WarTrophies bool
+	Weights     ClanRecommendWeights
+}
+
+// ClanRecommendation is a clan RecommendClans thinks the player could join.
+// Fit, Activity, War, and Language are each from 0 to 1.
+type ClanRecommendation struct {
+	Clan        ClanSearch
+	Score       float64
+	Fit         float64 // how close the clan's required trophies are to the player's
+	Activity    float64 // donations per member, relative to the most active clan found
+	War         float64 // war trophies, relative to the clan with the most found
+	Language    float64 // 1 if the clan is in a country where the player's language is spoken
+	WarTrophies int
+}
+
+// localeCountries are the locations where each language is mostly spoken, by location key.
+var localeCountries = map[Locale][]string{
+	LocaleEnglish:    {"GB", "US", "AU", "CA", "IE", "NZ", "ZA", "SG", "IN", "PH"},
+	LocaleFrench:     {"FR", "BE", "CA", "CH", "MA"},
+	LocaleGerman:     {"DE", "AT", "CH"},
+	LocaleSpanish:    {"ES", "MX", "AR", "CL", "CO", "PE", "VE"},
+	LocaleItalian:    {"IT", "CH"},
+	LocaleDutch:      {"NL", "BE"},
+	LocalePortuguese: {"PT", "BR"},
+	LocaleRussian:    {"RU", "UA"},
+	LocaleTurkish:    {"TR"},
+	LocaleJapanese:   {"JP"},
+	LocaleKorean:     {"KR"},
+	LocaleChinese:    {"CN", "TW", "HK", "SG"},
+}
+
+// speaks reports whether the language is spoken in the location.
+func (l Locale) speaks(location Location) bool {
+	for _, code := range localeCountries[l] {
+		if location.Code == code {
+			return true
+		}
+	}
+	return false
+}
+
+// RecommendClans finds open clans the player can join and ranks them by how close their required trophies are to
+// the player's, how much their members donate, their war trophies, and language.
+// Candidates come from ClanSearch and the location's TopClans.
+func (c *Client) RecommendClans(opts ClanRecommendOptions) (recommendations []ClanRecommendation, err error) {
+	limit := opts.Limit
+	if limit == 0 {
+		limit = 10
+	}
+	weights := opts.Weights
+	if weights == (ClanRecommendWeights{}) {
+		weights = DefaultClanRecommendWeights
+	}
+
+	// the search needs at least one criteria and full clans are no use anyway
+	filter := ClanSearchFilter{MaxMembers: MaxClanMembers - 1}
+	var locationKey string
+	if opts.Location != "" {
+		if locationKey, err = LocationKey(opts.Location); err != nil {
+			return
+		}
+		if region, ok := LookupLocation(locationKey); ok {
+			filter.LocationID = region.ID
+		}
+	}
+	candidates, err := c.ClanSearch(filter)
+	if err != nil {
+		return
+	}
+	if more, topErr := c.topClanCandidates(locationKey); topErr == nil {
+		candidates = append(candidates, more...)
+	}
+
+	seen := make(map[string]bool)
+	var maxActivity float64
+	for _, clan := range candidates {
+		if seen[clan.Tag] || clan.Type != ClanOpen || clan.MemberCount >= MaxClanMembers || clan.RequiredScore > opts.Trophies {
+			continue
+		}
+		seen[clan.Tag] = true
+		r := ClanRecommendation{Clan: clan}
+		if opts.Trophies > 0 {
+			r.Fit = float64(clan.RequiredScore) / float64(opts.Trophies)
+		}
+		if clan.MemberCount > 0 {
+			r.Activity = float64(clan.Donations) / float64(clan.MemberCount)
+		}
+		if r.Activity > maxActivity {
+			maxActivity = r.Activity
+		}
+		if opts.Language != "" && opts.Language.speaks(clan.Location) {
+			r.Language = 1
+		}
+		recommendations = append(recommendations, r)
+	}
+	for i := range recommendations {
+		if maxActivity > 0 {
+			recommendations[i].Activity /= maxActivity
+		}
+	}
+	rank := func() {
+		for i := range recommendations {
+			r := &recommendations[i]
+			r.Score = weights.Fit*r.Fit + weights.Activity*r.Activity + weights.War*r.War + weights.Language*r.Language
+		}
+		sort.SliceStable(recommendations, func(i, j int) bool { return recommendations[i].Score > recommendations[j].Score })
+	}
+	rank()
+	if len(recommendations) > limit {
+		recommendations = recommendations[:limit]
+	}
+
+	if opts.WarTrophies {
+		maxTrophies := 0
+		for i := range recommendations {
+			r := &recommendations[i]
+			warlog, logErr := c.ClanWarLogLast(r.Clan.Tag, 1)
+			if logErr != nil || len(warlog) == 0 {
+				continue
+			}
+			for _, standing := range warlog[0].Standings {
+				if standing.Tag == r.Clan.Tag {
+					r.WarTrophies = standing.WarTrophies
+				}
+			}
+			if r.WarTrophies > maxTrophies {
+				maxTrophies = r.WarTrophies
+			}
+		}
+		for i := range recommendations {
+			if maxTrophies > 0 {
+				recommendations[i].War = float64(recommendations[i].WarTrophies) / float64(maxTrophies)
+			}
+		}
+		rank()
+	}
+	return
+}
+
+// maxTopClanCandidates is how many clans from TopClans RecommendClans looks at.
+const maxTopClanCandidates = 50
+
+// topClanCandidates returns the clans on the location's leaderboard that aren't full, as ClanSearch results.
+// TopClan doesn't have the clan's type or required trophies so they are requested with Clans.
+func (c *Client) topClanCandidates(location string) (candidates []ClanSearch, err error) {
+	top, err := c.TopClans(location, nil)
+	if err != nil {
+		return
+	}
+	var tags []string
+	for _, t := range top {
+		// the rest of the leaderboard is mostly out of reach and would cost more requests
+		if len(tags) == maxTopClanCandidates {
+			break
+		}
+		if t.MemberCount < MaxClanMembers {
+			tags = append(tags, t.Tag)
+		}
+	}
+	// keep the request small, the multiple clans endpoint gets slow with a lot of tags
+	const batch = 10
+	for len(tags) > 0 {
+		n := len(tags)
+		if n > batch {
+			n = batch
+		}
+		clans, clansErr := c.Clans(tags[:n], nil)
+		if clansErr != nil {
+			return candidates, clansErr
+		}
+		for _, clan := range clans {
+			candidates = append(candidates, ClanSearch{
+				Tag:           clan.Tag,
+				Name:          clan.Name,
+				Type:          clan.Type,
+				Score:         clan.Score,
+				MemberCount:   clan.MemberCount,
+				RequiredScore: clan.RequiredScore,
+				Donations:     clan.Donations,
+				Badge:         clan.Badge,
+				Location:      clan.Location,
+			})
+		}
+		tags = tags[n:]
+	}
+	return
+}
@@ -0,0 +1,106 @@
+package goroyale
+
+import (
+	"errors"
+	"net/url"
+	"strconv"
+	"unicode/utf8"
+)
+
+// MinClanSearchNameLength is the shortest name the clan search endpoint accepts.
+const MinClanSearchNameLength = 3
+
+// ClanSearchFilter holds the criteria for ClanSearch.
+// Zero values are left out of the request, at least one criteria has to be set.
+// https://docs.royaleapi.com/#/endpoints/clan_search
+type ClanSearchFilter struct {
+	Name       string
+	Score      int // minimum clan score
+	MinMembers int
+	MaxMembers int
+	LocationID int // ID of a location from Constants.Regions
+
+	// Params are added to the request as they are, ex: for field filtering.
+	Params url.Values
+}
+
+// Validate checks f against the rules of the clan search endpoint.
+func (f ClanSearchFilter) Validate() error {
+	if f.Name == "" && f.Score == 0 && f.MinMembers == 0 && f.MaxMembers == 0 && f.LocationID == 0 {
+		return errors.New("clan search requires at least one criteria")
+	}
+	if f.Name != "" && utf8.RuneCountInString(f.Name) < MinClanSearchNameLength {
+		return errors.New("clan search name must be at least " + strconv.Itoa(MinClanSearchNameLength) + " characters")
+	}
+	if f.MinMembers < 0 || f.MaxMembers < 0 || (f.MaxMembers != 0 && f.MinMembers > f.MaxMembers) {
+		return errors.New("clan search member range is invalid")
+	}
+	return nil
+}
+
+func (f ClanSearchFilter) values() url.Values {
+	params := url.Values{}
+	for k, v := range f.Params {
+		params[k] = v
+	}
+	if f.Name != "" {
+		params.Set("name", f.Name)
+	}
+	if f.Score != 0 {
+		params.Set("score", strconv.Itoa(f.Score))
+	}
+	if f.MinMembers != 0 {
+		params.Set("minMembers", strconv.Itoa(f.MinMembers))
+	}
+	if f.MaxMembers != 0 {
+		params.Set("maxMembers", strconv.Itoa(f.MaxMembers))
+	}
+	if f.LocationID != 0 {
+		params.Set("locationId", strconv.Itoa(f.LocationID))
+	}
+	return params
+}
+
+// ClanSearch searches for clans matching filter.
+// https://docs.royaleapi.com/#/endpoints/clan_search
+func (c *Client) ClanSearch(filter ClanSearchFilter) (clans []ClanSearch, err error) {
+	if err = filter.Validate(); err != nil {
+		return
+	}
+	path := "/clan/search"
+	err = c.getJSON(path, filter.values(), &clans)
+	return
+}
+
+// ClanSearchPage returns a single page of ClanSearch results with up to max clans.
+// Pages start at 0.
+func (c *Client) ClanSearchPage(filter ClanSearchFilter, page, max int) (clans []ClanSearch, err error) {
+	if err = filter.Validate(); err != nil {
+		return
+	}
+	path := "/clan/search"
+	err = c.getJSON(path, withPage(filter.values(), page, max), &clans)
+	return
+}
+
+// ClanSearchEach requests ClanSearch results a page at a time and calls fn with every clan.
+// It stops when there are no more results or fn returns false.
+func (c *Client) ClanSearchEach(filter ClanSearchFilter, pageSize int, fn func(ClanSearch) bool) error {
+	if pageSize <= 0 {
+		return errors.New("clan search page size must be positive")
+	}
+	for page := 0; ; page++ {
+		clans, err := c.ClanSearchPage(filter, page, pageSize)
+		if err != nil {
+			return err
+		}
+		for _, clan := range clans {
+			if !fn(clan) {
+				return nil
+			}
+		}
+		if len(clans) < pageSize {
+			return nil
+		}
+	}
+}
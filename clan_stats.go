@@ -0,0 +1,101 @@
+package goroyale
+
+import "sort"
+
+// TrophyBucketSize is the width of the buckets in ClanStats.Trophies.
+const TrophyBucketSize = 500
+
+// ClanStats summarizes the members of a clan, see AggregateClan.
+type ClanStats struct {
+	Tag     string
+	Name    string
+	Members int
+
+	AverageTrophies  float64
+	MedianTrophies   float64
+	TotalDonations   int
+	AverageDonations float64
+	MedianDonations  float64
+
+	// Roles counts members by ClanMember.Role, ex: "elder".
+	Roles map[string]int
+	// Arenas counts members by arena, lowest arena first.
+	Arenas []ArenaCount
+	// Trophies is a histogram of member trophies in TrophyBucketSize buckets, from the lowest to the highest
+	// bucket a member is in. Buckets in between with nobody in them are included.
+	Trophies []TrophyBucket
+}
+
+// ArenaCount is how many members of a clan are in an arena.
+type ArenaCount struct {
+	Arena   Arena
+	Members int
+}
+
+// TrophyBucket is how many members of a clan have from Min up to but not including Max trophies.
+type TrophyBucket struct {
+	Min, Max int
+	Members  int
+}
+
+// AggregateClan computes ClanStats from the members of clan.
+// A clan without members has zero stats.
+func AggregateClan(clan Clan) (stats ClanStats) {
+	stats.Tag, stats.Name, stats.Members = clan.Tag, clan.Name, len(clan.Members)
+	stats.Roles = make(map[string]int)
+	if len(clan.Members) == 0 {
+		return
+	}
+
+	trophies := make([]int, len(clan.Members))
+	donations := make([]int, len(clan.Members))
+	arenas := make(map[int]*ArenaCount)
+	var totalTrophies int
+	for i, m := range clan.Members {
+		trophies[i], donations[i] = m.Trophies, m.Donations
+		totalTrophies += m.Trophies
+		stats.TotalDonations += m.Donations
+		stats.Roles[m.Role]++
+
+		a, ok := arenas[m.Arena.ArenaID]
+		if !ok {
+			a = &ArenaCount{Arena: m.Arena}
+			arenas[m.Arena.ArenaID] = a
+		}
+		a.Members++
+	}
+	stats.AverageTrophies = float64(totalTrophies) / float64(len(clan.Members))
+	stats.AverageDonations = float64(stats.TotalDonations) / float64(len(clan.Members))
+	stats.MedianTrophies = median(trophies)
+	stats.MedianDonations = median(donations)
+
+	for _, a := range arenas {
+		stats.Arenas = append(stats.Arenas, *a)
+	}
+	sort.Slice(stats.Arenas, func(i, j int) bool { return stats.Arenas[i].Arena.ArenaID < stats.Arenas[j].Arena.ArenaID })
+
+	// median sorted trophies, so the lowest and highest are at the ends
+	low, high := trophies[0]/TrophyBucketSize, trophies[len(trophies)-1]/TrophyBucketSize
+	stats.Trophies = make([]TrophyBucket, high-low+1)
+	for i := range stats.Trophies {
+		min := (low + i) * TrophyBucketSize
+		stats.Trophies[i] = TrophyBucket{Min: min, Max: min + TrophyBucketSize}
+	}
+	for _, t := range trophies {
+		stats.Trophies[t/TrophyBucketSize-low].Members++
+	}
+	return
+}
+
+// median sorts values and returns the middle one, or the average of the two middle ones.
+func median(values []int) float64 {
+	if len(values) == 0 {
+		return 0
+	}
+	sort.Ints(values)
+	mid := len(values) / 2
+	if len(values)%2 == 1 {
+		return float64(values[mid])
+	}
+	return float64(values[mid-1]+values[mid]) / 2
+}
@@ -0,0 +1,71 @@
+package goroyale
+
+// WarState is the stage a clan war is in.
+type WarState string
+
+// Clan war states used by the API.
+const (
+	WarNotInWar      WarState = "notInWar"
+	WarCollectionDay WarState = "collectionDay"
+	WarDay           WarState = "warDay"
+)
+
+// InWar reports whether s is collection day or war day.
+func (s WarState) InWar() bool {
+	return s == WarCollectionDay || s == WarDay
+}
+
+// Battles each participant gets on each day of a clan war.
+const (
+	CollectionDayBattles = 3
+	WarDayBattles        = 1
+)
+
+// MissingParticipants lists members who still have something to do in a clan war.
+type MissingParticipants struct {
+	State WarState
+	// NotJoined are clan members who aren't participants, only filled in on collection day since that's the only time they can join.
+	NotJoined []ClanMember
+	// BattlesLeft are participants who haven't used all of their battles for the current day.
+	BattlesLeft []ParticipantBattlesLeft
+}
+
+// ParticipantBattlesLeft is a war participant and how many battles they have left.
+type ParticipantBattlesLeft struct {
+	ClanWarParticipant
+
+	Remaining int
+}
+
+// MissingParticipants cross-references clan's members with w's participants.
+// clan should be the same clan w was requested for.
+func (w ClanWar) MissingParticipants(clan Clan) MissingParticipants {
+	report := MissingParticipants{State: w.State}
+
+	var battles int
+	switch w.State {
+	case WarCollectionDay:
+		battles = CollectionDayBattles
+	case WarDay:
+		battles = WarDayBattles
+	default:
+		return report
+	}
+
+	joined := make(map[string]bool, len(w.Participants))
+	for _, p := range w.Participants {
+		joined[p.Tag] = true
+		if p.BattlesPlayed < battles {
+			report.BattlesLeft = append(report.BattlesLeft, ParticipantBattlesLeft{p, battles - p.BattlesPlayed})
+		}
+	}
+
+	if w.State == WarCollectionDay {
+		for _, m := range clan.Members {
+			if !joined[m.Tag] {
+				report.NotJoined = append(report.NotJoined, m)
+			}
+		}
+	}
+	return report
+}
@@ -2,40 +2,95 @@
 package goroyale
 
 import (
+	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
-	"io/ioutil"
+	"io"
+	"log/slog"
 	"net/http"
 	"net/url"
 	"strconv"
+	"sync"
 	"time"
 )
 
-const baseURL = "https://api.royaleapi.com"
+// DefaultBaseURL is where requests are sent unless SetBaseURL is used.
+const DefaultBaseURL = "https://api.royaleapi.com"
 
 // Client allows you to easily interact with RoyaleAPI.
+//
+// A Client is safe for concurrent use by multiple goroutines once it is set up.
+// Requests from every goroutine share one ratelimit, cache, and usage tracker.
+// The exported fields and the Set methods that configure it (SetTransport, SetCache, SetBaseURL,
+// SetRetryPolicy, SetRetrySafe, SetSharedLimiter, SetAuditSink, SetRateLimitStore) must be used before making requests,
+// they aren't synchronized with requests in flight.
+// SetConcurrencyLimit and SetUsageWindow can be called at any time.
+//
+// Clients returned by WithContext share all of this state with the Client they came from.
 type Client struct {
 	Token string
+	// Codec is used to decode responses, encoding/json is used if it is nil.
+	Codec Codec
+	// Clock is used for ratelimiting and usage tracking, the wall clock is used if it is nil.
+	Clock Clock
+	// Label identifies this client in audit records, ex: the name of the team or service using it.
+	Label string
+	// Lenient stops one malformed value from failing a whole response.
+	// Slice elements that can't be decoded are skipped and the rest are returned along with a MultiError.
+	Lenient bool
+	// MaxResponseBytes is the biggest response body that will be read, 0 means there is no limit.
+	// Bigger responses fail with a ResponseTooLargeError instead of being read into memory.
+	MaxResponseBytes int64
+	// Logger receives structured records when requests are delayed by the ratelimit, retried, or slow.
+	// Nothing is logged if it is nil.
+	Logger *slog.Logger
+	// SlowRequestThreshold is how long a request can take before it is logged as slow, 0 doesn't log slow requests.
+	// It doesn't include time spent waiting for the ratelimit, which is logged separately.
+	SlowRequestThreshold time.Duration
+	// DryRun stops requests from being sent. Each one is checked with ValidateRequest and,
+	// if it's valid, fails with a DryRunError holding the URL it would have been sent to.
+	DryRun bool
 
 	client http.Client
 	// using empty struct because it has a byte size of 0
 	// i don't care what's in the channel, just that something is
 	rateBucket chan struct{}
+
+	concurrency *keyedSemaphore
+	usage       *usageTracker
+	cache       *responseCache
+	shared      *SharedLimiter
+	audit       AuditSink
+	baseURL     string
+	retry       RetryPolicy
+	retrySafe   map[string]bool // set by SetRetrySafe
+	ctx         context.Context // set by WithContext
+	life        *lifecycle
 }
 
+// DefaultTimeout is the request timeout of a Client made without WithTimeout or WithHTTPClient.
+const DefaultTimeout = 10 * time.Second
+
 // New creates a new RoyaleAPI client.
-func New(token string, timeout time.Duration) (c *Client, err error) {
+//
+//	c, err := goroyale.New(token, goroyale.WithTimeout(5*time.Second), goroyale.WithRetry(policy))
+func New(token string, opts ...Option) (c *Client, err error) {
 	c = &Client{
-		client:     http.Client{Timeout: 10 * time.Second},
-		rateBucket: make(chan struct{}, 5),
+		client:      http.Client{Timeout: DefaultTimeout},
+		rateBucket:  make(chan struct{}, 5),
+		concurrency: &keyedSemaphore{},
+		usage:       &usageTracker{},
+		baseURL:     DefaultBaseURL,
+		life:        newLifecycle(),
 	}
 	if token == "" {
 		err = errors.New("client requires token for authorization with the API")
 		return
 	}
 	c.Token = token
-	if timeout != 0 {
-		c.client = http.Client{Timeout: timeout}
+	for _, opt := range opts {
+		opt(c)
 	}
 
 	// Allow initial request
@@ -43,61 +98,281 @@ func New(token string, timeout time.Duration) (c *Client, err error) {
 	return
 }
 
+// NewWithTimeout creates a new RoyaleAPI client with a request timeout, 0 uses DefaultTimeout.
+//
+// Deprecated: This is how New used to work, use New with WithTimeout instead.
+func NewWithTimeout(token string, timeout time.Duration) (*Client, error) {
+	return New(token, WithTimeout(timeout))
+}
+
+// refill puts a request back in the rateBucket.
+// It never blocks, if the bucket is somehow full the request is dropped rather than holding up the caller.
+func (c *Client) refill() {
+	select {
+	case c.rateBucket <- struct{}{}:
+	default:
+	}
+}
+
 func (c *Client) updateRatelimit(resp *http.Response) error {
+	var (
+		limit        int
+		remainingI   int
+		hasRemaining bool
+		reset        time.Time
+		err          error
+	)
+	defer func() {
+		c.usage.observe(limit, remainingI, hasRemaining, reset, c.now())
+	}()
+
+	if l := resp.Header.Get("x-ratelimit-limit"); l != "" {
+		limit, _ = strconv.Atoi(l)
+	}
+
 	remaining := resp.Header.Get("x-ratelimit-remaining")
+	retry := resp.Header.Get("x-ratelimit-retry-after")
+	if remaining == "" && retry == "" {
+		// nothing to go off of so don't lose the request
+		c.refill()
+		return nil
+	}
+
 	if remaining != "" {
-		remainingI, err := strconv.Atoi(remaining)
+		remainingI, err = strconv.Atoi(remaining)
 		if err != nil {
+			c.refill()
 			return err
 		}
+		hasRemaining = true
 
 		if remainingI > 0 {
-			c.rateBucket <- struct{}{}
+			c.refill()
 		}
 	}
-	retry := resp.Header.Get("x-ratelimit-retry-after")
 	if retry != "" {
 		sec, err := strconv.ParseInt(retry, 10, 64)
 		if err != nil {
+			if !hasRemaining || remainingI <= 0 {
+				c.refill()
+			}
 			return err
 		}
+		reset = c.now().Add(time.Duration(sec) * time.Second)
 
 		// Ratelimit-Retry-After only shows up when Ratelimit-Remaining hits 0
 		// Wait until next request is available and add it to the rateBucket
-		go func() {
-			time.Sleep(time.Duration(sec) * time.Second)
-			c.rateBucket <- struct{}{}
-		}()
+		c.after(time.Duration(sec)*time.Second, c.refill)
 	}
 	return nil
 }
 
-func (c *Client) get(path string, params url.Values) (bytes []byte, err error) {
-	// take one request out of the rateBucket
-	<-c.rateBucket
+// bufferPool holds buffers for reading response bodies so each request doesn't allocate a new one.
+var bufferPool = sync.Pool{
+	New: func() interface{} { return new(bytes.Buffer) },
+}
+
+// send performs a GET request and reads the response body into buf.
+// Unlike do it doesn't treat non-200 responses as errors.
+func (c *Client) send(ctx context.Context, path string, params url.Values, buf *bytes.Buffer) (resp *http.Response, err error) {
+	return c.sendRequest(ctx, http.MethodGet, path, params, nil, nil, buf)
+}
+
+// sendRequest is send for any method, body and header can be nil.
+func (c *Client) sendRequest(ctx context.Context, method, path string, params url.Values, body []byte, header http.Header, buf *bytes.Buffer) (resp *http.Response, err error) {
+	if c.DryRun {
+		err = c.dryRun(path, params)
+		return
+	}
+	if err = c.life.begin(); err != nil {
+		return
+	}
+	defer c.life.requests.Done()
+	ctx, cancel := c.bound(ctx)
+	defer cancel()
+	defer func() { err = c.closedErr(err) }()
+
+	release, err := c.concurrency.acquire(ctx, endpointOf(path))
+	if err != nil {
+		return
+	}
+	defer release()
 
-	path = baseURL + path
-	req, err := http.NewRequest("GET", path, nil)
+	// take one request out of the rateBucket, only this wait is the ratelimit's
+	queued := c.now()
+	if err = c.acquire(ctx); err != nil {
+		return
+	}
+	if c.shared != nil {
+		if err = c.shared.Wait(ctx); err != nil {
+			c.refill()
+			return
+		}
+	}
+	start := c.now()
+	c.logDelayed(ctx, path, start.Sub(queued))
+	c.usage.record(endpointOf(path), RequestLabel(ctx), start)
+
+	var reqBody io.Reader
+	if body != nil {
+		reqBody = bytes.NewReader(body)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, c.baseURL+path, reqBody)
 	if err != nil {
+		c.refill()
 		return
 	}
+	for key, values := range header {
+		req.Header[key] = values
+	}
 	req.Header.Add("auth", c.Token)
 	req.URL.RawQuery = params.Encode()
 
-	resp, err := c.client.Do(req)
-	defer c.updateRatelimit(resp)
+	resp, err = c.client.Do(req)
 	if err != nil {
+		// no ratelimit headers to go off of so give the request back
+		c.refill()
+		// a cancelled request says nothing about the API
+		c.usage.outcome(ctx.Err() == nil, c.now())
+		c.auditRequest(ctx, start, path, params, 0, err)
 		return
 	}
 	defer resp.Body.Close()
+	defer c.updateRatelimit(resp)
 
-	bytes, err = ioutil.ReadAll(resp.Body)
+	err = c.readBody(path, resp, buf)
+	c.usage.outcome(resp.StatusCode >= 500, c.now())
+	c.logSlow(ctx, path, resp.StatusCode, c.now().Sub(start))
+	c.auditRequest(ctx, start, path, params, resp.StatusCode, err)
+	return
+}
+
+// readBody reads the response body into buf, up to MaxResponseBytes.
+func (c *Client) readBody(path string, resp *http.Response, buf *bytes.Buffer) error {
+	limit := c.MaxResponseBytes
+	if limit <= 0 {
+		_, err := buf.ReadFrom(resp.Body)
+		return err
+	}
+	if resp.ContentLength > limit {
+		return ResponseTooLargeError{path, limit}
+	}
+	// read one byte past the limit to tell a body of exactly limit bytes from a bigger one
+	if _, err := buf.ReadFrom(io.LimitReader(resp.Body, limit+1)); err != nil {
+		return err
+	}
+	if int64(buf.Len()) > limit {
+		return ResponseTooLargeError{path, limit}
+	}
+	return nil
+}
+
+// do performs a request and reads the response body into buf.
+// Non-200 responses are returned as an APIError.
+// Responses come from the cache if one is set.
+func (c *Client) do(ctx context.Context, path string, params url.Values, buf *bytes.Buffer) (err error) {
+	if c.DryRun {
+		return c.dryRun(path, params)
+	}
+	if cache := c.cache; cache != nil {
+		return c.doCached(ctx, cache, path, params, buf)
+	}
+	return c.doUncached(ctx, path, params, buf)
+}
+
+func (c *Client) doUncached(ctx context.Context, path string, params url.Values, buf *bytes.Buffer) (err error) {
+	// stop waiting between retries once the Client is closed
+	ctx, cancel := c.bound(ctx)
+	defer cancel()
+	for attempt := 1; ; attempt++ {
+		buf.Reset()
+		err = c.doOnce(ctx, path, params, buf)
+		if attempt >= c.retry.Attempts || !retryable(ctx, err) || !c.RetrySafe(ctx, http.MethodGet, path) {
+			return
+		}
+		backoff := c.retry.backoff(attempt)
+		c.logRetry(ctx, path, attempt, backoff, err)
+		if sleepErr := sleepContext(ctx, c.clock(), backoff); sleepErr != nil {
+			return
+		}
+	}
+}
+
+func (c *Client) doOnce(ctx context.Context, path string, params url.Values, buf *bytes.Buffer) (err error) {
+	resp, err := c.send(ctx, path, params, buf)
+	if err != nil {
+		return
+	}
 
 	if resp.StatusCode != 200 {
-		var apiErr APIError
-		json.Unmarshal(bytes, &apiErr)
-		return []byte{}, apiErr
+		return apiError(resp, buf.Bytes())
 	}
 
 	return
 }
+
+// apiError builds an APIError from a non-200 response.
+func apiError(resp *http.Response, body []byte) (apiErr APIError) {
+	json.Unmarshal(body, &apiErr)
+	if apiErr.StatusCode == 0 {
+		apiErr.StatusCode = resp.StatusCode
+	}
+	if apiErr.Message == "" {
+		apiErr.Message = resp.Status
+	}
+	return
+}
+
+// RawResponse is an undecoded response from the API.
+type RawResponse struct {
+	StatusCode int
+	Header     http.Header
+	Body       []byte
+}
+
+// Raw requests path, ex: "/player/8L9L9GL", and returns the response as it is.
+// It goes through the same ratelimiting as every other request, but non-200 responses are not turned into an APIError.
+func (c *Client) Raw(path string, params url.Values) (raw RawResponse, err error) {
+	buf := bufferPool.Get().(*bytes.Buffer)
+	buf.Reset()
+	defer bufferPool.Put(buf)
+
+	resp, err := c.send(c.context(), path, params, buf)
+	if err != nil {
+		return
+	}
+	raw = RawResponse{
+		StatusCode: resp.StatusCode,
+		Header:     resp.Header,
+		Body:       append([]byte(nil), buf.Bytes()...),
+	}
+	return
+}
+
+// get returns a copy of the response body.
+func (c *Client) get(path string, params url.Values) (b []byte, err error) {
+	buf := bufferPool.Get().(*bytes.Buffer)
+	buf.Reset()
+	defer bufferPool.Put(buf)
+
+	if err = c.do(c.context(), path, params, buf); err != nil {
+		return []byte{}, err
+	}
+	b = append([]byte(nil), buf.Bytes()...)
+	return
+}
+
+// getJSON decodes the response body into v without keeping the body around.
+func (c *Client) getJSON(path string, params url.Values, v interface{}) (err error) {
+	buf := bufferPool.Get().(*bytes.Buffer)
+	buf.Reset()
+	defer bufferPool.Put(buf)
+
+	if err = c.do(c.context(), path, params, buf); err != nil {
+		return
+	}
+	if c.Lenient {
+		return c.unmarshalLenient(buf.Bytes(), v)
+	}
+	return c.unmarshal(buf.Bytes(), v)
+}
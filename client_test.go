@@ -0,0 +1,141 @@
+package goroyale
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// readTestdata returns a response recorded in testdata.
+func readTestdata(tb testing.TB, name string) []byte {
+	tb.Helper()
+	data, err := os.ReadFile(filepath.Join("testdata", name))
+	if err != nil {
+		tb.Fatal(err)
+	}
+	return data
+}
+
+// recordedServer answers /player/{tag}/battles, /player/{tag}, and /clan/{tag} with the recorded responses.
+func recordedServer(tb testing.TB) *Client {
+	tb.Helper()
+	player, clan, battles := readTestdata(tb, "player.json"), readTestdata(tb, "clan.json"), readTestdata(tb, "battles.json")
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("x-ratelimit-remaining", "1000")
+		switch {
+		case strings.HasSuffix(r.URL.Path, "/battles"):
+			w.Write(battles)
+		case strings.HasPrefix(r.URL.Path, "/player/"):
+			w.Write(player)
+		case strings.HasPrefix(r.URL.Path, "/clan/"):
+			w.Write(clan)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	tb.Cleanup(srv.Close)
+	c, err := New("token", WithBaseURL(srv.URL))
+	if err != nil {
+		tb.Fatal(err)
+	}
+	return c
+}
+
+func TestRecordedResponses(t *testing.T) {
+	c := recordedServer(t)
+	player, err := c.Player("9890JJJV", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if player.Name != "Hello World" || len(player.Cards) == 0 || len(player.CurrentDeck) != 8 {
+		t.Errorf("got %s with %d cards and a deck of %d", player.Name, len(player.Cards), len(player.CurrentDeck))
+	}
+	clan, err := c.Clan("2CCCP", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(clan.Members) != clan.MemberCount {
+		t.Errorf("got %d members, MemberCount is %d", len(clan.Members), clan.MemberCount)
+	}
+	battles, err := c.PlayerBattles("9890JJJV", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(battles) != 25 {
+		t.Errorf("got %d battles, want 25", len(battles))
+	}
+}
+
+func benchmarkRequest(b *testing.B, request func(c *Client) error) {
+	c := recordedServer(b)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if err := request(c); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkPlayer(b *testing.B) {
+	benchmarkRequest(b, func(c *Client) error {
+		_, err := c.Player("9890JJJV", nil)
+		return err
+	})
+}
+
+func BenchmarkClan(b *testing.B) {
+	benchmarkRequest(b, func(c *Client) error {
+		_, err := c.Clan("2CCCP", nil)
+		return err
+	})
+}
+
+func BenchmarkPlayerBattles(b *testing.B) {
+	benchmarkRequest(b, func(c *Client) error {
+		_, err := c.PlayerBattles("9890JJJV", nil)
+		return err
+	})
+}
+
+// BenchmarkPlayerBattlesParallel is what a crawler sees, many requests at once sharing the buffer pool.
+func BenchmarkPlayerBattlesParallel(b *testing.B) {
+	c := recordedServer(b)
+	b.ReportAllocs()
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			if _, err := c.PlayerBattles("9890JJJV", nil); err != nil {
+				b.Error(err)
+				return
+			}
+		}
+	})
+}
+
+// BenchmarkUnmarshal decodes the recorded responses without the HTTP round trip.
+func BenchmarkUnmarshal(b *testing.B) {
+	c := &Client{}
+	for _, bm := range []struct {
+		file string
+		v    func() interface{}
+	}{
+		{"player.json", func() interface{} { return new(Player) }},
+		{"clan.json", func() interface{} { return new(Clan) }},
+		{"battles.json", func() interface{} { return new([]Battle) }},
+	} {
+		data := readTestdata(b, bm.file)
+		b.Run(strings.TrimSuffix(bm.file, ".json"), func(b *testing.B) {
+			b.ReportAllocs()
+			b.SetBytes(int64(len(data)))
+			for i := 0; i < b.N; i++ {
+				if err := c.unmarshal(data, bm.v()); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,107 @@
+package goroyale
+
+import (
+	"sync"
+	"time"
+)
+
+// Clock is the source of time the ratelimiter and usage tracking run on.
+// Swap in a ManualClock to test ratelimiting without waiting on the wall clock.
+type Clock interface {
+	Now() time.Time
+	Sleep(d time.Duration)
+	// After sends the time on the returned channel once d has passed, like time.After.
+	// Waits that can be cancelled use it instead of Sleep so nothing is left blocked.
+	After(d time.Duration) <-chan time.Time
+}
+
+// realClock is the Clock used when Client.Clock is nil.
+type realClock struct{}
+
+func (realClock) Now() time.Time        { return time.Now() }
+func (realClock) Sleep(d time.Duration) { time.Sleep(d) }
+
+func (realClock) After(d time.Duration) <-chan time.Time { return time.After(d) }
+
+func (c *Client) clock() Clock {
+	if c.Clock == nil {
+		return realClock{}
+	}
+	return c.Clock
+}
+
+func (c *Client) now() time.Time {
+	return c.clock().Now()
+}
+
+func (c *Client) sleep(d time.Duration) {
+	c.clock().Sleep(d)
+}
+
+// ManualClock is a Clock that only moves when Advance is called.
+// Sleep blocks, and After's channel waits, until the clock has been advanced past the end of the wait.
+type ManualClock struct {
+	mu       sync.Mutex
+	now      time.Time
+	sleepers []sleeper
+}
+
+type sleeper struct {
+	until time.Time
+	wake  chan time.Time // buffered so Advance never blocks on a wait nobody is receiving anymore
+}
+
+// NewManualClock returns a ManualClock stopped at start.
+func NewManualClock(start time.Time) *ManualClock {
+	return &ManualClock{now: start}
+}
+
+// Now returns the clock's current time.
+func (m *ManualClock) Now() time.Time {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.now
+}
+
+// Sleep blocks until the clock is advanced by at least d.
+func (m *ManualClock) Sleep(d time.Duration) {
+	<-m.After(d)
+}
+
+// After returns a channel that gets the clock's time once it is advanced by at least d.
+func (m *ManualClock) After(d time.Duration) <-chan time.Time {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	wake := make(chan time.Time, 1)
+	if d <= 0 {
+		wake <- m.now
+		return wake
+	}
+	m.sleepers = append(m.sleepers, sleeper{m.now.Add(d), wake})
+	return wake
+}
+
+// Advance moves the clock forward by d and wakes every Sleep and After that has finished.
+func (m *ManualClock) Advance(d time.Duration) {
+	m.mu.Lock()
+	m.now = m.now.Add(d)
+	waiting := m.sleepers[:0]
+	for _, s := range m.sleepers {
+		if s.until.After(m.now) {
+			waiting = append(waiting, s)
+		} else {
+			s.wake <- m.now
+		}
+	}
+	m.sleepers = waiting
+	m.mu.Unlock()
+}
+
+// Sleepers returns how many calls to Sleep and After are still waiting, including Afters whose
+// caller gave up on them.
+// It's useful for waiting until the code under test has started sleeping before calling Advance.
+func (m *ManualClock) Sleepers() int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return len(m.sleepers)
+}
@@ -0,0 +1,126 @@
+// Command goroyale-grpc is a gRPC gateway in front of RoyaleAPI, serving the Royale service in goroyalepb/service.proto.
+//
+// Every call goes through one goroyale Client with an in memory cache, so the services behind it
+// share one API token and one ratelimit instead of each embedding a Client. Repeated calls are answered
+// from the cache, and concurrent calls for the same uncached player or clan share one request to the API.
+//
+//	ROYALEAPI_TOKEN=... goroyale-grpc -listen :9090
+//
+// The Client is configured from ROYALEAPI_* environment variables, see goroyale.ConfigFromEnv.
+// -cache-size and -cache-ttl override the ROYALEAPI_CACHE_* ones when they're given.
+package main
+
+import (
+	"context"
+	"flag"
+	"log"
+	"net"
+	"net/url"
+	"strings"
+
+	"github.com/jegfish/goroyale"
+	"github.com/jegfish/goroyale/goroyalepb"
+	"github.com/jegfish/goroyale/internal/serve"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type server struct {
+	goroyalepb.UnimplementedRoyaleServer
+	client *goroyale.Client
+}
+
+// params turns a TagRequest's field filters into query parameters, the same ones goroyale.WithKeys and WithExclude send.
+func params(req *goroyalepb.TagRequest) url.Values {
+	params := url.Values{}
+	if keys := req.GetKeys(); len(keys) > 0 {
+		params.Set("keys", strings.Join(keys, ","))
+	}
+	if exclude := req.GetExclude(); len(exclude) > 0 {
+		params.Set("exclude", strings.Join(exclude, ","))
+	}
+	return params
+}
+
+// call checks req has a tag and returns the Client to make the call with, which stops when the call is cancelled.
+func (s *server) call(ctx context.Context, req *goroyalepb.TagRequest) (*goroyale.Client, error) {
+	if req.GetTag() == "" {
+		return nil, status.Error(codes.InvalidArgument, "a tag is required")
+	}
+	return s.client.WithContext(ctx), nil
+}
+
+func (s *server) GetPlayer(ctx context.Context, req *goroyalepb.TagRequest) (*goroyalepb.Player, error) {
+	c, err := s.call(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	player, err := c.Player(req.GetTag(), params(req))
+	if err != nil {
+		return nil, statusOf(err)
+	}
+	return goroyalepb.FromPlayer(player), nil
+}
+
+func (s *server) GetPlayerBattles(ctx context.Context, req *goroyalepb.TagRequest) (*goroyalepb.BattlesResponse, error) {
+	c, err := s.call(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	battles, err := c.PlayerBattles(req.GetTag(), params(req))
+	if err != nil {
+		return nil, statusOf(err)
+	}
+	return &goroyalepb.BattlesResponse{Battles: goroyalepb.FromBattles(battles)}, nil
+}
+
+func (s *server) GetClan(ctx context.Context, req *goroyalepb.TagRequest) (*goroyalepb.Clan, error) {
+	c, err := s.call(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	clan, err := c.Clan(req.GetTag(), params(req))
+	if err != nil {
+		return nil, statusOf(err)
+	}
+	return goroyalepb.FromClan(clan), nil
+}
+
+func (s *server) GetClanBattles(ctx context.Context, req *goroyalepb.TagRequest) (*goroyalepb.BattlesResponse, error) {
+	c, err := s.call(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	battles, err := c.ClanBattles(req.GetTag(), params(req))
+	if err != nil {
+		return nil, statusOf(err)
+	}
+	return &goroyalepb.BattlesResponse{Battles: goroyalepb.FromBattles(battles)}, nil
+}
+
+// statusOf turns err into a gRPC status, see serve.Status.
+func statusOf(err error) error {
+	_, code := serve.Status(err)
+	return status.Error(code, err.Error())
+}
+
+func main() {
+	listen := flag.String("listen", ":9090", "address to listen on")
+	cache := serve.AddCacheFlags(flag.CommandLine)
+	flag.Parse()
+
+	client, err := cache.NewClient()
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	lis, err := net.Listen("tcp", *listen)
+	if err != nil {
+		log.Fatal(err)
+	}
+	srv := grpc.NewServer()
+	goroyalepb.RegisterRoyaleServer(srv, &server{client: client})
+	log.Println("listening on", *listen)
+	log.Fatal(srv.Serve(lis))
+}
@@ -0,0 +1,130 @@
+package main
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/jegfish/goroyale"
+	"github.com/jegfish/goroyale/goroyalepb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// newTestGateway serves the Royale service in memory in front of upstream and returns a client for it.
+func newTestGateway(t *testing.T, upstream http.HandlerFunc) goroyalepb.RoyaleClient {
+	api := httptest.NewServer(upstream)
+	t.Cleanup(api.Close)
+	client, err := goroyale.New("token", goroyale.WithBaseURL(api.URL), goroyale.WithCache(goroyale.NewLRUCache(100), time.Minute))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	lis := bufconn.Listen(1 << 20)
+	srv := grpc.NewServer()
+	goroyalepb.RegisterRoyaleServer(srv, &server{client: client})
+	go srv.Serve(lis)
+	t.Cleanup(srv.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	return goroyalepb.NewRoyaleClient(conn)
+}
+
+func TestGatewayCaches(t *testing.T) {
+	battles, err := os.ReadFile("../../testdata/battles.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var requests atomic.Int64
+	rpc := newTestGateway(t, func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		switch r.URL.Path {
+		case "/player/2PP":
+			if got := r.URL.Query().Get("keys"); got != "name,tag" {
+				t.Errorf("keys=%q, want the TagRequest's keys", got)
+			}
+			w.Write([]byte(`{"tag":"2PP","name":"Second","trophies":4790}`))
+		case "/player/2PP/battles":
+			w.Write(battles)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"error":true,"status":404,"message":"No player with that tag"}`))
+		}
+	})
+
+	ctx := context.Background()
+	for i := 0; i < 2; i++ {
+		player, err := rpc.GetPlayer(ctx, &goroyalepb.TagRequest{Tag: "2PP", Keys: []string{"name", "tag"}})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if player.GetTag() != "2PP" || player.GetName() != "Second" || player.GetTrophies() != 4790 {
+			t.Errorf("got %v", player)
+		}
+	}
+	if n := requests.Load(); n != 1 {
+		t.Errorf("2 calls for the same player sent %d requests to the API, want 1", n)
+	}
+
+	resp, err := rpc.GetPlayerBattles(ctx, &goroyalepb.TagRequest{Tag: "2PP"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.GetBattles()) != 25 || resp.GetBattles()[0].GetTeam()[0].GetTag() != "9890JJJV" {
+		t.Errorf("got %d battles, the first is %v", len(resp.GetBattles()), resp.GetBattles()[0])
+	}
+
+	if _, err := rpc.GetClan(ctx, &goroyalepb.TagRequest{Tag: "MISSING"}); status.Code(err) != codes.NotFound {
+		t.Errorf("a 404 from the API is %v, want NotFound", err)
+	}
+	if _, err := rpc.GetClanBattles(ctx, &goroyalepb.TagRequest{}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("a call without a tag is %v, want InvalidArgument", err)
+	}
+}
+
+func TestGatewaySharesMisses(t *testing.T) {
+	var requests atomic.Int64
+	release := make(chan struct{})
+	rpc := newTestGateway(t, func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		<-release
+		w.Write([]byte(`{"tag":"2CCCP","members":[{"tag":"2PP"}]}`))
+	})
+
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			clan, err := rpc.GetClan(context.Background(), &goroyalepb.TagRequest{Tag: "2CCCP"})
+			if err != nil || clan.GetTag() != "2CCCP" || len(clan.GetMembers()) != 1 {
+				t.Errorf("got %v, %v", clan, err)
+			}
+		}()
+	}
+	// let the calls pile up behind the first one before the API answers
+	for requests.Load() == 0 {
+		time.Sleep(time.Millisecond)
+	}
+	time.Sleep(50 * time.Millisecond)
+	close(release)
+	wg.Wait()
+	if n := requests.Load(); n != 1 {
+		t.Errorf("20 concurrent calls for the same clan sent %d requests to the API, want 1", n)
+	}
+}
@@ -0,0 +1,146 @@
+// Command goroyale-proxy is a caching reverse proxy in front of RoyaleAPI.
+//
+// It adds the API token to every request, caches responses for as long as their
+// Cache-Control or Expires headers allow, and sends everything through one goroyale Client
+// so every tool pointed at it shares a single ratelimit. Concurrent requests for the same
+// uncached path share one request to the API.
+//
+//	ROYALEAPI_TOKEN=... goroyale-proxy -listen :8080
+//	curl localhost:8080/player/8L9L9GL
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"log"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jegfish/goroyale"
+)
+
+type proxy struct {
+	client     *goroyale.Client
+	defaultTTL time.Duration
+	// cache holds whole responses encoded with encodeResponse, so each one keeps its own ttl and headers.
+	cache goroyale.CacheGroup
+}
+
+// ttl works out how long a response can be cached for from its headers.
+func (p *proxy) ttl(h http.Header) time.Duration {
+	for _, directive := range strings.Split(h.Get("Cache-Control"), ",") {
+		directive = strings.TrimSpace(directive)
+		switch {
+		case directive == "no-store" || directive == "no-cache" || directive == "private":
+			return 0
+		case strings.HasPrefix(directive, "max-age="):
+			if sec, err := strconv.Atoi(strings.TrimPrefix(directive, "max-age=")); err == nil {
+				return time.Duration(sec) * time.Second
+			}
+		}
+	}
+	if expires, err := http.ParseTime(h.Get("Expires")); err == nil {
+		return time.Until(expires)
+	}
+	return p.defaultTTL
+}
+
+// cachedHeaders are the response headers passed on to clients.
+var cachedHeaders = []string{"Content-Type", "Cache-Control", "Expires", "Last-Modified"}
+
+// encodeResponse turns raw into a value for the cache, keeping only cachedHeaders.
+func encodeResponse(raw goroyale.RawResponse) ([]byte, error) {
+	header := make(http.Header)
+	for _, h := range cachedHeaders {
+		if v := raw.Header.Get(h); v != "" {
+			header.Set(h, v)
+		}
+	}
+	raw.Header = header
+	return json.Marshal(raw)
+}
+
+func decodeResponse(data []byte) (raw goroyale.RawResponse, err error) {
+	err = json.Unmarshal(data, &raw)
+	return
+}
+
+// fetch requests path from the API until ctx is done, only 200 responses are cached.
+func (p *proxy) fetch(ctx context.Context, path string, params url.Values) (data []byte, ttl time.Duration, err error) {
+	raw, err := p.client.WithContext(ctx).Raw(path, params)
+	if err != nil {
+		return
+	}
+	if data, err = encodeResponse(raw); err != nil {
+		return
+	}
+	if raw.StatusCode == http.StatusOK {
+		ttl = p.ttl(raw.Header)
+	}
+	return
+}
+
+func (p *proxy) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "only GET is supported", http.StatusMethodNotAllowed)
+		return
+	}
+	key := r.URL.Path + "?" + r.URL.Query().Encode()
+
+	// the fetch is shared, so it runs until every request waiting on it has gone instead of stopping with this one
+	data, hit, err := p.cache.Get(r.Context(), key, func(ctx context.Context) ([]byte, time.Duration, error) {
+		return p.fetch(ctx, r.URL.Path, r.URL.Query())
+	})
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadGateway)
+		return
+	}
+	raw, err := decodeResponse(data)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	for h, v := range raw.Header {
+		w.Header()[h] = v
+	}
+	if hit {
+		w.Header().Set("X-Cache", "HIT")
+	} else {
+		w.Header().Set("X-Cache", "MISS")
+	}
+	w.WriteHeader(raw.StatusCode)
+	w.Write(raw.Body)
+}
+
+func main() {
+	listen := flag.String("listen", ":8080", "address to listen on")
+	token := flag.String("token", os.Getenv("ROYALEAPI_TOKEN"), "RoyaleAPI token, defaults to $ROYALEAPI_TOKEN")
+	timeout := flag.Duration("timeout", 0, "upstream request timeout, 0 uses goroyale's default")
+	defaultTTL := flag.Duration("default-ttl", 0, "how long to cache responses that have no cache headers")
+	cacheSize := flag.Int("cache-size", 10000, "most responses to keep in memory, the least recently used are dropped first")
+	cacheDir := flag.String("cache-dir", "", "also keep responses in this directory so they survive restarts")
+	flag.Parse()
+
+	client, err := goroyale.New(*token, goroyale.WithTimeout(*timeout))
+	if err != nil {
+		log.Fatal(err)
+	}
+	var cache goroyale.Cache = goroyale.NewLRUCache(*cacheSize)
+	if *cacheDir != "" {
+		cache = goroyale.LayeredCache{Layers: []goroyale.Cache{cache, goroyale.DirCache{Dir: *cacheDir}}}
+	}
+	p := &proxy{
+		client:     client,
+		defaultTTL: *defaultTTL,
+		cache:      goroyale.CacheGroup{Cache: cache},
+	}
+
+	log.Println("listening on", *listen)
+	log.Fatal(http.ListenAndServe(*listen, p))
+}
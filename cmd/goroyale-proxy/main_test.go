@@ -0,0 +1,157 @@
+package main
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/jegfish/goroyale"
+)
+
+func newTestProxy(t *testing.T, upstream http.HandlerFunc) *httptest.Server {
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("x-ratelimit-remaining", "1000")
+		upstream(w, r)
+	}))
+	t.Cleanup(api.Close)
+	client, err := goroyale.New("token", goroyale.WithBaseURL(api.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+	p := &proxy{client: client, cache: goroyale.CacheGroup{Cache: goroyale.NewLRUCache(100)}}
+	srv := httptest.NewServer(p)
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func get(t *testing.T, url string) (resp *http.Response, body string) {
+	t.Helper()
+	resp, err := http.Get(url)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return resp, string(b)
+}
+
+func TestProxyCaches(t *testing.T) {
+	var requests atomic.Int64
+	srv := newTestProxy(t, func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		switch r.URL.Path {
+		case "/player/2PP":
+			w.Header().Set("Cache-Control", "max-age=60")
+			w.Header().Set("Set-Cookie", "session=1")
+			w.Write([]byte(`{"tag":"2PP"}`))
+		case "/clan/2CCCP":
+			w.Header().Set("Cache-Control", "no-store")
+			w.Write([]byte(`{"tag":"2CCCP"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"error":true}`))
+		}
+	})
+
+	resp, body := get(t, srv.URL+"/player/2PP")
+	if resp.Header.Get("X-Cache") != "MISS" || body != `{"tag":"2PP"}` {
+		t.Errorf("first request: %s %q", resp.Header.Get("X-Cache"), body)
+	}
+	resp, body = get(t, srv.URL+"/player/2PP")
+	if resp.Header.Get("X-Cache") != "HIT" || body != `{"tag":"2PP"}` || resp.Header.Get("Cache-Control") != "max-age=60" {
+		t.Errorf("second request: %s %q %v", resp.Header.Get("X-Cache"), body, resp.Header)
+	}
+	if resp.Header.Get("Set-Cookie") != "" {
+		t.Error("headers that aren't cachedHeaders were passed on")
+	}
+
+	for i := 0; i < 2; i++ {
+		if resp, _ := get(t, srv.URL+"/clan/2CCCP"); resp.Header.Get("X-Cache") != "MISS" {
+			t.Error("a no-store response was cached")
+		}
+		if resp, _ := get(t, srv.URL+"/player/missing"); resp.StatusCode != http.StatusNotFound || resp.Header.Get("X-Cache") != "MISS" {
+			t.Errorf("got %d %s for a 404, want it passed on and not cached", resp.StatusCode, resp.Header.Get("X-Cache"))
+		}
+	}
+	if n := requests.Load(); n != 5 {
+		t.Errorf("the API got %d requests, want 5", n)
+	}
+}
+
+func TestProxySharesMisses(t *testing.T) {
+	var requests atomic.Int64
+	release := make(chan struct{})
+	srv := newTestProxy(t, func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		<-release
+		w.Header().Set("Cache-Control", "max-age=60")
+		w.Write([]byte(`{"tag":"2PP"}`))
+	})
+
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, body := get(t, srv.URL+"/player/2PP"); !strings.Contains(body, "2PP") {
+				t.Errorf("got %q", body)
+			}
+		}()
+	}
+	// let the requests pile up behind the first one before the API answers
+	for requests.Load() == 0 {
+		time.Sleep(time.Millisecond)
+	}
+	time.Sleep(50 * time.Millisecond)
+	close(release)
+	wg.Wait()
+	if n := requests.Load(); n != 1 {
+		t.Errorf("20 concurrent requests for the same path sent %d requests to the API, want 1", n)
+	}
+}
+
+func TestProxyCancelsAbandonedFetches(t *testing.T) {
+	started := make(chan struct{})
+	cancelled := make(chan struct{})
+	srv := newTestProxy(t, func(w http.ResponseWriter, r *http.Request) {
+		close(started)
+		select {
+		case <-r.Context().Done():
+			close(cancelled)
+		case <-time.After(5 * time.Second):
+		}
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, srv.URL+"/player/2PP", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	done := make(chan error, 1)
+	go func() {
+		resp, err := http.DefaultClient.Do(req)
+		if err == nil {
+			resp.Body.Close()
+		}
+		done <- err
+	}()
+	<-started
+	cancel()
+	if err := <-done; err == nil {
+		t.Error("the cancelled request got a response")
+	}
+	select {
+	case <-cancelled:
+	case <-time.After(5 * time.Second):
+		t.Fatal("the request to the API kept going after the only request waiting on it was cancelled")
+	}
+}
@@ -0,0 +1,113 @@
+// Command goroyale-server is a caching REST API in front of RoyaleAPI, for programs that aren't written in Go.
+//
+// Responses are the goroyale structs as JSON, so field names stay the same even when RoyaleAPI's change.
+// Every request goes through one goroyale Client with an in memory cache, so repeated requests
+// are cheap and every consumer shares a single ratelimit.
+// It describes itself with an OpenAPI document at /openapi.json, -openapi prints it instead of serving.
+//
+//	ROYALEAPI_TOKEN=... goroyale-server -listen :8080
+//	curl localhost:8080/players/8L9L9GL
+//	curl 'localhost:8080/top/clans?location=GB'
+//
+// The Client is configured from ROYALEAPI_* environment variables, see goroyale.ConfigFromEnv.
+// -cache-size and -cache-ttl override the ROYALEAPI_CACHE_* ones when they're given.
+package main
+
+//go:generate go run . -openapi openapi.json
+
+import (
+	"encoding/json"
+	"flag"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"os"
+
+	"github.com/jegfish/goroyale"
+	"github.com/jegfish/goroyale/internal/serve"
+)
+
+type server struct {
+	client  *goroyale.Client
+	openAPI []byte
+}
+
+func (s *server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeJSON(w, http.StatusMethodNotAllowed, map[string]string{"error": "only GET is supported"})
+		return
+	}
+	switch r.URL.Path {
+	case "/health":
+		s.health(w, r)
+		return
+	case "/openapi.json":
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(s.openAPI)
+		return
+	}
+	// routes are in order, so /tournaments/open is matched before /tournaments/{tag}
+	for _, rt := range routes {
+		if tag, ok := rt.match(r.URL.Path); ok {
+			v, err := rt.fetch(s.client.WithContext(r.Context()), r, tag)
+			if err != nil {
+				status, _ := serve.Status(err)
+				writeJSON(w, status, map[string]string{"error": err.Error()})
+				return
+			}
+			writeJSON(w, http.StatusOK, v)
+			return
+		}
+	}
+	writeJSON(w, http.StatusNotFound, map[string]string{"error": "no such endpoint, see /openapi.json"})
+}
+
+func (s *server) health(w http.ResponseWriter, r *http.Request) {
+	h := s.client.Health(r.Context())
+	status := http.StatusOK
+	if h.Status == goroyale.HealthDown {
+		status = http.StatusServiceUnavailable
+	}
+	body := map[string]interface{}{"status": h.Status, "version": h.Version, "latency": h.Latency.String(), "error_rate": h.ErrorRate}
+	if h.Err != nil {
+		body["error"] = h.Err.Error()
+	}
+	writeJSON(w, status, body)
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
+
+func main() {
+	listen := flag.String("listen", ":8080", "address to listen on")
+	cache := serve.AddCacheFlags(flag.CommandLine)
+	spec := flag.String("openapi", "", "write the OpenAPI document to this file, - for stdout, and exit")
+	flag.Parse()
+
+	doc, err := openAPI()
+	if err != nil {
+		log.Fatal(err)
+	}
+	if *spec != "" {
+		if *spec == "-" {
+			os.Stdout.Write(append(doc, '\n'))
+			return
+		}
+		if err := ioutil.WriteFile(*spec, append(doc, '\n'), 0644); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+
+	client, err := cache.NewClient()
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	s := &server{client, doc}
+	log.Println("listening on", *listen)
+	log.Fatal(http.ListenAndServe(*listen, s))
+}
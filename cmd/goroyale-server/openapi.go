@@ -0,0 +1,109 @@
+package main
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+
+	"github.com/jegfish/goroyale"
+)
+
+// openAPIVersion is the version of OpenAPI the document is written in.
+// 3.1 uses the same JSON Schema draft as goroyale.JSONSchema, so the schemas can be used as they are.
+const openAPIVersion = "3.1.0"
+
+type object = map[string]interface{}
+
+// openAPI describes every route as an OpenAPI document.
+func openAPI() ([]byte, error) {
+	schemas := object{
+		"Error": object{
+			"type":       "object",
+			"properties": object{"error": object{"type": "string"}},
+			"required":   []string{"error"},
+		},
+	}
+	paths := object{}
+	for _, rt := range routes {
+		name := reflect.TypeOf(rt.schema).Name()
+		if err := addSchema(schemas, name, rt.schema); err != nil {
+			return nil, err
+		}
+
+		var schema interface{} = object{"$ref": "#/components/schemas/" + name}
+		if rt.list {
+			schema = object{"type": "array", "items": schema}
+		}
+		var params []interface{}
+		if strings.Contains(rt.path, "{tag}") {
+			params = append(params, object{
+				"name": "tag", "in": "path", "required": true,
+				"description": "player, clan, or tournament tag, with or without the #",
+				"schema":      object{"type": "string"},
+			})
+		}
+		if rt.location {
+			params = append(params, object{
+				"name": "location", "in": "query",
+				"description": "a location key, alias, or name, ex: GB or united kingdom, leave it out for global",
+				"schema":      object{"type": "string"},
+			})
+		}
+		errorResponse := func(description string) object {
+			return object{"description": description, "content": object{"application/json": object{"schema": object{"$ref": "#/components/schemas/Error"}}}}
+		}
+		get := object{
+			"summary": rt.summary,
+			"responses": object{
+				"200":     object{"description": rt.summary, "content": object{"application/json": object{"schema": schema}}},
+				"default": errorResponse("the error from RoyaleAPI, or why it couldn't be reached"),
+			},
+		}
+		if params != nil {
+			get["parameters"] = params
+		}
+		paths[rt.path] = object{"get": get}
+	}
+	paths["/health"] = object{"get": object{
+		"summary":   "Whether RoyaleAPI is reachable, see goroyale.Client.Health",
+		"responses": object{"200": object{"description": "up or degraded"}, "503": object{"description": "down"}},
+	}}
+
+	doc := object{
+		"openapi": openAPIVersion,
+		"info": object{
+			"title":       "goroyale-server",
+			"description": "A caching REST API in front of RoyaleAPI. Field names are the names of the goroyale structs.",
+			"version":     "1",
+		},
+		"paths":      paths,
+		"components": object{"schemas": schemas},
+	}
+	b, err := json.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	// goroyale.JSONSchema puts the struct types it references in $defs, they're components here
+	return []byte(strings.ReplaceAll(string(b), "#/$defs/", "#/components/schemas/")), nil
+}
+
+// addSchema adds the schema of v, and everything it references, to schemas.
+func addSchema(schemas object, name string, v interface{}) error {
+	b, err := goroyale.JSONSchema(v)
+	if err != nil {
+		return err
+	}
+	var schema object
+	if err = json.Unmarshal(b, &schema); err != nil {
+		return err
+	}
+	if defs, ok := schema["$defs"].(map[string]interface{}); ok {
+		for defName, def := range defs {
+			schemas[defName] = def
+		}
+	}
+	delete(schema, "$defs")
+	delete(schema, "$schema")
+	schemas[name] = schema
+	return nil
+}
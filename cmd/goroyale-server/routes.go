@@ -0,0 +1,127 @@
+package main
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/jegfish/goroyale"
+)
+
+// route is one endpoint of the server, used both to serve it and to describe it in the OpenAPI document.
+type route struct {
+	path    string // {tag} matches one path segment, which is passed to fetch normalized
+	summary string
+	schema  interface{} // a value of the type returned, used for its JSON Schema
+	list    bool        // whether a list of schema is returned
+	// location is true for routes that take a location query parameter
+	location bool
+	fetch    func(c *goroyale.Client, r *http.Request, tag string) (interface{}, error)
+}
+
+var routes = []route{
+	{
+		path:    "/players/{tag}",
+		summary: "A player's profile",
+		schema:  goroyale.Player{},
+		fetch: func(c *goroyale.Client, r *http.Request, tag string) (interface{}, error) {
+			return c.Player(tag, nil)
+		},
+	},
+	{
+		path:    "/players/{tag}/battles",
+		summary: "A player's recent battles",
+		schema:  goroyale.Battle{},
+		list:    true,
+		fetch: func(c *goroyale.Client, r *http.Request, tag string) (interface{}, error) {
+			return c.PlayerBattles(tag, nil)
+		},
+	},
+	{
+		path:    "/players/{tag}/chests",
+		summary: "A player's upcoming chests",
+		schema:  goroyale.PlayerChests{},
+		fetch: func(c *goroyale.Client, r *http.Request, tag string) (interface{}, error) {
+			return c.PlayerChests(tag, nil)
+		},
+	},
+	{
+		path:    "/clans/{tag}",
+		summary: "A clan and its members",
+		schema:  goroyale.Clan{},
+		fetch: func(c *goroyale.Client, r *http.Request, tag string) (interface{}, error) {
+			return c.Clan(tag, nil)
+		},
+	},
+	{
+		path:    "/clans/{tag}/war",
+		summary: "A clan's current war",
+		schema:  goroyale.ClanWar{},
+		fetch: func(c *goroyale.Client, r *http.Request, tag string) (interface{}, error) {
+			return c.ClanWar(tag, nil)
+		},
+	},
+	{
+		path:    "/clans/{tag}/warlog",
+		summary: "A clan's past wars",
+		schema:  goroyale.ClanWarLogEntry{},
+		list:    true,
+		fetch: func(c *goroyale.Client, r *http.Request, tag string) (interface{}, error) {
+			return c.ClanWarLog(tag, nil)
+		},
+	},
+	{
+		path:    "/tournaments/open",
+		summary: "Open tournaments",
+		schema:  goroyale.Tournament{},
+		list:    true,
+		fetch: func(c *goroyale.Client, r *http.Request, tag string) (interface{}, error) {
+			return c.OpenTournaments(nil)
+		},
+	},
+	{
+		path:    "/tournaments/{tag}",
+		summary: "A tournament and its members",
+		schema:  goroyale.SpecificTournament{},
+		fetch: func(c *goroyale.Client, r *http.Request, tag string) (interface{}, error) {
+			return c.Tournament(tag, nil)
+		},
+	},
+	{
+		path:     "/top/players",
+		summary:  "The top players of a location, or the world",
+		schema:   goroyale.TopPlayer{},
+		list:     true,
+		location: true,
+		fetch: func(c *goroyale.Client, r *http.Request, tag string) (interface{}, error) {
+			return c.TopPlayers(r.URL.Query().Get("location"), nil)
+		},
+	},
+	{
+		path:     "/top/clans",
+		summary:  "The top clans of a location, or the world",
+		schema:   goroyale.TopClan{},
+		list:     true,
+		location: true,
+		fetch: func(c *goroyale.Client, r *http.Request, tag string) (interface{}, error) {
+			return c.TopClans(r.URL.Query().Get("location"), nil)
+		},
+	},
+}
+
+// match reports whether path is rt's path, and returns the tag in it if it has one.
+func (rt route) match(path string) (tag string, ok bool) {
+	want := strings.Split(strings.Trim(rt.path, "/"), "/")
+	got := strings.Split(strings.Trim(path, "/"), "/")
+	if len(want) != len(got) {
+		return "", false
+	}
+	for i := range want {
+		switch {
+		case want[i] == "{tag}":
+			tag = goroyale.NormalizeTag(got[i])
+		case want[i] != got[i]:
+			return "", false
+		}
+	}
+	return tag, true
+}
@@ -0,0 +1,30 @@
+package goroyale
+
+import "encoding/json"
+
+// Codec decodes JSON responses from the API.
+// It lets you swap encoding/json for a faster drop-in such as jsoniter or sonic.
+//
+//	c.Codec = jsoniter.ConfigCompatibleWithStandardLibrary
+//
+// Responses are read into pooled buffers that get reused by the next request.
+// Some codecs, like sonic, can keep pointing into data from the strings they decode,
+// so a Codec is always given its own copy of the response instead of the pooled buffer.
+type Codec interface {
+	Unmarshal(data []byte, v interface{}) error
+}
+
+// stdCodec is the Codec used when Client.Codec is nil.
+type stdCodec struct{}
+
+func (stdCodec) Unmarshal(data []byte, v interface{}) error {
+	return json.Unmarshal(data, v)
+}
+
+func (c *Client) unmarshal(data []byte, v interface{}) error {
+	if c.Codec == nil {
+		return stdCodec{}.Unmarshal(data, v)
+	}
+	// the copy keeps zero-copy codecs from holding on to a buffer that's about to be reused
+	return c.Codec.Unmarshal(append([]byte(nil), data...), v)
+}
@@ -0,0 +1,62 @@
+package goroyale
+
+import (
+	"context"
+	"strings"
+	"sync"
+)
+
+// endpointOf returns the first segment of a request path.
+// ex: "/player/8L9L9GL/battles" => "/player"
+func endpointOf(path string) string {
+	path = "/" + strings.TrimPrefix(path, "/")
+	if i := strings.IndexByte(path[1:], '/'); i != -1 {
+		return path[:i+1]
+	}
+	return path
+}
+
+// keyedSemaphore limits how many requests can be in flight for each endpoint.
+// Endpoints without a limit are not restricted.
+type keyedSemaphore struct {
+	mu   sync.Mutex
+	sems map[string]chan struct{}
+}
+
+func (k *keyedSemaphore) set(key string, n int) {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	if k.sems == nil {
+		k.sems = make(map[string]chan struct{})
+	}
+	if n <= 0 {
+		delete(k.sems, key)
+		return
+	}
+	// requests already holding the old channel release into it, so they aren't lost
+	k.sems[key] = make(chan struct{}, n)
+}
+
+// acquire blocks until key has a free slot or ctx is done and returns the function to release it.
+func (k *keyedSemaphore) acquire(ctx context.Context, key string) (release func(), err error) {
+	k.mu.Lock()
+	sem, ok := k.sems[key]
+	k.mu.Unlock()
+	if !ok {
+		return func() {}, nil
+	}
+	select {
+	case sem <- struct{}{}:
+		return func() { <-sem }, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// SetConcurrencyLimit limits how many requests to an endpoint can be in flight at once.
+// This is separate from the API's ratelimit, which still applies to every request.
+// endpoint is the first part of the path, ex: "/player" or "/constants".
+// A limit of 0 or less removes the limit.
+func (c *Client) SetConcurrencyLimit(endpoint string, n int) {
+	c.concurrency.set(endpointOf(endpoint), n)
+}
@@ -0,0 +1,147 @@
+package goroyale
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+)
+
+// ratelimitServer answers like the API does, with a window of limit requests that resets a second after
+// it starts on clock. Requests over the limit get a 429, which a Client following the headers never sends.
+type ratelimitServer struct {
+	clock *ManualClock
+	limit int
+
+	mu          sync.Mutex
+	reset       time.Time
+	used        int
+	served      int
+	overLimit   int
+	inFlight    map[string]int
+	maxInFlight map[string]int
+}
+
+func (s *ratelimitServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	endpoint := endpointOf(r.URL.Path)
+	s.mu.Lock()
+	if now := s.clock.Now(); !now.Before(s.reset) {
+		s.reset, s.used = now.Add(time.Second), 0
+	}
+	s.used++
+	remaining := s.limit - s.used
+	if remaining < 0 {
+		s.overLimit++
+		s.mu.Unlock()
+		w.WriteHeader(http.StatusTooManyRequests)
+		return
+	}
+	s.served++
+	s.inFlight[endpoint]++
+	if s.inFlight[endpoint] > s.maxInFlight[endpoint] {
+		s.maxInFlight[endpoint] = s.inFlight[endpoint]
+	}
+	s.mu.Unlock()
+
+	// give other requests a chance to overlap with this one
+	time.Sleep(100 * time.Microsecond)
+
+	w.Header().Set("x-ratelimit-limit", strconv.Itoa(s.limit))
+	w.Header().Set("x-ratelimit-remaining", strconv.Itoa(remaining))
+	if remaining == 0 {
+		w.Header().Set("x-ratelimit-retry-after", "1")
+	}
+	fmt.Fprintf(w, `{"tag":%q,"name":"Bob","trophies":4000}`, r.URL.Path[len(endpoint)+1:])
+
+	s.mu.Lock()
+	s.inFlight[endpoint]--
+	s.mu.Unlock()
+}
+
+// TestClientConcurrentUse hammers one Client, its copies from WithContext, and its limiter from many goroutines.
+// It's meant to be run with go test -race, which is what catches most of what it's looking for.
+func TestClientConcurrentUse(t *testing.T) {
+	const (
+		workers  = 50
+		requests = 8
+		limit    = 20
+	)
+	clock := NewManualClock(testStart)
+	api := &ratelimitServer{clock: clock, limit: limit, inFlight: make(map[string]int), maxInFlight: make(map[string]int)}
+	srv := httptest.NewServer(api)
+	defer srv.Close()
+	c, err := New("token", WithBaseURL(srv.URL), WithClock(clock), WithCache(NewLRUCache(64), time.Minute))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+	c.SetConcurrencyLimit("/clan", 2)
+
+	// move the clock along whenever the Client is waiting for the ratelimit to reset
+	stop := make(chan struct{})
+	defer close(stop)
+	go func() {
+		for {
+			select {
+			case <-stop:
+				return
+			default:
+			}
+			if clock.Sleepers() > 0 {
+				clock.Advance(time.Second)
+			}
+			time.Sleep(50 * time.Microsecond)
+		}
+	}()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	var wg sync.WaitGroup
+	errs := make(chan error, workers*requests)
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			client := c.WithContext(ctx)
+			for j := 0; j < requests; j++ {
+				// every fourth worker repeats tags so some requests come from the cache
+				tag := fmt.Sprintf("P%dX%d", i, j)
+				if i%4 == 0 {
+					tag = fmt.Sprintf("C%d", j)
+				}
+				var err error
+				if j%2 == 0 {
+					_, err = client.Player(tag, nil)
+				} else {
+					_, err = client.Clan(tag, nil)
+				}
+				if err != nil {
+					errs <- err
+				}
+				c.Usage()
+				c.EstimateRemaining()
+			}
+		}(i)
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		t.Error(err)
+	}
+
+	api.mu.Lock()
+	defer api.mu.Unlock()
+	if api.overLimit > 0 {
+		t.Errorf("%d requests were sent over the ratelimit", api.overLimit)
+	}
+	if api.served == 0 || api.served > workers*requests {
+		t.Errorf("the server answered %d requests, want 1-%d", api.served, workers*requests)
+	}
+	if n := api.maxInFlight["/clan"]; n > 2 {
+		t.Errorf("%d /clan requests were in flight at once with a limit of 2", n)
+	}
+}
@@ -0,0 +1,256 @@
+package goroyale
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ConfigDuration is a time.Duration in a Config.
+// In JSON it is either a string like "1m30s" or a number of seconds.
+type ConfigDuration time.Duration
+
+// UnmarshalJSON accepts strings parsed by time.ParseDuration and numbers of seconds.
+func (d *ConfigDuration) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err == nil {
+		v, err := parseConfigDuration(s)
+		*d = ConfigDuration(v)
+		return err
+	}
+	var sec float64
+	if err := json.Unmarshal(b, &sec); err != nil {
+		return fmt.Errorf("duration must be a string or number of seconds: %s", b)
+	}
+	*d = ConfigDuration(sec * float64(time.Second))
+	return nil
+}
+
+// MarshalJSON writes d as a duration string.
+func (d ConfigDuration) MarshalJSON() ([]byte, error) {
+	return json.Marshal(time.Duration(d).String())
+}
+
+func parseConfigDuration(s string) (time.Duration, error) {
+	if sec, err := strconv.ParseFloat(s, 64); err == nil {
+		return time.Duration(sec * float64(time.Second)), nil
+	}
+	return time.ParseDuration(s)
+}
+
+// Config holds the settings of a Client so they can come from a file or the environment.
+// Zero values keep the Client's defaults.
+type Config struct {
+	Token   string         `json:"token"`
+	Timeout ConfigDuration `json:"timeout"`
+	BaseURL string         `json:"base_url"`
+	Label   string         `json:"label"`
+	Lenient bool           `json:"lenient"`
+	// MaxResponseBytes sets Client.MaxResponseBytes.
+	MaxResponseBytes int64 `json:"max_response_bytes"`
+	// SlowRequest sets Client.SlowRequestThreshold, it only matters once a Logger is set.
+	SlowRequest ConfigDuration `json:"slow_request"`
+
+	Retry struct {
+		Attempts   int            `json:"attempts"`
+		Backoff    ConfigDuration `json:"backoff"`
+		MaxBackoff ConfigDuration `json:"max_backoff"`
+	} `json:"retry"`
+
+	// Cache is an LRUCache of Size entries, a DirCache in Dir, or both layered if both are set.
+	Cache struct {
+		Size int            `json:"size"`
+		Dir  string         `json:"dir"`
+		TTL  ConfigDuration `json:"ttl"`
+	} `json:"cache"`
+
+	// RateLimitFile is the path of a FileRateLimitStore.
+	RateLimitFile string `json:"ratelimit_file"`
+	// ConcurrencyLimits maps endpoints, ex: "/player", to how many requests to them can run at once.
+	ConcurrencyLimits map[string]int `json:"concurrency_limits"`
+}
+
+// EnvPrefix starts the name of every environment variable read by ConfigFromEnv.
+const EnvPrefix = "ROYALEAPI_"
+
+// ConfigFromEnv reads a Config from environment variables:
+//
+//	ROYALEAPI_TOKEN
+//	ROYALEAPI_TIMEOUT           duration, ex: 10s
+//	ROYALEAPI_BASE_URL
+//	ROYALEAPI_LABEL
+//	ROYALEAPI_LENIENT           true or false
+//	ROYALEAPI_MAX_RESPONSE_BYTES
+//	ROYALEAPI_SLOW_REQUEST      duration
+//	ROYALEAPI_RETRY_ATTEMPTS
+//	ROYALEAPI_RETRY_BACKOFF     duration
+//	ROYALEAPI_RETRY_MAX_BACKOFF duration
+//	ROYALEAPI_CACHE_SIZE
+//	ROYALEAPI_CACHE_DIR
+//	ROYALEAPI_CACHE_TTL         duration
+//	ROYALEAPI_RATELIMIT_FILE
+//	ROYALEAPI_CONCURRENCY_<ENDPOINT> ex: ROYALEAPI_CONCURRENCY_PLAYER=2 sets the limit for /player
+func ConfigFromEnv() (cfg Config, err error) {
+	var errs []error
+	env := func(name string) string {
+		return os.Getenv(EnvPrefix + name)
+	}
+	duration := func(name string, d *ConfigDuration) {
+		if v := env(name); v != "" {
+			parsed, err := parseConfigDuration(v)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("%s%s: %w", EnvPrefix, name, err))
+			}
+			*d = ConfigDuration(parsed)
+		}
+	}
+	integer := func(name string, n *int) {
+		if v := env(name); v != "" {
+			var err error
+			if *n, err = strconv.Atoi(v); err != nil {
+				errs = append(errs, fmt.Errorf("%s%s: %w", EnvPrefix, name, err))
+			}
+		}
+	}
+
+	cfg.Token = env("TOKEN")
+	duration("TIMEOUT", &cfg.Timeout)
+	cfg.BaseURL = env("BASE_URL")
+	cfg.Label = env("LABEL")
+	if v := env("LENIENT"); v != "" {
+		if cfg.Lenient, err = strconv.ParseBool(v); err != nil {
+			errs = append(errs, fmt.Errorf("%sLENIENT: %w", EnvPrefix, err))
+		}
+	}
+	if v := env("MAX_RESPONSE_BYTES"); v != "" {
+		if cfg.MaxResponseBytes, err = strconv.ParseInt(v, 10, 64); err != nil {
+			errs = append(errs, fmt.Errorf("%sMAX_RESPONSE_BYTES: %w", EnvPrefix, err))
+		}
+	}
+	duration("SLOW_REQUEST", &cfg.SlowRequest)
+	integer("RETRY_ATTEMPTS", &cfg.Retry.Attempts)
+	duration("RETRY_BACKOFF", &cfg.Retry.Backoff)
+	duration("RETRY_MAX_BACKOFF", &cfg.Retry.MaxBackoff)
+	integer("CACHE_SIZE", &cfg.Cache.Size)
+	cfg.Cache.Dir = env("CACHE_DIR")
+	duration("CACHE_TTL", &cfg.Cache.TTL)
+	cfg.RateLimitFile = env("RATELIMIT_FILE")
+	for _, kv := range os.Environ() {
+		name, v, _ := strings.Cut(kv, "=")
+		endpoint := strings.TrimPrefix(name, EnvPrefix+"CONCURRENCY_")
+		if endpoint == name || endpoint == "" {
+			continue
+		}
+		n, err := strconv.Atoi(v)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", name, err))
+			continue
+		}
+		if cfg.ConcurrencyLimits == nil {
+			cfg.ConcurrencyLimits = map[string]int{}
+		}
+		cfg.ConcurrencyLimits["/"+strings.ToLower(endpoint)] = n
+	}
+
+	err = errors.Join(errs...)
+	return
+}
+
+// LoadConfig reads a Config from a JSON file, or a YAML one if path ends in .yaml or .yml.
+// YAML files use the same field names as JSON ones.
+func LoadConfig(path string) (cfg Config, err error) {
+	err = decodeConfigFile(path, &cfg)
+	return
+}
+
+// decodeConfigFile decodes the JSON or YAML file at path into v, unknown fields are errors.
+func decodeConfigFile(path string, v interface{}) error {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	if ext := strings.ToLower(filepath.Ext(path)); ext == ".yaml" || ext == ".yml" {
+		if data, err = yamlToJSON(data); err != nil {
+			return fmt.Errorf("%s: %w", path, err)
+		}
+	}
+	dec := json.NewDecoder(bytes.NewReader(data))
+	// catch typos instead of silently ignoring them
+	dec.DisallowUnknownFields()
+	if err = dec.Decode(v); err != nil {
+		return fmt.Errorf("%s: %w", path, err)
+	}
+	return nil
+}
+
+// NewFromEnv creates a Client configured by environment variables, see ConfigFromEnv.
+func NewFromEnv() (*Client, error) {
+	cfg, err := ConfigFromEnv()
+	if err != nil {
+		return nil, err
+	}
+	return cfg.NewClient()
+}
+
+// NewFromConfig creates a Client configured by the JSON or YAML file at path, see LoadConfig.
+func NewFromConfig(path string) (*Client, error) {
+	cfg, err := LoadConfig(path)
+	if err != nil {
+		return nil, err
+	}
+	return cfg.NewClient()
+}
+
+// NewClient creates a Client with the settings in cfg.
+func (cfg Config) NewClient() (*Client, error) {
+	opts := []Option{
+		WithTimeout(time.Duration(cfg.Timeout)),
+		WithLabel(cfg.Label),
+		WithRetry(RetryPolicy{
+			Attempts:   cfg.Retry.Attempts,
+			Backoff:    time.Duration(cfg.Retry.Backoff),
+			MaxBackoff: time.Duration(cfg.Retry.MaxBackoff),
+		}),
+	}
+	if cfg.BaseURL != "" {
+		opts = append(opts, WithBaseURL(strings.TrimSuffix(cfg.BaseURL, "/")))
+	}
+	c, err := New(cfg.Token, opts...)
+	if err != nil {
+		return nil, err
+	}
+	c.Lenient = cfg.Lenient
+	c.MaxResponseBytes = cfg.MaxResponseBytes
+	c.SlowRequestThreshold = time.Duration(cfg.SlowRequest)
+
+	var layers []Cache
+	if cfg.Cache.Size > 0 {
+		layers = append(layers, NewLRUCache(cfg.Cache.Size))
+	}
+	if cfg.Cache.Dir != "" {
+		layers = append(layers, DirCache{cfg.Cache.Dir})
+	}
+	switch len(layers) {
+	case 1:
+		c.SetCache(layers[0], time.Duration(cfg.Cache.TTL))
+	case 2:
+		c.SetCache(LayeredCache{Layers: layers}, time.Duration(cfg.Cache.TTL))
+	}
+
+	if cfg.RateLimitFile != "" {
+		if err := c.SetRateLimitStore(FileRateLimitStore{cfg.RateLimitFile}); err != nil {
+			return nil, err
+		}
+	}
+	for endpoint, n := range cfg.ConcurrencyLimits {
+		c.SetConcurrencyLimit(endpoint, n)
+	}
+	return c, nil
+}
@@ -0,0 +1,128 @@
+package goroyale
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestYAMLToJSON(t *testing.T) {
+	for _, test := range []struct {
+		yaml, json string
+	}{
+		{"", "null"},
+		{"a: 1\nb: two\n", `{"a":1,"b":"two"}`},
+		{"# comment\n---\na: 1 # trailing\n", `{"a":1}`},
+		{"a:\n  b:\n    c: true\n", `{"a":{"b":{"c":true}}}`},
+		{"a:\n- 1\n- 2\n", `{"a":[1,2]}`},
+		{"a:\n  - x: 1\n    y: 2\n  - x: 3\n", `{"a":[{"x":1,"y":2},{"x":3}]}`},
+		{"- - 1\n  - 2\n- 3\n", `[[1,2],3]`},
+		{"a: [1, 'b', \"c\"]\nb: {x: 1, y: [2]}\nc: []\n", `{"a":[1,"b","c"],"b":{"x":1,"y":[2]},"c":[]}`},
+		{"a: [1,\n  2]\n", `{"a":[1,2]}`},
+		{"a: ~\nb: null\nc:\nd: False\n", `{"a":null,"b":null,"c":null,"d":false}`},
+		{"a: 1.5\nb: -007\nc: 1e3\nd: +2\n", `{"a":1.5,"b":-7,"c":1000,"d":2}`},
+		{"a: 1m30s\nb: '30'\nc: 2PP\nd: .inf\n", `{"a":"1m30s","b":"30","c":"2PP","d":".inf"}`},
+		{"url: https://example.com/a#b\n", `{"url":"https://example.com/a#b"}`},
+		{"a: \"x # not a comment\"\nb: 'it''s'\nc: \"tab\\there\"\n", `{"a":"x # not a comment","b":"it's","c":"tab\there"}`},
+		{"\"quoted key\": 1\n", `{"quoted key":1}`},
+	} {
+		got, err := yamlToJSON([]byte(test.yaml))
+		if err != nil {
+			t.Errorf("%q: %v", test.yaml, err)
+			continue
+		}
+		if string(got) != test.json {
+			t.Errorf("%q is %s, want %s", test.yaml, got, test.json)
+		}
+	}
+}
+
+func TestYAMLToJSONErrors(t *testing.T) {
+	for _, test := range []struct {
+		yaml, err string
+	}{
+		{"a: 1\n  b: 2\n", "line 2: unexpected indentation"},
+		{"a: 1\na: 2\n", `line 2: "a" is set twice`},
+		{"a: 1\n- 2\n", "line 2: expected a key: value"},
+		{"a:\n\tb: 1\n", "line 2: indent with spaces"},
+		{"a: |\n  text\n", "line 1: block scalars"},
+		{"a: &x 1\n", "line 1: anchors"},
+		{"a: [1, 2\n", "line 1: missing"},
+		{"a: \"open\n", "line 1: unterminated string"},
+		{"a: 1\n---\nb: 2\n", "line 2: only one YAML document"},
+		{"just a string\nb: 1\n", "line 2: expected the end of the document"},
+		{"- 1\nb: 2\n", "line 2: expected the end of the document"},
+	} {
+		_, err := yamlToJSON([]byte(test.yaml))
+		if err == nil || !strings.Contains(err.Error(), test.err) {
+			t.Errorf("%q: got %v, want %q", test.yaml, err, test.err)
+		}
+	}
+}
+
+func TestLoadConfigYAML(t *testing.T) {
+	dir := t.TempDir()
+	write := func(name, data string) string {
+		path := filepath.Join(dir, name)
+		if err := os.WriteFile(path, []byte(data), 0o644); err != nil {
+			t.Fatal(err)
+		}
+		return path
+	}
+	fromJSON, err := LoadConfig(write("config.json", `{
+		"token": "abc",
+		"timeout": "10s",
+		"retry": {"attempts": 3, "backoff": 1.5},
+		"cache": {"size": 100, "ttl": "1m"},
+		"concurrency_limits": {"/player": 2}
+	}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	fromYAML, err := LoadConfig(write("config.yml", `
+token: abc
+timeout: 10s
+retry:
+  attempts: 3
+  backoff: 1.5 # seconds
+cache: {size: 100, ttl: 1m}
+concurrency_limits:
+  /player: 2
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(fromYAML, fromJSON) {
+		t.Errorf("the YAML config is %+v, the JSON one is %+v", fromYAML, fromJSON)
+	}
+	if time.Duration(fromYAML.Retry.Backoff) != 1500*time.Millisecond {
+		t.Errorf("retry.backoff is %v, want 1.5s", time.Duration(fromYAML.Retry.Backoff))
+	}
+
+	if _, err := LoadConfig(write("typo.yaml", "tokn: abc\n")); err == nil || !strings.Contains(err.Error(), "tokn") {
+		t.Errorf("an unknown field in YAML is %v, want an error naming it", err)
+	}
+	if _, err := LoadConfig(write("bad.yaml", "token: abc\n  timeout: 1s\n")); err == nil || !strings.Contains(err.Error(), "bad.yaml: line 2") {
+		t.Errorf("bad YAML is %v, want an error with the file and line", err)
+	}
+}
+
+func TestConfigFromEnvConcurrency(t *testing.T) {
+	t.Setenv("ROYALEAPI_CONCURRENCY_PLAYER", "2")
+	t.Setenv("ROYALEAPI_CONCURRENCY_CLAN", "4")
+	cfg, err := ConfigFromEnv()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := map[string]int{"/player": 2, "/clan": 4}; !reflect.DeepEqual(cfg.ConcurrencyLimits, want) {
+		t.Errorf("got %v, want %v", cfg.ConcurrencyLimits, want)
+	}
+
+	t.Setenv("ROYALEAPI_CONCURRENCY_CLAN", "lots")
+	if _, err := ConfigFromEnv(); err == nil || !strings.Contains(err.Error(), "ROYALEAPI_CONCURRENCY_CLAN") {
+		t.Errorf("got %v, want an error naming the variable", err)
+	}
+}
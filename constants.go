@@ -0,0 +1,90 @@
+package goroyale
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+)
+
+// ConstantsSections holds the top-level sections of the constants payload without decoding them.
+// Each section is only decoded when asked for, so you don't need to keep the whole Constants struct in memory.
+// Keys are the JSON keys used by the API, ex: "cards" or "arenas".
+type ConstantsSections map[string]json.RawMessage
+
+// Decode unmarshals a single section into v.
+func (s ConstantsSections) Decode(key string, v interface{}) error {
+	raw, ok := s[key]
+	if !ok {
+		return fmt.Errorf("constants section %q not in response", key)
+	}
+	return json.Unmarshal(raw, v)
+}
+
+// Cards decodes the "cards" section.
+func (s ConstantsSections) Cards() (cards []ConstantsCard, err error) {
+	err = s.Decode("cards", &cards)
+	return
+}
+
+// Arenas decodes the "arenas" section.
+func (s ConstantsSections) Arenas() (arenas []ConstantsArena, err error) {
+	err = s.Decode("arenas", &arenas)
+	return
+}
+
+// Rarities decodes the "rarities" section.
+func (s ConstantsSections) Rarities() (rarities []ConstantsRarity, err error) {
+	err = s.Decode("rarities", &rarities)
+	return
+}
+
+// GameModes decodes the "game_modes" section.
+func (s ConstantsSections) GameModes() (gameModes []ConstantsGameMode, err error) {
+	err = s.Decode("game_modes", &gameModes)
+	return
+}
+
+// Regions decodes the "regions" section.
+func (s ConstantsSections) Regions() (regions []ConstantsRegion, err error) {
+	err = s.Decode("regions", &regions)
+	return
+}
+
+// Tournaments decodes the "tournaments" section.
+func (s ConstantsSections) Tournaments() (tournaments []ConstantsTournament, err error) {
+	err = s.Decode("tournaments", &tournaments)
+	return
+}
+
+// TreasureChests decodes the "treasure_chests" section.
+func (s ConstantsSections) TreasureChests() (chests ConstantsTreasureChests, err error) {
+	err = s.Decode("treasure_chests", &chests)
+	return
+}
+
+// ConstantsSections works like Constants but leaves every section undecoded.
+// https://docs.royaleapi.com/#/endpoints/constants
+func (c *Client) ConstantsSections(params url.Values) (sections ConstantsSections, err error) {
+	path := "/constants"
+	// json.RawMessage copies what it's given, so the sections don't point into getJSON's pooled buffer
+	err = c.getJSON(path, params, &sections)
+	return
+}
+
+// ConstantsCards returns only the cards from the constants endpoint.
+func (c *Client) ConstantsCards(params url.Values) (cards []ConstantsCard, err error) {
+	var sections ConstantsSections
+	if sections, err = c.ConstantsSections(params); err == nil {
+		cards, err = sections.Cards()
+	}
+	return
+}
+
+// ConstantsArenas returns only the arenas from the constants endpoint.
+func (c *Client) ConstantsArenas(params url.Values) (arenas []ConstantsArena, err error) {
+	var sections ConstantsSections
+	if sections, err = c.ConstantsSections(params); err == nil {
+		arenas, err = sections.Arenas()
+	}
+	return
+}
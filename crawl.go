@@ -0,0 +1,279 @@
+package goroyale
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"os"
+	"sync"
+	"time"
+)
+
+// Defaults used by Crawl when CrawlOptions leaves them 0.
+const (
+	DefaultCrawlWorkers         = 5
+	DefaultCrawlCheckpointEvery = 100
+)
+
+// crawlRateLimitWait is how long Crawl backs off after a 429 when it doesn't know when the ratelimit resets.
+const crawlRateLimitWait = time.Second
+
+// CrawlCheckpoint is how far a Crawl has got.
+type CrawlCheckpoint struct {
+	Done      map[string]bool   // tags that have been fetched, including ones that failed
+	Failed    map[string]string // the error each failed tag got
+	UpdatedAt time.Time
+}
+
+// CheckpointStore persists CrawlCheckpoints so a Crawl can pick up where it left off.
+type CheckpointStore interface {
+	// LoadCheckpoint returns the saved checkpoint, an empty one if nothing has been saved.
+	LoadCheckpoint() (CrawlCheckpoint, error)
+	SaveCheckpoint(CrawlCheckpoint) error
+}
+
+// MemoryCheckpointStore is a CheckpointStore that keeps the checkpoint in memory,
+// ex: to resume a Crawl within the same process after ctx was cancelled.
+type MemoryCheckpointStore struct {
+	mu         sync.Mutex
+	checkpoint CrawlCheckpoint
+}
+
+// LoadCheckpoint returns a copy of the last saved checkpoint.
+func (m *MemoryCheckpointStore) LoadCheckpoint() (CrawlCheckpoint, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.checkpoint.copy(), nil
+}
+
+// SaveCheckpoint keeps a copy of cp.
+func (m *MemoryCheckpointStore) SaveCheckpoint(cp CrawlCheckpoint) error {
+	m.mu.Lock()
+	m.checkpoint = cp.copy()
+	m.mu.Unlock()
+	return nil
+}
+
+// FileCheckpointStore is a CheckpointStore that keeps the checkpoint as JSON in a file.
+// A missing file is an empty checkpoint.
+type FileCheckpointStore struct {
+	Path string
+}
+
+// LoadCheckpoint reads the checkpoint from the file.
+func (f FileCheckpointStore) LoadCheckpoint() (cp CrawlCheckpoint, err error) {
+	b, err := ioutil.ReadFile(f.Path)
+	if os.IsNotExist(err) {
+		return cp, nil
+	}
+	if err != nil {
+		return
+	}
+	err = json.Unmarshal(b, &cp)
+	return
+}
+
+// SaveCheckpoint writes the checkpoint to the file without ever leaving it half written.
+func (f FileCheckpointStore) SaveCheckpoint(cp CrawlCheckpoint) error {
+	b, err := json.Marshal(cp)
+	if err != nil {
+		return err
+	}
+	return writeFileAtomic(f.Path, b)
+}
+
+func (cp CrawlCheckpoint) copy() CrawlCheckpoint {
+	c := CrawlCheckpoint{Done: make(map[string]bool, len(cp.Done)), Failed: make(map[string]string, len(cp.Failed)), UpdatedAt: cp.UpdatedAt}
+	for tag := range cp.Done {
+		c.Done[tag] = true
+	}
+	for tag, err := range cp.Failed {
+		c.Failed[tag] = err
+	}
+	return c
+}
+
+// CrawlProgress is reported by Crawl as it goes.
+type CrawlProgress struct {
+	Total     int // tags passed to Crawl
+	Done      int // tags fetched, including ones done before a resume and ones that failed
+	Failed    int
+	Remaining int
+	Elapsed   time.Duration // since this run of Crawl started
+	Rate      float64       // tags per second fetched by this run
+	ETA       time.Duration // estimated time left at Rate, 0 if it can't be estimated yet
+}
+
+// CrawlOptions configures Crawl.
+type CrawlOptions struct {
+	Workers int // how many tags are fetched at once, DefaultCrawlWorkers if it is 0
+	// CheckpointEvery is how many tags are finished between saved checkpoints, DefaultCrawlCheckpointEvery if it is 0.
+	CheckpointEvery int
+	// RetryFailed fetches the tags that failed in an earlier run again.
+	RetryFailed bool
+	// Progress is called after every checkpoint, it shouldn't block.
+	Progress func(CrawlProgress)
+	// Interval returns the least time between fetches starting, fetches go as fast as the workers can if it
+	// is nil or returns 0. It's called before each fetch so the pace can change during a crawl,
+	// ex: Supervisor.CrawlInterval follows the crawl_interval of reloaded configs.
+	Interval func() time.Duration
+	// Clock is what intervals, ratelimit waits, and progress are timed with, the real clock if it is nil.
+	Clock Clock
+}
+
+// Crawl calls fetch for every tag, saving how far it has got to store so a crashed or cancelled crawl
+// can be run again with the same tags and continue from its last checkpoint instead of starting over.
+// fetch should make its requests with ctx, ex: c.WithContext(ctx).Player(tag, nil), and store the result itself.
+//
+// A tag whose fetch returns an error is recorded in the checkpoint's Failed and skipped,
+// except for 429s and RateLimitWaitErrors, which are waited out and tried again.
+// Crawl stops early if ctx is done or the checkpoint can't be saved, and always saves a final checkpoint.
+func Crawl(ctx context.Context, tags []string, fetch func(ctx context.Context, tag string) error, store CheckpointStore, opts CrawlOptions) (progress CrawlProgress, err error) {
+	workers := opts.Workers
+	if workers <= 0 {
+		workers = DefaultCrawlWorkers
+	}
+	every := opts.CheckpointEvery
+	if every <= 0 {
+		every = DefaultCrawlCheckpointEvery
+	}
+	clock := opts.Clock
+	if clock == nil {
+		clock = realClock{}
+	}
+
+	saved, err := store.LoadCheckpoint()
+	if err != nil {
+		return
+	}
+	cp := saved.copy()
+	if opts.RetryFailed {
+		for tag := range cp.Failed {
+			delete(cp.Done, tag)
+			delete(cp.Failed, tag)
+		}
+	}
+
+	var todo []string
+	for _, tag := range tags {
+		if !cp.Done[tag] {
+			todo = append(todo, tag)
+		}
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	start := clock.Now()
+	var (
+		mu       sync.Mutex
+		finished int // tags finished by this run
+		saveErr  error
+	)
+	report := func() CrawlProgress {
+		p := CrawlProgress{Total: len(tags), Failed: len(cp.Failed), Elapsed: clock.Now().Sub(start)}
+		for _, tag := range tags {
+			if cp.Done[tag] {
+				p.Done++
+			}
+		}
+		p.Remaining = p.Total - p.Done
+		if sec := p.Elapsed.Seconds(); sec > 0 && finished > 0 {
+			p.Rate = float64(finished) / sec
+			p.ETA = time.Duration(float64(p.Remaining) / p.Rate * float64(time.Second))
+		}
+		return p
+	}
+	// checkpoint saves cp and reports progress, mu must be held
+	checkpoint := func() {
+		cp.UpdatedAt = clock.Now()
+		if err := store.SaveCheckpoint(cp.copy()); err != nil && saveErr == nil {
+			saveErr = err
+			cancel()
+		}
+		if opts.Progress != nil {
+			opts.Progress(report())
+		}
+	}
+
+	jobs := make(chan string)
+	var wg sync.WaitGroup
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for tag := range jobs {
+				fetchErr := crawlOne(ctx, clock, tag, fetch)
+				if ctx.Err() != nil {
+					// an interrupted fetch isn't done, it'll be tried again on resume
+					return
+				}
+				mu.Lock()
+				cp.Done[tag] = true
+				if fetchErr != nil {
+					cp.Failed[tag] = fetchErr.Error()
+				}
+				if finished++; finished%every == 0 {
+					checkpoint()
+				}
+				mu.Unlock()
+			}
+		}()
+	}
+	var last time.Time // when the last fetch was handed out
+feed:
+	for _, tag := range todo {
+		if opts.Interval != nil && !last.IsZero() {
+			if err := sleepContext(ctx, clock, last.Add(opts.Interval()).Sub(clock.Now())); err != nil {
+				break feed
+			}
+		}
+		select {
+		case jobs <- tag:
+			last = clock.Now()
+		case <-ctx.Done():
+			break feed
+		}
+	}
+	close(jobs)
+	wg.Wait()
+
+	mu.Lock()
+	defer mu.Unlock()
+	checkpoint()
+	progress = report()
+	if err = saveErr; err == nil {
+		err = ctx.Err()
+	}
+	return
+}
+
+// crawlOne fetches tag, waiting out the ratelimit whenever the fetch hits it.
+func crawlOne(ctx context.Context, clock Clock, tag string, fetch func(ctx context.Context, tag string) error) error {
+	for {
+		err := fetch(ctx, tag)
+		wait, limited := crawlBackoff(err)
+		if !limited {
+			return err
+		}
+		if err := sleepContext(ctx, clock, wait); err != nil {
+			return err
+		}
+	}
+}
+
+// crawlBackoff reports whether err means the ratelimit was hit and how long to wait before trying again.
+func crawlBackoff(err error) (wait time.Duration, limited bool) {
+	var waitErr RateLimitWaitError
+	if errors.As(err, &waitErr) {
+		if waitErr.Wait > 0 {
+			return waitErr.Wait, true
+		}
+		return crawlRateLimitWait, true
+	}
+	var apiErr APIError
+	if errors.As(err, &apiErr) && apiErr.StatusCode == 429 {
+		return crawlRateLimitWait, true
+	}
+	return 0, false
+}
@@ -0,0 +1,71 @@
+package goroyale
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// cardIdentity is what identifies a card in a deck hash, its ID or its key if the ID is missing.
+func cardIdentity(id int, key string) string {
+	if id != 0 {
+		return strconv.Itoa(id)
+	}
+	return key
+}
+
+func deckHash(ids []string) string {
+	sort.Strings(ids)
+	sum := sha1.Sum([]byte(strings.Join(ids, ",")))
+	return hex.EncodeToString(sum[:])
+}
+
+// DeckHash returns a hash of a deck's cards that doesn't depend on their order or levels,
+// so the same deck always hashes the same, ex: to use decks as map keys.
+// PopularDeck.Hash returns the same hash for the same cards.
+func DeckHash(deck []Card) string {
+	ids := make([]string, len(deck))
+	for i, c := range deck {
+		ids[i] = cardIdentity(c.ID, c.Key)
+	}
+	return deckHash(ids)
+}
+
+// Hash returns the DeckHash of the deck's cards.
+func (d PopularDeck) Hash() string {
+	ids := make([]string, len(d.Cards))
+	for i, c := range d.Cards {
+		ids[i] = cardIdentity(c.ID, c.Key)
+	}
+	return deckHash(ids)
+}
+
+// CanonicalizeDeck returns a copy of deck sorted by card ID, cards without an ID go last sorted by key.
+func CanonicalizeDeck(deck []Card) []Card {
+	sorted := append([]Card(nil), deck...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		a, b := sorted[i], sorted[j]
+		if (a.ID == 0) != (b.ID == 0) {
+			return a.ID != 0
+		}
+		if a.ID != b.ID {
+			return a.ID < b.ID
+		}
+		return a.Key < b.Key
+	})
+	return sorted
+}
+
+// SameDeck reports whether a and b have the same cards, in any order and at any level.
+func SameDeck(a, b []Card) bool {
+	return len(a) == len(b) && DeckHash(a) == DeckHash(b)
+}
+
+// deckKey is an order independent, readable key for a deck's cards.
+func deckKey(cardKeys []string) string {
+	keys := append([]string(nil), cardKeys...)
+	sort.Strings(keys)
+	return strings.Join(keys, ",")
+}
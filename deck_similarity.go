@@ -0,0 +1,144 @@
+package goroyale
+
+import (
+	"math"
+	"sort"
+)
+
+// Weights DeckSimilarity gives cards, a shared win condition says more about a deck than a shared spell.
+const (
+	winConditionWeight = 2.0
+	cardWeight         = 1.0
+)
+
+// similarityCard is what DeckSimilarity needs to know about a card.
+type similarityCard struct {
+	id     string
+	key    string
+	typ    string
+	elixir int
+}
+
+func (c similarityCard) weight() float64 {
+	if WinConditions[c.key] {
+		return winConditionWeight
+	}
+	return cardWeight
+}
+
+// partial is how much credit c gets for being swapped for other, ex: a Fireball for a Poison.
+// Cards with the same role get some credit, more if they cost about the same.
+func (c similarityCard) partial(other similarityCard) float64 {
+	sameRole := WinConditions[c.key] == WinConditions[other.key] && (WinConditions[c.key] || c.typ != "" && c.typ == other.typ)
+	if !sameRole {
+		return 0
+	}
+	if math.Abs(float64(c.elixir-other.elixir)) <= 1 {
+		return 0.3
+	}
+	return 0.1
+}
+
+// similarityCards fills in the type and elixir of cards from GameData when they're missing, like in battle decks.
+func similarityCards(deck []Card) []similarityCard {
+	constants, _ := GameData()
+	cards := make([]similarityCard, len(deck))
+	for i, c := range deck {
+		sc := similarityCard{cardIdentity(c.ID, c.Key), c.Key, c.Type, c.Elixir}
+		if sc.typ == "" || sc.elixir == 0 {
+			for _, cc := range constants.Cards {
+				if (c.ID != 0 && cc.ID == c.ID) || (c.Key != "" && cc.Key == c.Key) {
+					sc.key, sc.typ, sc.elixir = cc.Key, cc.Type, cc.Elixir
+					break
+				}
+			}
+		}
+		cards[i] = sc
+	}
+	return cards
+}
+
+// overlap is how much of a's weight is matched in b, exact matches first then partial ones.
+func overlap(a, b []similarityCard) (credit, total float64) {
+	used := make([]bool, len(b))
+	var unmatched []similarityCard
+	for _, c := range a {
+		total += c.weight()
+		found := false
+		for j, o := range b {
+			if !used[j] && (o.id == c.id || c.key != "" && o.key == c.key) {
+				used[j], found = true, true
+				credit += c.weight()
+				break
+			}
+		}
+		if !found {
+			unmatched = append(unmatched, c)
+		}
+	}
+	for _, c := range unmatched {
+		best, bestJ := 0.0, -1
+		for j, o := range b {
+			if p := c.partial(o); !used[j] && p > best {
+				best, bestJ = p, j
+			}
+		}
+		if bestJ >= 0 {
+			used[bestJ] = true
+			credit += best * c.weight()
+		}
+	}
+	return
+}
+
+// DeckSimilarity returns how alike two decks are, from 0 for nothing in common to 1 for the same cards.
+// Win conditions count for more than other cards, and cards that aren't shared still count a little
+// if they were swapped for a card with the same role, ex: Fireball for Poison.
+func DeckSimilarity(a, b []Card) float64 {
+	ca, cb := similarityCards(a), similarityCards(b)
+	creditA, totalA := overlap(ca, cb)
+	creditB, totalB := overlap(cb, ca)
+	if totalA == 0 || totalB == 0 {
+		return 0
+	}
+	// average both ways so the result doesn't depend on the order of a and b
+	return (creditA/totalA + creditB/totalB) / 2
+}
+
+// Deck returns the deck's cards as Cards.
+func (d MetaDeck) Deck() []Card {
+	deck := make([]Card, len(d.Cards))
+	for i, c := range d.Cards {
+		deck[i] = Card{
+			Name:     c.Name,
+			MaxLevel: c.MaxLevel,
+			Rarity:   c.Rarity,
+			Icon:     c.Icon,
+			Key:      c.Key,
+			Elixir:   c.Elixir,
+			Type:     c.Type,
+			Arena:    c.Arena,
+			ID:       c.ID,
+		}
+	}
+	return deck
+}
+
+// SimilarDeck is a deck found by FindSimilarDecks.
+type SimilarDeck struct {
+	Deck       MetaDeck
+	Similarity float64
+}
+
+// FindSimilarDecks returns the n decks in corpus most like deck, most similar first,
+// ex: to match an opponent's deck to the archetypes in a MetaReport.
+func FindSimilarDecks(deck []Card, corpus []MetaDeck, n int) (similar []SimilarDeck) {
+	for _, d := range corpus {
+		similar = append(similar, SimilarDeck{d, DeckSimilarity(deck, d.Deck())})
+	}
+	sort.SliceStable(similar, func(i, j int) bool { return similar[i].Similarity > similar[j].Similarity })
+	if len(similar) > n {
+		similar = similar[:n]
+	}
+	return
+}
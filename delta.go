@@ -0,0 +1,93 @@
+package goroyale
+
+import "sync"
+
+// PlayerField names a part of a Player that DiffPlayers compares.
+type PlayerField string
+
+// Fields reported by DiffPlayers.
+const (
+	PlayerFieldName      PlayerField = "name"
+	PlayerFieldTrophies  PlayerField = "trophies"
+	PlayerFieldArena     PlayerField = "arena"      // Old and New are arena names
+	PlayerFieldLevel     PlayerField = "level"      // king level
+	PlayerFieldClan      PlayerField = "clan"       // Old and New are clan tags, "" when not in a clan
+	PlayerFieldRole      PlayerField = "role"       // only when the clan didn't change
+	PlayerFieldDonations PlayerField = "donations"  // donations this week
+	PlayerFieldGames     PlayerField = "games"      // total games played
+	PlayerFieldDeck      PlayerField = "deck"       // Old and New are comma separated card keys
+	PlayerFieldMaxTrophy PlayerField = "max_trophy" // personal best
+)
+
+// PlayerChange is a field that differs between two fetches of a player.
+type PlayerChange struct {
+	Field PlayerField
+	Old   interface{}
+	New   interface{}
+}
+
+// DiffPlayers returns what changed between two versions of a player, in the order of the PlayerField constants.
+func DiffPlayers(old, new Player) (changes []PlayerChange) {
+	add := func(field PlayerField, o, n interface{}) {
+		if o != n {
+			changes = append(changes, PlayerChange{field, o, n})
+		}
+	}
+	add(PlayerFieldName, old.Name, new.Name)
+	add(PlayerFieldTrophies, old.Trophies, new.Trophies)
+	add(PlayerFieldArena, old.Arena.Name, new.Arena.Name)
+	add(PlayerFieldLevel, old.Stats.Level, new.Stats.Level)
+	add(PlayerFieldClan, old.Clan.Tag, new.Clan.Tag)
+	if old.Clan.Tag == new.Clan.Tag {
+		add(PlayerFieldRole, old.Clan.Role, new.Clan.Role)
+	}
+	add(PlayerFieldDonations, old.Clan.Donations, new.Clan.Donations)
+	add(PlayerFieldGames, old.Games.Total, new.Games.Total)
+	add(PlayerFieldDeck, cardKeys(old.CurrentDeck), cardKeys(new.CurrentDeck))
+	add(PlayerFieldMaxTrophy, old.Stats.MaxTrophies, new.Stats.MaxTrophies)
+	return
+}
+
+// cardKeys returns the deckKey of a deck of Cards.
+func cardKeys(deck []Card) string {
+	keys := make([]string, len(deck))
+	for i, c := range deck {
+		keys[i] = c.Key
+	}
+	return deckKey(keys)
+}
+
+// ChangeDetector remembers the last version of each player it fetched so it can report what changed.
+type ChangeDetector struct {
+	Client *Client
+
+	mu      sync.Mutex
+	players map[string]Player
+}
+
+// FetchPlayerDelta fetches a player and returns what changed since the last time they were fetched.
+// The first fetch of a player has no changes, ok is false until there's something to compare against.
+func (d *ChangeDetector) FetchPlayerDelta(tag string) (p Player, changes []PlayerChange, ok bool, err error) {
+	if p, err = d.Client.Player(tag, nil); err != nil {
+		return
+	}
+	d.mu.Lock()
+	if d.players == nil {
+		d.players = make(map[string]Player)
+	}
+	old, ok := d.players[tag]
+	d.players[tag] = p
+	d.mu.Unlock()
+
+	if ok {
+		changes = DiffPlayers(old, p)
+	}
+	return
+}
+
+// Forget drops the remembered version of a player.
+func (d *ChangeDetector) Forget(tag string) {
+	d.mu.Lock()
+	delete(d.players, tag)
+	d.mu.Unlock()
+}
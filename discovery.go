@@ -0,0 +1,164 @@
+package goroyale
+
+import "context"
+
+// DiscoveredKind says whether a DiscoveredTag is a player or a clan.
+type DiscoveredKind string
+
+// Kinds of DiscoveredTag.
+const (
+	DiscoveredPlayer DiscoveredKind = "player"
+	DiscoveredClan   DiscoveredKind = "clan"
+)
+
+// DiscoveredTag is a tag found by DiscoverTags.
+type DiscoveredTag struct {
+	Tag  string // normalized, see NormalizeTag
+	Kind DiscoveredKind
+	From string // the clan or player it was found through, "" for seeds
+}
+
+// DiscoveryOptions configures DiscoverTags.
+type DiscoveryOptions struct {
+	// MaxPlayers and MaxClans stop discovery once that many of each have been found, 0 means no limit.
+	// Discovery stops when MaxPlayers is reached or there are no clans left to expand.
+	MaxPlayers int
+	MaxClans   int
+	// FollowOpponents also yields the opponents in members' battles and expands their clans.
+	FollowOpponents bool
+	// OnError is called when a clan or player can't be fetched, and discovery carries on without it.
+	// If it is nil the first error stops discovery and is returned.
+	OnError func(tag string, err error)
+}
+
+// DiscoverTags finds player and clan tags for building datasets, starting from the seed clans.
+// Each clan's members are yielded, then each member's battles are checked for clans they've been in before,
+// which are yielded and expanded in turn, breadth first.
+// Every tag is only yielded once.
+// It stops early when yield returns an error or ctx is done and returns that error.
+func (c *Client) DiscoverTags(ctx context.Context, seeds []string, opts DiscoveryOptions, yield func(DiscoveredTag) error) error {
+	d := discovery{
+		client:  c.WithContext(ctx),
+		opts:    opts,
+		yield:   yield,
+		players: make(map[string]bool),
+		clans:   make(map[string]bool),
+	}
+	for _, seed := range seeds {
+		if err := d.addClan(seed, ""); err != nil {
+			return err
+		}
+	}
+
+	// clans are only found through new players, so once there's no room for players there's nothing left to find
+	for len(d.queue) > 0 && !d.playersFull() {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		tag := d.queue[0]
+		d.queue = d.queue[1:]
+		if err := d.expand(tag); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type discovery struct {
+	client *Client
+	opts   DiscoveryOptions
+	yield  func(DiscoveredTag) error
+
+	players, clans map[string]bool
+	queue          []string // clans waiting to be expanded
+}
+
+func (d *discovery) playersFull() bool {
+	return d.opts.MaxPlayers > 0 && len(d.players) >= d.opts.MaxPlayers
+}
+
+func (d *discovery) clansFull() bool {
+	return d.opts.MaxClans > 0 && len(d.clans) >= d.opts.MaxClans
+}
+
+// worthFollowing reports whether a player's battles could still turn up anything new.
+func (d *discovery) worthFollowing() bool {
+	return !d.clansFull() || (d.opts.FollowOpponents && !d.playersFull())
+}
+
+func (d *discovery) addClan(tag, from string) error {
+	tag = NormalizeTag(tag)
+	if tag == "" || d.clans[tag] || d.clansFull() {
+		return nil
+	}
+	d.clans[tag] = true
+	d.queue = append(d.queue, tag)
+	return d.yield(DiscoveredTag{tag, DiscoveredClan, from})
+}
+
+// addPlayer yields tag and reports whether it was new.
+func (d *discovery) addPlayer(tag, from string) (added bool, err error) {
+	tag = NormalizeTag(tag)
+	if tag == "" || d.players[tag] || d.playersFull() {
+		return
+	}
+	d.players[tag] = true
+	return true, d.yield(DiscoveredTag{tag, DiscoveredPlayer, from})
+}
+
+// failed reports err through OnError, or returns it if there isn't one.
+func (d *discovery) failed(tag string, err error) error {
+	if d.opts.OnError == nil {
+		return err
+	}
+	d.opts.OnError(tag, err)
+	return nil
+}
+
+// expand yields a clan's members and follows their battles to other clans.
+func (d *discovery) expand(clanTag string) error {
+	clan, err := d.client.Clan(clanTag, nil)
+	if err != nil {
+		return d.failed(clanTag, err)
+	}
+	for _, m := range clan.Members {
+		added, err := d.addPlayer(m.Tag, clanTag)
+		if err != nil {
+			return err
+		}
+		if !added || !d.worthFollowing() {
+			continue
+		}
+		if err := d.follow(m.Tag, clanTag); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// follow looks through a player's battles for clans other than the one they were found in.
+func (d *discovery) follow(playerTag, clanTag string) error {
+	battles, err := d.client.PlayerBattles(playerTag, nil)
+	if err != nil {
+		return d.failed(playerTag, err)
+	}
+	for _, b := range battles {
+		if m, ok := b.PlayerOf(playerTag); ok && NormalizeTag(m.Clan.Tag) != clanTag {
+			if err := d.addClan(m.Clan.Tag, playerTag); err != nil {
+				return err
+			}
+		}
+		if !d.opts.FollowOpponents {
+			continue
+		}
+		for _, o := range b.OpponentOf(playerTag) {
+			if _, err := d.addPlayer(o.Tag, playerTag); err != nil {
+				return err
+			}
+			if err := d.addClan(o.Clan.Tag, playerTag); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
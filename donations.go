@@ -0,0 +1,96 @@
+package goroyale
+
+import "sort"
+
+// DaysPerWeek is how many days of donations ClanMember.Donations covers, the count resets every week.
+const DaysPerWeek = 7
+
+// DonationCapacity is how much a player in an arena can request and donate, from the arenas in GameData.
+// Limits are in donation capacity, see DonateCapacity in ConstantsRarity, which is 1 for a common card.
+type DonationCapacity struct {
+	Arena       int // like Arena.ArenaID
+	RequestSize int // commons that can be requested at once
+	DailyLimit  int
+	// MaxDonations is how many cards of each rarity can be donated to a single request, by rarity name.
+	MaxDonations map[string]int
+}
+
+// WeeklyLimit returns the most donation capacity that can be used in a week.
+func (d DonationCapacity) WeeklyLimit() int {
+	return d.DailyLimit * DaysPerWeek
+}
+
+// MaxWeeklyCards returns the most cards of the rarity that can be donated in a week, ex: "Rare".
+// It's 0 if the rarity isn't in GameData.
+func (d DonationCapacity) MaxWeeklyCards(rarity string) int {
+	constants, _ := GameData()
+	for _, r := range constants.Rarities {
+		if r.Name == rarity && r.DonateCapacity > 0 {
+			return d.WeeklyLimit() / r.DonateCapacity
+		}
+	}
+	return 0
+}
+
+// ArenaDonationCapacity returns the donation limits of the arena with the ID, like Arena.ArenaID.
+// Arenas past the last one in GameData, like the leagues, use the limits of the last one.
+func ArenaDonationCapacity(arenaID int) (capacity DonationCapacity, ok bool) {
+	constants, err := GameData()
+	if err != nil {
+		return
+	}
+	var best ConstantsArena
+	for _, a := range constants.Arenas {
+		if a.Arena <= arenaID && a.Arena >= best.Arena && a.RequestSize > 0 {
+			best, ok = a, true
+		}
+	}
+	if !ok {
+		return
+	}
+	return DonationCapacity{
+		Arena:       best.Arena,
+		RequestSize: best.RequestSize,
+		DailyLimit:  best.DailyDonationCapacityLimit,
+		MaxDonations: map[string]int{
+			"Common": best.MaxDonationCountCommon,
+			"Rare":   best.MaxDonationCountRare,
+			"Epic":   best.MaxDonationCountEpic,
+		},
+	}, true
+}
+
+// DonationEfficiency is how much of their weekly donation capacity a clan member has used.
+type DonationEfficiency struct {
+	Member   ClanMember
+	Capacity DonationCapacity
+	// Efficiency is Member.Donations divided by the most commons the member's arena lets them donate in a week.
+	// The API doesn't say which rarities were donated, so members donating rares can't reach 1.
+	Efficiency float64
+}
+
+// MemberDonationEfficiency works out the DonationEfficiency of a clan member.
+// ok is false if the member's arena isn't in GameData.
+func MemberDonationEfficiency(m ClanMember) (e DonationEfficiency, ok bool) {
+	e.Member = m
+	if e.Capacity, ok = ArenaDonationCapacity(m.Arena.ArenaID); !ok {
+		return
+	}
+	if limit := e.Capacity.WeeklyLimit(); limit > 0 {
+		e.Efficiency = float64(m.Donations) / float64(limit)
+	}
+	return
+}
+
+// ClanDonationEfficiency works out the DonationEfficiency of every member of the clan, most efficient first,
+// so members in low arenas can be compared fairly with members who can donate more.
+// Members whose arena isn't in GameData are left out.
+func ClanDonationEfficiency(clan Clan) (members []DonationEfficiency) {
+	for _, m := range clan.Members {
+		if e, ok := MemberDonationEfficiency(m); ok {
+			members = append(members, e)
+		}
+	}
+	sort.SliceStable(members, func(i, j int) bool { return members[i].Efficiency > members[j].Efficiency })
+	return
+}
@@ -0,0 +1,188 @@
+package goroyale
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// ErrDryRun is matched by errors.Is when a request wasn't sent because the Client is in dry run mode.
+var ErrDryRun = errors.New("dry run, request not sent")
+
+// DryRunError is returned instead of a response by a Client with DryRun set
+// when the request it would have sent is valid.
+type DryRunError struct {
+	URL    string // the full URL that would have been requested
+	Path   string
+	Params url.Values
+}
+
+func (err DryRunError) Error() string {
+	return ErrDryRun.Error() + ": " + err.URL
+}
+
+// Is reports whether target is ErrDryRun.
+func (err DryRunError) Is(target error) bool {
+	return target == ErrDryRun
+}
+
+// ErrInvalidRequest is matched by errors.Is when ValidateRequest finds a problem with a request.
+var ErrInvalidRequest = errors.New("invalid request")
+
+// InvalidRequestError describes everything wrong with a request found by ValidateRequest.
+type InvalidRequestError struct {
+	Path     string
+	Problems []string
+}
+
+func (err InvalidRequestError) Error() string {
+	return fmt.Sprintf("%v %s: %s", ErrInvalidRequest, err.Path, strings.Join(err.Problems, "; "))
+}
+
+// Is reports whether target is ErrInvalidRequest.
+func (err InvalidRequestError) Is(target error) bool {
+	return target == ErrInvalidRequest
+}
+
+// WithDryRun sets Client.DryRun.
+func WithDryRun() Option {
+	return func(c *Client) {
+		c.DryRun = true
+	}
+}
+
+// tagAlphabet holds every character a player, clan, or tournament tag can contain.
+const tagAlphabet = "0289PYLQGRJCUV"
+
+// Tags are at least 3 characters, and the longest seen so far are 12. 15 leaves some room.
+const (
+	minTagLength = 3
+	maxTagLength = 15
+)
+
+// maxTags is how many tags the endpoints that take a list of them accept at once.
+var maxTags = map[string]int{
+	"/player":      7,
+	"/clan":        10,
+	"/tournaments": 10,
+}
+
+// clanLists are the paths under /clan that aren't tags.
+var clanLists = map[string]bool{
+	"search": true,
+}
+
+// tournamentLists are the paths under /tournaments that aren't tags.
+var tournamentLists = map[string]bool{
+	"open":   true,
+	"known":  true,
+	"1k":     true,
+	"prep":   true,
+	"search": true,
+}
+
+// lists are the paths that aren't tags under each endpoint of maxTags.
+var lists = map[string]map[string]bool{
+	"/clan":        clanLists,
+	"/tournaments": tournamentLists,
+}
+
+// knownParams are the query parameters the API accepts.
+var knownParams = map[string]bool{
+	"keys":       true,
+	"exclude":    true,
+	"max":        true,
+	"page":       true,
+	"lang":       true,
+	"name":       true,
+	"score":      true,
+	"minMembers": true,
+	"maxMembers": true,
+	"locationId": true,
+	"from":       true,
+	"to":         true,
+}
+
+// knownEndpoints are the endpoints ValidateRequest checks the params of,
+// others may be newer than this package so anything goes.
+var knownEndpoints = map[string]bool{
+	"/version":     true,
+	"/constants":   true,
+	"/player":      true,
+	"/clan":        true,
+	"/tournaments": true,
+	"/top":         true,
+	"/popular":     true,
+	"/auth":        true,
+	"/endpoints":   true,
+}
+
+// ValidateRequest checks a request before it's sent: that the tags in path are well formed,
+// that there aren't more of them than the endpoint accepts, and that params only has names the API knows.
+// Problems are returned together as an InvalidRequestError.
+func ValidateRequest(path string, params url.Values) error {
+	var problems []string
+	endpoint := endpointOf(path)
+	parts := strings.Split(strings.Trim(path, "/"), "/")
+
+	if _, ok := maxTags[endpoint]; ok && len(parts) > 1 && !lists[endpoint][parts[1]] {
+		tags := strings.Split(parts[1], ",")
+		if limit := maxTags[endpoint]; len(tags) > limit {
+			problems = append(problems, fmt.Sprintf("%d tags is more than the %d %s accepts", len(tags), limit, endpoint))
+		}
+		for _, tag := range tags {
+			if problem := tagProblem(tag); problem != "" {
+				problems = append(problems, problem)
+			}
+		}
+	}
+	if knownEndpoints[endpoint] {
+		for name := range params {
+			if !knownParams[name] {
+				problems = append(problems, fmt.Sprintf("unknown param %q", name))
+			}
+		}
+	}
+
+	if len(problems) > 0 {
+		return InvalidRequestError{path, problems}
+	}
+	return nil
+}
+
+func tagProblem(tag string) string {
+	switch {
+	case tag == "":
+		return "empty tag"
+	case len(tag) < minTagLength || len(tag) > maxTagLength:
+		return fmt.Sprintf("tag %q should be %d to %d characters", tag, minTagLength, maxTagLength)
+	case strings.Trim(tag, tagAlphabet) != "":
+		if normalized := NormalizeTag(tag); normalized != tag && tagProblem(normalized) == "" {
+			return fmt.Sprintf("tag %q isn't normalized, use %q", tag, normalized)
+		}
+		return fmt.Sprintf("tag %q has characters tags can't contain", tag)
+	}
+	return ""
+}
+
+// RequestURL validates a request and returns the URL it would be sent to, without sending it.
+func (c *Client) RequestURL(path string, params url.Values) (string, error) {
+	if err := ValidateRequest(path, params); err != nil {
+		return "", err
+	}
+	u := c.baseURL + path
+	if query := params.Encode(); query != "" {
+		u += "?" + query
+	}
+	return u, nil
+}
+
+// dryRun returns the error a request fails with in dry run mode.
+func (c *Client) dryRun(path string, params url.Values) error {
+	u, err := c.RequestURL(path, params)
+	if err != nil {
+		return err
+	}
+	return DryRunError{u, path, params}
+}
@@ -0,0 +1,49 @@
+package goroyale
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestValidateRequest(t *testing.T) {
+	for _, tt := range []struct {
+		path    string
+		problem string // "" if the request is valid
+	}{
+		{"/player/2PP", ""},
+		{"/player/2PP,8L9L9GL/battles", ""},
+		{"/player/#2pp", "isn't normalized"},
+		{"/player/2PP,2PP,2PP,2PP,2PP,2PP,2PP,2PP", "more than the 7"},
+		{"/clan/2CCCP", ""},
+		{"/clan/search", ""},
+		{"/clan/searches", "characters tags can't contain"},
+		{"/tournaments/known", ""},
+		{"/tournaments/2PP", ""},
+	} {
+		err := ValidateRequest(tt.path, nil)
+		switch {
+		case tt.problem == "" && err != nil:
+			t.Errorf("ValidateRequest(%q) = %v, want nil", tt.path, err)
+		case tt.problem != "" && (err == nil || !strings.Contains(err.Error(), tt.problem)):
+			t.Errorf("ValidateRequest(%q) = %v, want a problem containing %q", tt.path, err, tt.problem)
+		}
+	}
+}
+
+func TestDryRunClanSearch(t *testing.T) {
+	c, err := New("token", WithDryRun(), WithBaseURL("https://api.example"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+
+	_, err = c.ClanSearch(ClanSearchFilter{Name: "Some Clan", MinMembers: 10})
+	var dryRun DryRunError
+	if !errors.As(err, &dryRun) {
+		t.Fatalf("ClanSearch in dry run mode = %v, want a DryRunError", err)
+	}
+	if want := "https://api.example/clan/search?minMembers=10&name=Some+Clan"; dryRun.URL != want {
+		t.Errorf("ClanSearch would request %s, want %s", dryRun.URL, want)
+	}
+}
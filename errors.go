@@ -1,5 +1,11 @@
 package goroyale
 
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
 // APIError represents an error returned from the API.
 // https://docs.royaleapi.com/#/errors
 type APIError struct {
@@ -10,3 +16,54 @@ type APIError struct {
 func (err APIError) Error() string {
 	return err.Message
 }
+
+// ErrNotTracked is matched by errors.Is when a request fails because a clan doesn't have stat tracking enabled.
+var ErrNotTracked = errors.New("clan is not tracked")
+
+// NotTrackedError is returned by ClanHistory and ClanWeeklyHistory for clans that don't have stat tracking enabled.
+// Tracking can be enabled by searching for the clan on https://royaleapi.com/
+type NotTrackedError struct {
+	Tag string
+	Err APIError // the error returned by the API
+}
+
+func (err NotTrackedError) Error() string {
+	return "clan " + err.Tag + " is not tracked: " + err.Err.Message
+}
+
+// Unwrap returns the underlying APIError.
+func (err NotTrackedError) Unwrap() error {
+	return err.Err
+}
+
+// Is reports whether target is ErrNotTracked.
+func (err NotTrackedError) Is(target error) bool {
+	return target == ErrNotTracked
+}
+
+// notTracked turns API errors about tracking into a NotTrackedError.
+func notTracked(tag string, err error) error {
+	var apiErr APIError
+	if errors.As(err, &apiErr) && strings.Contains(strings.ToLower(apiErr.Message), "track") {
+		return NotTrackedError{tag, apiErr}
+	}
+	return err
+}
+
+// ErrResponseTooLarge is matched by errors.Is when a response is bigger than Client.MaxResponseBytes.
+var ErrResponseTooLarge = errors.New("response too large")
+
+// ResponseTooLargeError is returned when a response body is bigger than Client.MaxResponseBytes.
+type ResponseTooLargeError struct {
+	Path  string
+	Limit int64
+}
+
+func (err ResponseTooLargeError) Error() string {
+	return fmt.Sprintf("response from %s is bigger than the %d byte limit", err.Path, err.Limit)
+}
+
+// Is reports whether target is ErrResponseTooLarge.
+func (err ResponseTooLargeError) Is(target error) bool {
+	return target == ErrResponseTooLarge
+}
@@ -0,0 +1,225 @@
+package goroyale
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+// Tagged is implemented by every event published on an EventBus.
+// EventTag is the player or clan tag the event is about.
+type Tagged interface {
+	EventTag() string
+}
+
+// Notifiable events can be turned into the generic Event sent by a Notifier.
+type Notifiable interface {
+	Tagged
+	Event() Event
+}
+
+// Backpressure decides what happens when a subscriber isn't keeping up with events.
+type Backpressure int
+
+// Backpressure policies.
+const (
+	// DropOldest throws away the oldest buffered event to make room, this is the default.
+	DropOldest Backpressure = iota
+	// DropNewest throws away the event that didn't fit.
+	DropNewest
+	// Block makes the publisher wait, which slows down every watcher publishing on the bus.
+	Block
+)
+
+// DefaultSubscriptionBuffer is the buffer size used when SubscribeOptions.Buffer is 0 or less.
+const DefaultSubscriptionBuffer = 64
+
+// SubscribeOptions configures a Subscription.
+type SubscribeOptions struct {
+	// Tags limits events to the ones about these player or clan tags, all events are received if it's empty.
+	Tags   []string
+	Buffer int
+	Policy Backpressure
+}
+
+// EventBus passes events from watchers to subscribers.
+// The zero value is ready to use.
+type EventBus struct {
+	mu   sync.RWMutex
+	subs []subscriber
+}
+
+type subscriber interface {
+	deliver(ev Tagged)
+	close()
+}
+
+// Publish sends ev to every subscriber that wants it.
+func (b *EventBus) Publish(ev Tagged) {
+	b.mu.RLock()
+	subs := append([]subscriber(nil), b.subs...)
+	b.mu.RUnlock()
+	for _, s := range subs {
+		s.deliver(ev)
+	}
+}
+
+// Close unsubscribes every subscriber, closing their channels.
+func (b *EventBus) Close() {
+	b.mu.Lock()
+	subs := b.subs
+	b.subs = nil
+	b.mu.Unlock()
+	for _, s := range subs {
+		s.close()
+	}
+}
+
+func (b *EventBus) add(s subscriber) {
+	b.mu.Lock()
+	b.subs = append(b.subs, s)
+	b.mu.Unlock()
+}
+
+func (b *EventBus) remove(s subscriber) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	for i, sub := range b.subs {
+		if sub == s {
+			b.subs = append(b.subs[:i:i], b.subs[i+1:]...)
+			return
+		}
+	}
+}
+
+// Subscription receives events of type T from an EventBus on C.
+type Subscription[T any] struct {
+	// C is closed once the Subscription is unsubscribed.
+	C <-chan T
+
+	c       chan T
+	bus     *EventBus
+	convert func(Tagged) (T, bool)
+	tags    map[string]bool
+	policy  Backpressure
+	dropped atomic.Int64
+
+	mu     sync.Mutex
+	closed bool
+	done   chan struct{}
+	once   sync.Once
+}
+
+// Subscribe returns a Subscription to events of type T, ex:
+//
+//	battles := goroyale.Subscribe[goroyale.NewBattle](bus, goroyale.SubscribeOptions{})
+//	for b := range battles.C {
+//		fmt.Println(b.Battle)
+//	}
+func Subscribe[T Tagged](bus *EventBus, opts SubscribeOptions) *Subscription[T] {
+	return newSubscription(bus, opts, func(ev Tagged) (T, bool) {
+		t, ok := ev.(T)
+		return t, ok
+	})
+}
+
+// SubscribeAll returns a Subscription to every Notifiable event as a generic Event.
+// Its channel can be passed straight to Notifier.Run.
+func SubscribeAll(bus *EventBus, opts SubscribeOptions) *Subscription[Event] {
+	return newSubscription(bus, opts, func(ev Tagged) (Event, bool) {
+		n, ok := ev.(Notifiable)
+		if !ok {
+			return Event{}, false
+		}
+		return n.Event(), true
+	})
+}
+
+func newSubscription[T any](bus *EventBus, opts SubscribeOptions, convert func(Tagged) (T, bool)) *Subscription[T] {
+	buffer := opts.Buffer
+	if buffer <= 0 {
+		buffer = DefaultSubscriptionBuffer
+	}
+	s := &Subscription[T]{
+		c:       make(chan T, buffer),
+		bus:     bus,
+		convert: convert,
+		policy:  opts.Policy,
+		done:    make(chan struct{}),
+	}
+	s.C = s.c
+	if len(opts.Tags) > 0 {
+		s.tags = make(map[string]bool, len(opts.Tags))
+		for _, tag := range opts.Tags {
+			s.tags[tag] = true
+		}
+	}
+	bus.add(s)
+	return s
+}
+
+func (s *Subscription[T]) deliver(ev Tagged) {
+	if s.tags != nil && !s.tags[ev.EventTag()] {
+		return
+	}
+	v, ok := s.convert(ev)
+	if !ok {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.closed {
+		return
+	}
+	switch s.policy {
+	case Block:
+		select {
+		case s.c <- v:
+		case <-s.done:
+		}
+	case DropNewest:
+		select {
+		case s.c <- v:
+		default:
+			s.dropped.Add(1)
+		}
+	default:
+		for {
+			select {
+			case s.c <- v:
+				return
+			default:
+			}
+			select {
+			case <-s.c:
+				s.dropped.Add(1)
+			default:
+				// unbuffered and nobody is receiving
+				s.dropped.Add(1)
+				return
+			}
+		}
+	}
+}
+
+// Dropped returns how many events were thrown away because the subscriber wasn't keeping up.
+func (s *Subscription[T]) Dropped() int64 {
+	return s.dropped.Load()
+}
+
+// Unsubscribe stops the Subscription and closes C.
+func (s *Subscription[T]) Unsubscribe() {
+	s.bus.remove(s)
+	s.close()
+}
+
+func (s *Subscription[T]) close() {
+	s.once.Do(func() {
+		// wakes up a blocked deliver so the lock can be taken
+		close(s.done)
+		s.mu.Lock()
+		s.closed = true
+		close(s.c)
+		s.mu.Unlock()
+	})
+}
@@ -0,0 +1,123 @@
+package goroyale
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// BlobStore is somewhere exported snapshots get written, ex: an S3 or GCS bucket.
+// Keys use forward slashes.
+type BlobStore interface {
+	PutBlob(ctx context.Context, key string, data []byte) error
+}
+
+// DirBlobStore is a BlobStore that writes to a directory on disk.
+type DirBlobStore struct {
+	Dir string
+}
+
+// PutBlob writes data to the file at key inside d.Dir, making directories as needed.
+func (d DirBlobStore) PutBlob(ctx context.Context, key string, data []byte) error {
+	path := filepath.Join(d.Dir, filepath.FromSlash(key))
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(path, data, 0644)
+}
+
+// SnapshotRecord is a line of an exported NDJSON file.
+type SnapshotRecord struct {
+	FetchedAt time.Time   `json:"fetched_at"`
+	Tag       string      `json:"tag"`
+	Data      interface{} `json:"data"`
+}
+
+// SnapshotExporter periodically fetches clans and players and writes them to a BlobStore.
+//
+// Each run writes one NDJSON file per kind with Hive style partitions, which most
+// analytics tools (Spark, DuckDB, Athena, BigQuery) can read as a single table:
+//
+//	<Prefix>/kind=clans/date=2006-01-02/hour=15/<unix nano>.ndjson
+type SnapshotExporter struct {
+	Client     *Client
+	Store      BlobStore
+	Prefix     string
+	ClanTags   []string
+	PlayerTags []string
+	Interval   time.Duration // DefaultWatchInterval if it is 0
+}
+
+// Run exports a snapshot every Interval until ctx is done.
+// Errors from individual exports are passed to onError if it isn't nil.
+func (e *SnapshotExporter) Run(ctx context.Context, onError func(error)) error {
+	return poll(ctx, e.Client, e.Interval, func() {
+		if err := e.Export(ctx); err != nil && onError != nil {
+			onError(err)
+		}
+	})
+}
+
+// Export fetches every tag once and writes the results.
+// Tags that fail to fetch are left out of the snapshot and reported in the returned error.
+func (e *SnapshotExporter) Export(ctx context.Context) error {
+	now := e.Client.now().UTC()
+	var errs []error
+
+	var clans []SnapshotRecord
+	for _, tag := range e.ClanTags {
+		clan, err := e.Client.Clan(tag, nil)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("clan %s: %w", tag, err))
+			continue
+		}
+		clans = append(clans, SnapshotRecord{e.Client.now().UTC(), tag, clan})
+	}
+	if err := e.write(ctx, "clans", now, clans); err != nil {
+		errs = append(errs, err)
+	}
+
+	var players []SnapshotRecord
+	for _, tag := range e.PlayerTags {
+		player, err := e.Client.Player(tag, nil)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("player %s: %w", tag, err))
+			continue
+		}
+		players = append(players, SnapshotRecord{e.Client.now().UTC(), tag, player})
+	}
+	if err := e.write(ctx, "players", now, players); err != nil {
+		errs = append(errs, err)
+	}
+	return errors.Join(errs...)
+}
+
+func (e *SnapshotExporter) write(ctx context.Context, kind string, at time.Time, records []SnapshotRecord) error {
+	if len(records) == 0 {
+		return nil
+	}
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	for _, r := range records {
+		if err := enc.Encode(r); err != nil {
+			return err
+		}
+	}
+	return e.Store.PutBlob(ctx, SnapshotKey(e.Prefix, kind, at), buf.Bytes())
+}
+
+// SnapshotKey returns the key SnapshotExporter writes a snapshot of kind taken at t to.
+func SnapshotKey(prefix, kind string, t time.Time) string {
+	t = t.UTC()
+	key := fmt.Sprintf("kind=%s/date=%s/hour=%02d/%d.ndjson", kind, t.Format("2006-01-02"), t.Hour(), t.UnixNano())
+	if prefix != "" {
+		key = prefix + "/" + key
+	}
+	return key
+}
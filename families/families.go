@@ -0,0 +1,199 @@
+// Package families keeps track of clan families, clans run together where a main clan
+// is fed new players by feeder clans, which is how most clans at high levels are organized.
+//
+//	family := families.Family{Name: "Royale", Clans: []string{"2CCCP", "9Q8UCU"}}
+//	roster, err := family.Roster(ctx, client)
+//	for _, move := range families.Movements(lastRoster, roster) {
+//		fmt.Println(move)
+//	}
+package families
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/jegfish/goroyale"
+)
+
+// Family is a group of clans.
+type Family struct {
+	Name  string
+	Clans []string // tags, the main clan first
+}
+
+// Roster is every member of a family at one time.
+type Roster struct {
+	Time  time.Time
+	Clans []goroyale.Clan // in the order of Family.Clans
+}
+
+// Member is a member of a clan in a family.
+type Member struct {
+	goroyale.ClanMember
+	Clan     string // tag of the clan they're in
+	ClanName string
+}
+
+// Roster requests every clan in the family.
+func (f Family) Roster(ctx context.Context, c *goroyale.Client) (roster Roster, err error) {
+	g := c.Group(ctx)
+	results := make([]*goroyale.FetchResult[goroyale.Clan], len(f.Clans))
+	for i, tag := range f.Clans {
+		results[i] = g.Clan(tag)
+	}
+	if err = g.Wait(); err != nil {
+		return
+	}
+	roster.Time = time.Now()
+	for _, r := range results {
+		roster.Clans = append(roster.Clans, r.Value)
+	}
+	return
+}
+
+// Members returns the members of every clan, highest trophies first.
+func (r Roster) Members() (members []Member) {
+	for _, clan := range r.Clans {
+		for _, m := range clan.Members {
+			members = append(members, Member{m, goroyale.NormalizeTag(clan.Tag), clan.Name})
+		}
+	}
+	sort.SliceStable(members, func(i, j int) bool { return members[i].Trophies > members[j].Trophies })
+	return
+}
+
+// Member finds a player in any of the family's clans.
+func (r Roster) Member(tag string) (member Member, ok bool) {
+	tag = goroyale.NormalizeTag(tag)
+	for _, clan := range r.Clans {
+		for _, m := range clan.Members {
+			if goroyale.NormalizeTag(m.Tag) == tag {
+				return Member{m, goroyale.NormalizeTag(clan.Tag), clan.Name}, true
+			}
+		}
+	}
+	return
+}
+
+// Overview returns the stats of each clan, in the order of the roster.
+func (r Roster) Overview() []goroyale.ClanStats {
+	stats := make([]goroyale.ClanStats, len(r.Clans))
+	for i, clan := range r.Clans {
+		stats[i] = goroyale.AggregateClan(clan)
+	}
+	return stats
+}
+
+// MovementKind is what a Movement is.
+type MovementKind int
+
+// Movement kinds.
+const (
+	Joined MovementKind = iota // joined one of the clans from outside the family
+	Left                       // left the family
+	Moved                      // moved from one clan in the family to another
+)
+
+// Movement is a player who joined, left, or moved within a family between two rosters.
+type Movement struct {
+	Kind MovementKind
+	Tag  string
+	Name string
+	From Member // zero if Kind is Joined
+	To   Member // zero if Kind is Left
+}
+
+// String describes the movement, ex: "Bob moved from Royale to Royale Academy".
+func (m Movement) String() string {
+	switch m.Kind {
+	case Joined:
+		return fmt.Sprintf("%s joined %s", m.Name, m.To.ClanName)
+	case Left:
+		return fmt.Sprintf("%s left %s", m.Name, m.From.ClanName)
+	}
+	return fmt.Sprintf("%s moved from %s to %s", m.Name, m.From.ClanName, m.To.ClanName)
+}
+
+// Movements compares two rosters of a family and returns who joined, left, or moved between them,
+// ordered by tag. A player in more than one clan of a roster, which happens when clans were requested a
+// moment apart while they moved, is counted in the first of them.
+func Movements(old, new Roster) (moves []Movement) {
+	before, after := rosterIndex(old), rosterIndex(new)
+	for tag, to := range after {
+		from, ok := before[tag]
+		switch {
+		case !ok:
+			moves = append(moves, Movement{Kind: Joined, Tag: tag, Name: to.Name, To: to})
+		case from.Clan != to.Clan:
+			moves = append(moves, Movement{Kind: Moved, Tag: tag, Name: to.Name, From: from, To: to})
+		}
+	}
+	for tag, from := range before {
+		if _, ok := after[tag]; !ok {
+			moves = append(moves, Movement{Kind: Left, Tag: tag, Name: from.Name, From: from})
+		}
+	}
+	sort.Slice(moves, func(i, j int) bool { return moves[i].Tag < moves[j].Tag })
+	return
+}
+
+func rosterIndex(r Roster) map[string]Member {
+	index := make(map[string]Member)
+	for _, clan := range r.Clans {
+		for _, m := range clan.Members {
+			tag := goroyale.NormalizeTag(m.Tag)
+			if _, ok := index[tag]; !ok {
+				index[tag] = Member{m, goroyale.NormalizeTag(clan.Tag), clan.Name}
+			}
+		}
+	}
+	return index
+}
+
+// WarCalendarEntry is when the current phase of a clan's war ends.
+type WarCalendarEntry struct {
+	Clan     string
+	ClanName string
+	State    goroyale.WarState // goroyale.WarCollectionDay or goroyale.WarDay
+	Ends     time.Time
+	War      goroyale.ClanWar
+}
+
+// String describes the entry, ex: "Royale: war day ends 2018-06-01 18:00 UTC".
+func (e WarCalendarEntry) String() string {
+	phase := "collection day"
+	if e.State == goroyale.WarDay {
+		phase = "war day"
+	}
+	return fmt.Sprintf("%s: %s ends %s", e.ClanName, phase, e.Ends.UTC().Format("2006-01-02 15:04 MST"))
+}
+
+// WarCalendar requests the war of every clan in the family and returns when each one's
+// current phase ends, soonest first. Clans that aren't in a war are left out.
+func (f Family) WarCalendar(ctx context.Context, c *goroyale.Client) (calendar []WarCalendarEntry, err error) {
+	g := c.Group(ctx)
+	results := make([]*goroyale.FetchResult[goroyale.ClanWar], len(f.Clans))
+	for i, tag := range f.Clans {
+		results[i] = g.ClanWar(tag)
+	}
+	if err = g.Wait(); err != nil {
+		return
+	}
+	for i, r := range results {
+		war := r.Value
+		entry := WarCalendarEntry{Clan: goroyale.NormalizeTag(f.Clans[i]), ClanName: war.Clan.Name, State: war.State, War: war}
+		switch {
+		case war.IsCollectionDay() && war.CollectionEndTime != 0:
+			entry.Ends = time.Unix(int64(war.CollectionEndTime), 0)
+		case war.IsWarDay() && war.WarEndTime != 0:
+			entry.Ends = time.Unix(int64(war.WarEndTime), 0)
+		default:
+			continue
+		}
+		calendar = append(calendar, entry)
+	}
+	sort.SliceStable(calendar, func(i, j int) bool { return calendar[i].Ends.Before(calendar[j].Ends) })
+	return
+}
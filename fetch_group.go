@@ -0,0 +1,117 @@
+package goroyale
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+)
+
+// fetchGroupWorkers is how many calls of a FetchGroup run at once.
+// The ratelimit still decides how fast requests go out, this only stops a big group from
+// starting a goroutine per call that all sit waiting for it.
+const fetchGroupWorkers = 5
+
+// FetchGroup runs several different requests at once, ex: a player, their clan, and the clan's war,
+// and waits for all of them.
+//
+//	g := c.Group(ctx)
+//	player := g.Player("8L9L9GL")
+//	war := g.ClanWar("2CCCP")
+//	if err := g.Wait(); err != nil {
+//		// player.Err and war.Err say which failed
+//	}
+type FetchGroup struct {
+	client *Client
+	sem    chan struct{}
+	wg     sync.WaitGroup
+
+	mu    sync.Mutex
+	keyed map[string]interface{} // results of the typed methods, so the same request isn't made twice
+	errs  []error
+}
+
+// FetchResult is the result of one call in a FetchGroup, it's only filled in once Wait returns.
+type FetchResult[T any] struct {
+	Value T
+	Err   error
+}
+
+// Group starts a FetchGroup whose requests are cancelled when ctx is done.
+func (c *Client) Group(ctx context.Context) *FetchGroup {
+	return &FetchGroup{
+		client: c.WithContext(ctx),
+		sem:    make(chan struct{}, fetchGroupWorkers),
+		keyed:  make(map[string]interface{}),
+	}
+}
+
+// Fetch adds a call to the group, fn is given the group's Client.
+// name is used to label its error in Wait.
+func Fetch[T any](g *FetchGroup, name string, fn func(c *Client) (T, error)) *FetchResult[T] {
+	r := new(FetchResult[T])
+	g.wg.Add(1)
+	go func() {
+		defer g.wg.Done()
+		g.sem <- struct{}{}
+		r.Value, r.Err = fn(g.client)
+		<-g.sem
+		if r.Err != nil {
+			g.mu.Lock()
+			g.errs = append(g.errs, fmt.Errorf("%s: %w", name, r.Err))
+			g.mu.Unlock()
+		}
+	}()
+	return r
+}
+
+// fetchOnce works like Fetch but returns the earlier result if the same name was already added.
+func fetchOnce[T any](g *FetchGroup, name string, fn func(c *Client) (T, error)) *FetchResult[T] {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if r, ok := g.keyed[name].(*FetchResult[T]); ok {
+		return r
+	}
+	// Fetch only takes g.mu from the goroutine it starts
+	r := Fetch(g, name, fn)
+	g.keyed[name] = r
+	return r
+}
+
+// Wait waits for every call in the group and returns their errors joined together, nil if they all succeeded.
+func (g *FetchGroup) Wait() error {
+	g.wg.Wait()
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	return errors.Join(g.errs...)
+}
+
+// Player adds a Player request to the group.
+func (g *FetchGroup) Player(tag string) *FetchResult[Player] {
+	return fetchOnce(g, "player "+tag, func(c *Client) (Player, error) { return c.Player(tag, nil) })
+}
+
+// PlayerBattles adds a PlayerBattles request to the group.
+func (g *FetchGroup) PlayerBattles(tag string) *FetchResult[[]Battle] {
+	return fetchOnce(g, "player battles "+tag, func(c *Client) ([]Battle, error) { return c.PlayerBattles(tag, nil) })
+}
+
+// PlayerChests adds a PlayerChests request to the group.
+func (g *FetchGroup) PlayerChests(tag string) *FetchResult[PlayerChests] {
+	return fetchOnce(g, "player chests "+tag, func(c *Client) (PlayerChests, error) { return c.PlayerChests(tag, nil) })
+}
+
+// Clan adds a Clan request to the group.
+func (g *FetchGroup) Clan(tag string) *FetchResult[Clan] {
+	return fetchOnce(g, "clan "+tag, func(c *Client) (Clan, error) { return c.Clan(tag, nil) })
+}
+
+// ClanWar adds a ClanWar request to the group.
+func (g *FetchGroup) ClanWar(tag string) *FetchResult[ClanWar] {
+	return fetchOnce(g, "clan war "+tag, func(c *Client) (ClanWar, error) { return c.ClanWar(tag, nil) })
+}
+
+// ClanWarLog adds a ClanWarLog request to the group.
+func (g *FetchGroup) ClanWarLog(tag string) *FetchResult[[]ClanWarLogEntry] {
+	return fetchOnce(g, "clan war log "+tag, func(c *Client) ([]ClanWarLogEntry, error) { return c.ClanWarLog(tag, nil) })
+}
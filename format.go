@@ -0,0 +1,167 @@
+package goroyale
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Plain text and markdown renderers for chat bots and command line tools.
+
+func deckNames(deck []Card) string {
+	names := make([]string, len(deck))
+	for i, card := range deck {
+		names[i] = card.Name
+	}
+	return strings.Join(names, ", ")
+}
+
+// mdEscape escapes characters in names that would break a markdown table.
+func mdEscape(s string) string {
+	return strings.NewReplacer("|", `\|`, "*", `\*`, "_", `\_`, "`", "\\`").Replace(s)
+}
+
+// String renders p as plain text.
+func (p Player) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s (#%s)\n", p.Name, p.Tag)
+	fmt.Fprintf(&b, "Trophies: %d (best %d), %s\n", p.Trophies, p.Stats.MaxTrophies, p.Arena.Name)
+	if p.Clan.Tag != "" {
+		fmt.Fprintf(&b, "Clan: %s (#%s), %s\n", p.Clan.Name, p.Clan.Tag, p.Clan.Role)
+	}
+	fmt.Fprintf(&b, "Games: %d W / %d L / %d D\n", p.Games.Wins, p.Games.Losses, p.Games.Draws)
+	if len(p.CurrentDeck) > 0 {
+		fmt.Fprintf(&b, "Deck: %s\n", deckNames(p.CurrentDeck))
+	}
+	return b.String()
+}
+
+// Markdown renders p as markdown.
+func (p Player) Markdown() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "**%s** (#%s)\n\n", mdEscape(p.Name), p.Tag)
+	fmt.Fprintf(&b, "- Trophies: %d (best %d), %s\n", p.Trophies, p.Stats.MaxTrophies, p.Arena.Name)
+	if p.Clan.Tag != "" {
+		fmt.Fprintf(&b, "- Clan: %s (#%s), %s\n", mdEscape(p.Clan.Name), p.Clan.Tag, p.Clan.Role)
+	}
+	fmt.Fprintf(&b, "- Games: %d W / %d L / %d D\n", p.Games.Wins, p.Games.Losses, p.Games.Draws)
+	if len(p.CurrentDeck) > 0 {
+		deck := deckNames(p.CurrentDeck)
+		if p.DeckLink != "" {
+			deck = "[" + deck + "](" + p.DeckLink + ")"
+		}
+		fmt.Fprintf(&b, "- Deck: %s\n", deck)
+	}
+	return b.String()
+}
+
+// String renders c as plain text with a line per member.
+func (c Clan) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s (#%s)\n", c.Name, c.Tag)
+	fmt.Fprintf(&b, "Score: %d, Members: %d/50, Required trophies: %d, Donations: %d\n", c.Score, c.MemberCount, c.RequiredScore, c.Donations)
+	for _, m := range c.Members {
+		fmt.Fprintf(&b, "%2d. %-16s %-10s %5d trophies %4d donations\n", m.Rank, m.Name, m.Role, m.Trophies, m.Donations)
+	}
+	return b.String()
+}
+
+// Markdown renders c as markdown with a table of members.
+func (c Clan) Markdown() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "**%s** (#%s)\n\n", mdEscape(c.Name), c.Tag)
+	fmt.Fprintf(&b, "Score: %d, Members: %d/50, Required trophies: %d, Donations: %d\n\n", c.Score, c.MemberCount, c.RequiredScore, c.Donations)
+	if len(c.Members) > 0 {
+		b.WriteString("| # | Name | Role | Trophies | Donations |\n|---|---|---|---|---|\n")
+		for _, m := range c.Members {
+			fmt.Fprintf(&b, "| %d | %s | %s | %d | %d |\n", m.Rank, mdEscape(m.Name), m.Role, m.Trophies, m.Donations)
+		}
+	}
+	return b.String()
+}
+
+func (w ClanWar) endTime() (label string, t time.Time) {
+	switch w.State {
+	case WarCollectionDay:
+		return "Collection day ends", time.Unix(int64(w.CollectionEndTime), 0)
+	case WarDay:
+		return "War day ends", time.Unix(int64(w.WarEndTime), 0)
+	}
+	return "", time.Time{}
+}
+
+// String renders w as plain text.
+func (w ClanWar) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s (#%s): %s\n", w.Clan.Name, w.Clan.Tag, w.State)
+	if label, t := w.endTime(); label != "" {
+		fmt.Fprintf(&b, "%s: %s\n", label, t.UTC().Format(time.RFC1123))
+	}
+	fmt.Fprintf(&b, "Participants: %d, Battles: %d, Wins: %d, Crowns: %d\n", w.Clan.Participants, w.Clan.BattlesPlayed, w.Clan.Wins, w.Clan.Crowns)
+	for i, s := range w.Standings {
+		fmt.Fprintf(&b, "%d. %-16s %d wins %d crowns\n", i+1, s.Name, s.Wins, s.Crowns)
+	}
+	return b.String()
+}
+
+// Markdown renders w as markdown with a table of standings.
+func (w ClanWar) Markdown() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "**%s** (#%s): %s\n\n", mdEscape(w.Clan.Name), w.Clan.Tag, w.State)
+	if label, t := w.endTime(); label != "" {
+		fmt.Fprintf(&b, "%s: %s\n\n", label, t.UTC().Format(time.RFC1123))
+	}
+	fmt.Fprintf(&b, "Participants: %d, Battles: %d, Wins: %d, Crowns: %d\n", w.Clan.Participants, w.Clan.BattlesPlayed, w.Clan.Wins, w.Clan.Crowns)
+	if len(w.Standings) > 0 {
+		b.WriteString("\n| # | Clan | Battles | Wins | Crowns |\n|---|---|---|---|---|\n")
+		for i, s := range w.Standings {
+			fmt.Fprintf(&b, "| %d | %s | %d | %d | %d |\n", i+1, mdEscape(s.Name), s.BattlesPlayed, s.Wins, s.Crowns)
+		}
+	}
+	return b.String()
+}
+
+func (b Battle) result() string {
+	switch {
+	case b.Winner > 0:
+		return "Win"
+	case b.Winner < 0:
+		return "Loss"
+	}
+	return "Draw"
+}
+
+func teamNames(team []TeamMember) string {
+	names := make([]string, len(team))
+	for i, m := range team {
+		names[i] = m.Name
+	}
+	return strings.Join(names, " & ")
+}
+
+// String renders b as a one line summary from the team's point of view.
+func (b Battle) String() string {
+	return fmt.Sprintf("%s %d-%d (%s) %s vs %s", b.result(), b.TeamCrowns, b.OpponentCrowns, b.Mode.Name, teamNames(b.Team), teamNames(b.Opponent))
+}
+
+// Markdown renders b as a one line markdown summary from the team's point of view.
+func (b Battle) Markdown() string {
+	return fmt.Sprintf("**%s** %d-%d (%s) %s vs %s", b.result(), b.TeamCrowns, b.OpponentCrowns, b.Mode.Name,
+		mdEscape(teamNames(b.Team)), mdEscape(teamNames(b.Opponent)))
+}
+
+// BattlesSummary renders the win/loss/draw record of battles, ex: "7W 2L 1D".
+func BattlesSummary(battles []Battle) string {
+	var wins, losses, draws int
+	for _, b := range battles {
+		switch {
+		case b.Winner > 0:
+			wins++
+		case b.Winner < 0:
+			losses++
+		default:
+			draws++
+		}
+	}
+	return fmt.Sprintf("%dW %dL %dD", wins, losses, draws)
+}
@@ -0,0 +1,89 @@
+package goroyale
+
+import "strings"
+
+// GameModeName is the name of a game mode as used by BattleMode.Name and the game_modes constants.
+type GameModeName string
+
+// Game modes in the game_modes constants.
+const (
+	GameModeLadder                 GameModeName = "Ladder"
+	GameModeFriendly               GameModeName = "Friendly"
+	GameModeTournament             GameModeName = "Tournament"
+	GameModeTeamVsTeam             GameModeName = "TeamVsTeam"
+	GameModeChallenge              GameModeName = "Challenge"
+	GameModeDoubleElixirTournament GameModeName = "DoubleElixirTournament"
+	GameModeTripleElixir           GameModeName = "TripleElixir"
+	GameModeDraft                  GameModeName = "Draft"
+	GameModeDraftChallenge         GameModeName = "DraftChallenge"
+	GameModeSuddenDeath            GameModeName = "SuddenDeath"
+	GameModeTouchdown              GameModeName = "Touchdown"
+	GameModeClanWarCollectionDay   GameModeName = "ClanWarCollectionDay"
+	GameModeClanWarWarDay          GameModeName = "ClanWarWarDay"
+)
+
+// deckSelectionDraft is ConstantsGameMode.DeckSelection for modes where decks are drafted.
+const deckSelectionDraft = "DeckSelectionDraft"
+
+// GameMode is a game mode from the game_modes constants.
+type GameMode struct {
+	Name GameModeName      // the name that was looked up, ex: "Challenge_AllCards"
+	Data ConstantsGameMode // the game_modes entry Name matched
+}
+
+// LookupGameMode finds the game mode with the name in GameData.
+// Battle mode names often have variants on the end, ex: "Draft_Competitive",
+// so if there is no exact match the part before the first underscore is tried.
+func LookupGameMode(name string) (mode GameMode, ok bool) {
+	constants, err := GameData()
+	if err != nil {
+		return
+	}
+	mode.Name = GameModeName(name)
+	base := name
+	if i := strings.Index(name, "_"); i >= 0 {
+		base = name[:i]
+	}
+	for _, try := range []string{name, base} {
+		for _, m := range constants.GameModes {
+			if m.Name == try {
+				mode.Data = m
+				return mode, true
+			}
+		}
+	}
+	return
+}
+
+// GameMode looks up the battle's mode in GameData, see LookupGameMode.
+func (m BattleMode) GameMode() (GameMode, bool) {
+	return LookupGameMode(m.Name)
+}
+
+// Is reports whether the mode is name or a variant of it, ex: "Challenge_AllCards" is GameModeChallenge.
+func (m GameMode) Is(name GameModeName) bool {
+	return m.Name == name || GameModeName(m.Data.Name) == name
+}
+
+// IsDraft reports whether decks are drafted in the mode.
+func (m GameMode) IsDraft() bool {
+	return m.Data.DeckSelection == deckSelectionDraft || strings.Contains(string(m.Name), string(GameModeDraft))
+}
+
+// IsChallenge reports whether the mode is played as part of a challenge.
+func (m GameMode) IsChallenge() bool {
+	return strings.Contains(string(m.Name), string(GameModeChallenge))
+}
+
+// IsTeam reports whether the mode is played 2v2.
+func (m GameMode) IsTeam() bool {
+	return m.Data.Players == "2v2"
+}
+
+// ElixirMultiplier returns how many times faster than normal elixir is produced in the mode, ex: 2 for double elixir.
+func (m GameMode) ElixirMultiplier() int {
+	if m.Data.ElixirProductionMultiplier == 0 {
+		return 1
+	}
+	return m.Data.ElixirProductionMultiplier
+}
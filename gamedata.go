@@ -0,0 +1,48 @@
+package goroyale
+
+import (
+	_ "embed" // needed for the constants snapshot
+	"encoding/json"
+	"sync"
+)
+
+// constantsSnapshot is a vendored copy of the /constants endpoint.
+// It lets GameData work without a token or a network call.
+//
+//go:embed data/constants.json
+var constantsSnapshot []byte
+
+var gameData struct {
+	sync.RWMutex
+	once      sync.Once
+	constants Constants
+	err       error
+}
+
+// GameData returns the constants this package currently knows about.
+// Until Client.Constants is called successfully this will be the snapshot embedded in the package,
+// afterwards it will be the most recent data fetched from the API.
+func GameData() (Constants, error) {
+	gameData.once.Do(func() {
+		var constants Constants
+		err := json.Unmarshal(constantsSnapshot, &constants)
+
+		gameData.Lock()
+		// Client.Constants may have already refreshed it
+		if gameData.constants.Cards == nil {
+			gameData.constants, gameData.err = constants, err
+		}
+		gameData.Unlock()
+	})
+
+	gameData.RLock()
+	defer gameData.RUnlock()
+	return gameData.constants, gameData.err
+}
+
+// setGameData replaces the data returned by GameData.
+func setGameData(constants Constants) {
+	gameData.Lock()
+	gameData.constants, gameData.err = constants, nil
+	gameData.Unlock()
+}
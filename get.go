@@ -0,0 +1,65 @@
+package goroyale
+
+import (
+	"context"
+	"net/url"
+	"strings"
+)
+
+// RequestOption changes a single request made with Get.
+// It's separate from Option, which configures a whole Client.
+type RequestOption func(*requestOptions)
+
+type requestOptions struct {
+	params    url.Values
+	label     string
+	retrySafe *bool
+}
+
+// WithParams adds query parameters to the request.
+func WithParams(params url.Values) RequestOption {
+	return func(o *requestOptions) {
+		for key, values := range params {
+			o.params[key] = append(o.params[key], values...)
+		}
+	}
+}
+
+// WithKeys only asks for the fields with the JSON keys, ex: WithKeys("name", "trophies").
+// https://docs.royaleapi.com/#/field_filter
+func WithKeys(keys ...string) RequestOption {
+	return func(o *requestOptions) {
+		o.params.Set("keys", strings.Join(keys, ","))
+	}
+}
+
+// WithExclude asks for every field except the ones with the JSON keys.
+func WithExclude(keys ...string) RequestOption {
+	return func(o *requestOptions) {
+		o.params.Set("exclude", strings.Join(keys, ","))
+	}
+}
+
+// Get requests path, ex: "/player/8L9L9GL", and decodes the response into a T.
+// It goes through the same auth, ratelimiting, retries, caching, and error handling as the Client's own methods,
+// so endpoints this package doesn't know about yet can be used with your own structs.
+//
+//	type Tracking struct{ Tag string; Active bool; Legible bool }
+//	t, err := goroyale.Get[Tracking](c, ctx, "/clan/2CCCP/tracking")
+func Get[T any](c *Client, ctx context.Context, path string, opts ...RequestOption) (v T, err error) {
+	o := requestOptions{params: url.Values{}}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	if !strings.HasPrefix(path, "/") {
+		path = "/" + path
+	}
+	if o.label != "" {
+		ctx = Labeled(ctx, o.label)
+	}
+	if o.retrySafe != nil {
+		ctx = RetrySafeContext(ctx, *o.retrySafe)
+	}
+	err = c.WithContext(ctx).getJSON(path, o.params, &v)
+	return
+}
@@ -0,0 +1,477 @@
+package goroyalepb
+
+import (
+	"reflect"
+
+	"github.com/jegfish/goroyale"
+	"google.golang.org/protobuf/proto"
+)
+
+// The From functions convert goroyale structs to the messages in goroyale.pb.go and the To functions convert them back.
+// The To functions accept nil messages, use the Get methods, and return the zero value for them.
+
+func FromArena(a goroyale.Arena) *Arena {
+	return &Arena{
+		Name:        a.Name,
+		Arena:       a.Arena,
+		ArenaId:     int64(a.ArenaID),
+		TrophyLimit: int64(a.TrophyLimit),
+	}
+}
+
+func ToArena(a *Arena) goroyale.Arena {
+	return goroyale.Arena{
+		Name:        a.GetName(),
+		Arena:       a.GetArena(),
+		ArenaID:     int(a.GetArenaId()),
+		TrophyLimit: int(a.GetTrophyLimit()),
+	}
+}
+
+func FromBadge(b goroyale.Badge) *Badge {
+	return &Badge{
+		Name:     b.Name,
+		Category: b.Category,
+		Id:       int64(b.ID),
+		Image:    b.Image,
+	}
+}
+
+func ToBadge(b *Badge) goroyale.Badge {
+	return goroyale.Badge{
+		Name:     b.GetName(),
+		Category: b.GetCategory(),
+		ID:       int(b.GetId()),
+		Image:    b.GetImage(),
+	}
+}
+
+func FromPlayerClan(c goroyale.PlayerClan) *PlayerClan {
+	return &PlayerClan{
+		Tag:               c.Tag,
+		Name:              c.Name,
+		Role:              c.Role,
+		Donations:         int64(c.Donations),
+		DonationsReceived: int64(c.DonationsReceived),
+		DonationsDelta:    int64(c.DonationsDelta),
+		Badge:             FromBadge(c.Badge),
+	}
+}
+
+func ToPlayerClan(c *PlayerClan) goroyale.PlayerClan {
+	return goroyale.PlayerClan{
+		Tag:               c.GetTag(),
+		Name:              c.GetName(),
+		Role:              c.GetRole(),
+		Donations:         int(c.GetDonations()),
+		DonationsReceived: int(c.GetDonationsReceived()),
+		DonationsDelta:    int(c.GetDonationsDelta()),
+		Badge:             ToBadge(c.GetBadge()),
+	}
+}
+
+func FromPlayerStats(s goroyale.PlayerStats) *PlayerStats {
+	return &PlayerStats{
+		TournamentCardsWon: int64(s.TournamentCardsWon),
+		MaxTrophies:        int64(s.MaxTrophies),
+		ThreeCrownWins:     int64(s.ThreeCrownWins),
+		CardsFound:         int64(s.CardsFound),
+		TotalDonations:     int64(s.TotalDonations),
+		ChallengeMaxWins:   int64(s.ChallengeMaxWins),
+		ChallengeCardsWon:  int64(s.ChallengeCardsWon),
+		Level:              int64(s.Level),
+	}
+}
+
+func ToPlayerStats(s *PlayerStats) goroyale.PlayerStats {
+	return goroyale.PlayerStats{
+		TournamentCardsWon: int(s.GetTournamentCardsWon()),
+		MaxTrophies:        int(s.GetMaxTrophies()),
+		ThreeCrownWins:     int(s.GetThreeCrownWins()),
+		CardsFound:         int(s.GetCardsFound()),
+		TotalDonations:     int(s.GetTotalDonations()),
+		ChallengeMaxWins:   int(s.GetChallengeMaxWins()),
+		ChallengeCardsWon:  int(s.GetChallengeCardsWon()),
+		Level:              int(s.GetLevel()),
+	}
+}
+
+func FromPlayerGames(g goroyale.PlayerGames) *PlayerGames {
+	return &PlayerGames{
+		Total:           int64(g.Total),
+		TournamentGames: int64(g.TournamentGames),
+		Wins:            int64(g.Wins),
+		WinsPercent:     float64(g.WinsPercent),
+		Losses:          int64(g.Losses),
+		LossesPercent:   float64(g.LossesPercent),
+		Draws:           int64(g.Draws),
+		DrawsPercent:    float64(g.DrawsPercent),
+	}
+}
+
+func ToPlayerGames(g *PlayerGames) goroyale.PlayerGames {
+	return goroyale.PlayerGames{
+		Total:           int(g.GetTotal()),
+		TournamentGames: int(g.GetTournamentGames()),
+		Wins:            int(g.GetWins()),
+		WinsPercent:     goroyale.Percent(g.GetWinsPercent()),
+		Losses:          int(g.GetLosses()),
+		LossesPercent:   goroyale.Percent(g.GetLossesPercent()),
+		Draws:           int(g.GetDraws()),
+		DrawsPercent:    goroyale.Percent(g.GetDrawsPercent()),
+	}
+}
+
+func FromCard(c goroyale.Card) *Card {
+	return &Card{
+		Name:               c.Name,
+		Level:              int64(c.Level),
+		MaxLevel:           int64(c.MaxLevel),
+		Count:              int64(c.Count),
+		Rarity:             c.Rarity,
+		RequiredForUpgrade: int64(c.RequiredForUpgrade),
+		Icon:               c.Icon,
+		Key:                c.Key,
+		Elixir:             int64(c.Elixir),
+		Type:               c.Type,
+		Arena:              int64(c.Arena),
+		Description:        c.Description,
+		Id:                 int64(c.ID),
+	}
+}
+
+func ToCard(c *Card) goroyale.Card {
+	card := goroyale.Card{
+		Name:        c.GetName(),
+		Level:       int(c.GetLevel()),
+		MaxLevel:    int(c.GetMaxLevel()),
+		Count:       int(c.GetCount()),
+		Rarity:      c.GetRarity(),
+		Icon:        c.GetIcon(),
+		Key:         c.GetKey(),
+		Elixir:      int(c.GetElixir()),
+		Type:        c.GetType(),
+		Arena:       int(c.GetArena()),
+		Description: c.GetDescription(),
+		ID:          int(c.GetId()),
+	}
+	// the field's type is unexported so it can only be set through reflection
+	reflect.ValueOf(&card.RequiredForUpgrade).Elem().SetInt(c.GetRequiredForUpgrade())
+	return card
+}
+
+func fromCards(cards []goroyale.Card) (pb []*Card) {
+	for _, c := range cards {
+		pb = append(pb, FromCard(c))
+	}
+	return
+}
+
+func toCards(pb []*Card) (cards []goroyale.Card) {
+	for _, c := range pb {
+		cards = append(cards, ToCard(c))
+	}
+	return
+}
+
+func FromPlayer(p goroyale.Player) *Player {
+	return &Player{
+		Tag:         p.Tag,
+		Name:        p.Name,
+		Trophies:    int64(p.Trophies),
+		Rank:        int64(p.Rank),
+		Arena:       FromArena(p.Arena),
+		Clan:        FromPlayerClan(p.Clan),
+		Stats:       FromPlayerStats(p.Stats),
+		Games:       FromPlayerGames(p.Games),
+		DeckLink:    p.DeckLink,
+		CurrentDeck: fromCards(p.CurrentDeck),
+		Cards:       fromCards(p.Cards),
+	}
+}
+
+func ToPlayer(p *Player) goroyale.Player {
+	return goroyale.Player{
+		Tag:         p.GetTag(),
+		Name:        p.GetName(),
+		Trophies:    int(p.GetTrophies()),
+		Rank:        int(p.GetRank()),
+		Arena:       ToArena(p.GetArena()),
+		Clan:        ToPlayerClan(p.GetClan()),
+		Stats:       ToPlayerStats(p.GetStats()),
+		Games:       ToPlayerGames(p.GetGames()),
+		DeckLink:    p.GetDeckLink(),
+		CurrentDeck: toCards(p.GetCurrentDeck()),
+		Cards:       toCards(p.GetCards()),
+	}
+}
+
+func FromLocation(l goroyale.Location) *Location {
+	return &Location{
+		Name:      l.Name,
+		IsCountry: l.IsCountry,
+		Code:      l.Code,
+	}
+}
+
+func ToLocation(l *Location) goroyale.Location {
+	return goroyale.Location{
+		Name:      l.GetName(),
+		IsCountry: l.GetIsCountry(),
+		Code:      l.GetCode(),
+	}
+}
+
+func FromClanMember(m goroyale.ClanMember) *ClanMember {
+	return &ClanMember{
+		Name:              m.Name,
+		Tag:               m.Tag,
+		Rank:              int64(m.Rank),
+		PreviousRank:      int64(m.PreviousRank),
+		Role:              m.Role,
+		ExpLevel:          int64(m.EXPLevel),
+		Trophies:          int64(m.Trophies),
+		ClanChestCrowns:   int64(m.ClanChestCrowns),
+		Donations:         int64(m.Donations),
+		DonationsReceived: int64(m.DonationsReceived),
+		DonationsDelta:    int64(m.DonationsDelta),
+		DonationsPercent:  float64(m.DonationsPercent),
+		Arena:             FromArena(m.Arena),
+	}
+}
+
+func ToClanMember(m *ClanMember) goroyale.ClanMember {
+	return goroyale.ClanMember{
+		Name:              m.GetName(),
+		Tag:               m.GetTag(),
+		Rank:              int(m.GetRank()),
+		PreviousRank:      int(m.GetPreviousRank()),
+		Role:              m.GetRole(),
+		EXPLevel:          int(m.GetExpLevel()),
+		Trophies:          int(m.GetTrophies()),
+		ClanChestCrowns:   int(m.GetClanChestCrowns()),
+		Donations:         int(m.GetDonations()),
+		DonationsReceived: int(m.GetDonationsReceived()),
+		DonationsDelta:    int(m.GetDonationsDelta()),
+		DonationsPercent:  goroyale.Percent(m.GetDonationsPercent()),
+		Arena:             ToArena(m.GetArena()),
+	}
+}
+
+func fromMembers(members []goroyale.ClanMember) (pb []*ClanMember) {
+	for _, m := range members {
+		pb = append(pb, FromClanMember(m))
+	}
+	return
+}
+
+func toMembers(pb []*ClanMember) (members []goroyale.ClanMember) {
+	for _, m := range pb {
+		members = append(members, ToClanMember(m))
+	}
+	return
+}
+
+func FromClan(c goroyale.Clan) *Clan {
+	return &Clan{
+		Tag:           c.Tag,
+		Name:          c.Name,
+		Description:   c.Description,
+		Type:          c.Type,
+		Score:         int64(c.Score),
+		MemberCount:   int64(c.MemberCount),
+		RequiredScore: int64(c.RequiredScore),
+		Donations:     int64(c.Donations),
+		Badge:         FromBadge(c.Badge),
+		Location:      FromLocation(c.Location),
+		Members:       fromMembers(c.Members),
+	}
+}
+
+func ToClan(c *Clan) goroyale.Clan {
+	return goroyale.Clan{
+		Tag:           c.GetTag(),
+		Name:          c.GetName(),
+		Description:   c.GetDescription(),
+		Type:          c.GetType(),
+		Score:         int(c.GetScore()),
+		MemberCount:   int(c.GetMemberCount()),
+		RequiredScore: int(c.GetRequiredScore()),
+		Donations:     int(c.GetDonations()),
+		Badge:         ToBadge(c.GetBadge()),
+		Location:      ToLocation(c.GetLocation()),
+		Members:       toMembers(c.GetMembers()),
+	}
+}
+
+func FromBattleMode(m goroyale.BattleMode) *BattleMode {
+	return &BattleMode{
+		Name:            m.Name,
+		Deck:            m.Deck,
+		CardLevels:      m.CardLevels,
+		OvertimeSeconds: int64(m.OvertimeSeconds),
+		Players:         m.Players,
+		SameDeck:        m.SameDeck,
+	}
+}
+
+func ToBattleMode(m *BattleMode) goroyale.BattleMode {
+	return goroyale.BattleMode{
+		Name:            m.GetName(),
+		Deck:            m.GetDeck(),
+		CardLevels:      m.GetCardLevels(),
+		OvertimeSeconds: int(m.GetOvertimeSeconds()),
+		Players:         m.GetPlayers(),
+		SameDeck:        m.GetSameDeck(),
+	}
+}
+
+func FromTeamClan(c goroyale.TeamClan) *TeamClan {
+	return &TeamClan{
+		Tag:   c.Tag,
+		Name:  c.Name,
+		Badge: FromBadge(c.Badge),
+	}
+}
+
+func ToTeamClan(c *TeamClan) goroyale.TeamClan {
+	return goroyale.TeamClan{
+		Tag:   c.GetTag(),
+		Name:  c.GetName(),
+		Badge: ToBadge(c.GetBadge()),
+	}
+}
+
+func FromTeamMember(m goroyale.TeamMember) *TeamMember {
+	return &TeamMember{
+		Tag:           m.Tag,
+		Name:          m.Name,
+		CrownsEarned:  int64(m.CrownsEarned),
+		TrophyChange:  int64(m.TrophyChange),
+		StartTrophies: int64(m.StartTrophies),
+		Clan:          FromTeamClan(m.Clan),
+		DeckLink:      m.DeckLink,
+		Deck:          fromCards(m.Deck),
+	}
+}
+
+func ToTeamMember(m *TeamMember) goroyale.TeamMember {
+	return goroyale.TeamMember{
+		Tag:           m.GetTag(),
+		Name:          m.GetName(),
+		CrownsEarned:  int(m.GetCrownsEarned()),
+		TrophyChange:  int(m.GetTrophyChange()),
+		StartTrophies: int(m.GetStartTrophies()),
+		Clan:          ToTeamClan(m.GetClan()),
+		DeckLink:      m.GetDeckLink(),
+		Deck:          toCards(m.GetDeck()),
+	}
+}
+
+func fromTeam(team []goroyale.TeamMember) (pb []*TeamMember) {
+	for _, m := range team {
+		pb = append(pb, FromTeamMember(m))
+	}
+	return
+}
+
+func toTeam(pb []*TeamMember) (team []goroyale.TeamMember) {
+	for _, m := range pb {
+		team = append(team, ToTeamMember(m))
+	}
+	return
+}
+
+func FromBattle(b goroyale.Battle) *Battle {
+	return &Battle{
+		Type:           b.Type,
+		ChallengeType:  b.ChallengeType,
+		Mode:           FromBattleMode(b.Mode),
+		WinCountBefore: int64(b.WinCountBefore),
+		UtcTime:        int64(b.UTCTime),
+		DeckType:       b.DeckType,
+		TeamSize:       int64(b.TeamSize),
+		Winner:         int64(b.Winner),
+		TeamCrowns:     int64(b.TeamCrowns),
+		OpponentCrowns: int64(b.OpponentCrowns),
+		Team:           fromTeam(b.Team),
+		Opponent:       fromTeam(b.Opponent),
+		Arena:          FromArena(b.Arena),
+	}
+}
+
+func ToBattle(b *Battle) goroyale.Battle {
+	return goroyale.Battle{
+		Type:           b.GetType(),
+		ChallengeType:  b.GetChallengeType(),
+		Mode:           ToBattleMode(b.GetMode()),
+		WinCountBefore: int(b.GetWinCountBefore()),
+		UTCTime:        int(b.GetUtcTime()),
+		DeckType:       b.GetDeckType(),
+		TeamSize:       int(b.GetTeamSize()),
+		Winner:         int(b.GetWinner()),
+		TeamCrowns:     int(b.GetTeamCrowns()),
+		OpponentCrowns: int(b.GetOpponentCrowns()),
+		Team:           toTeam(b.GetTeam()),
+		Opponent:       toTeam(b.GetOpponent()),
+		Arena:          ToArena(b.GetArena()),
+	}
+}
+
+func FromBattles(battles []goroyale.Battle) []*Battle {
+	pb := make([]*Battle, len(battles))
+	for i, b := range battles {
+		pb[i] = FromBattle(b)
+	}
+	return pb
+}
+
+func ToBattles(pb []*Battle) []goroyale.Battle {
+	battles := make([]goroyale.Battle, len(pb))
+	for i, b := range pb {
+		battles[i] = ToBattle(b)
+	}
+	return battles
+}
+
+// MarshalPlayer encodes p as a Player message, ex: to store snapshots compactly.
+func MarshalPlayer(p goroyale.Player) ([]byte, error) {
+	return proto.Marshal(FromPlayer(p))
+}
+
+// UnmarshalPlayer decodes a Player message.
+func UnmarshalPlayer(data []byte) (p goroyale.Player, err error) {
+	var pb Player
+	if err = proto.Unmarshal(data, &pb); err == nil {
+		p = ToPlayer(&pb)
+	}
+	return
+}
+
+// MarshalClan encodes c as a Clan message.
+func MarshalClan(c goroyale.Clan) ([]byte, error) {
+	return proto.Marshal(FromClan(c))
+}
+
+// UnmarshalClan decodes a Clan message.
+func UnmarshalClan(data []byte) (c goroyale.Clan, err error) {
+	var pb Clan
+	if err = proto.Unmarshal(data, &pb); err == nil {
+		c = ToClan(&pb)
+	}
+	return
+}
+
+// MarshalBattle encodes b as a Battle message.
+func MarshalBattle(b goroyale.Battle) ([]byte, error) {
+	return proto.Marshal(FromBattle(b))
+}
+
+// UnmarshalBattle decodes a Battle message.
+func UnmarshalBattle(data []byte) (b goroyale.Battle, err error) {
+	var pb Battle
+	if err = proto.Unmarshal(data, &pb); err == nil {
+		b = ToBattle(&pb)
+	}
+	return
+}
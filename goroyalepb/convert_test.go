@@ -0,0 +1,292 @@
+package goroyalepb
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/jegfish/goroyale"
+	"google.golang.org/protobuf/proto"
+)
+
+// testdata/X.binpb is testdata/X.txtpb encoded from goroyale.proto alone, without this package:
+//
+//	buf convert goroyale.proto --type goroyale.Player --from testdata/player.txtpb --to testdata/player.binpb
+//
+// or with protoc --encode=goroyale.Player goroyale.proto < testdata/player.txtpb > testdata/player.binpb.
+// The values below are the same as the .txtpb files, so the converters have to put every field in the right place.
+
+var (
+	testArena = goroyale.Arena{Name: "Legendary Arena", Arena: "League 3", ArenaID: 13, TrophyLimit: 4600}
+	testBadge = goroyale.Badge{
+		Name:     "Flame_01",
+		Category: "01_Flame",
+		ID:       16000000,
+		Image:    "https://royaleapi.github.io/cr-api-assets/badges/Flame_01.png",
+	}
+)
+
+func testCards(tb testing.TB) (knight, archers goroyale.Card) {
+	// RequiredForUpgrade's type is unexported so the cards are decoded from JSON
+	err := json.Unmarshal([]byte(`[{
+		"name": "Knight", "level": 12, "maxLevel": 13, "count": 800, "rarity": "Common", "requiredForUpgrade": 5000,
+		"icon": "https://royaleapi.github.io/cr-api-assets/cards/knight.png", "key": "knight", "elixir": 3,
+		"type": "Troop", "arena": 0, "description": "A tough melee fighter.", "id": 26000000
+	}, {
+		"name": "Archers", "level": 13, "maxLevel": 13, "count": 1, "rarity": "Common", "requiredForUpgrade": "Maxed",
+		"icon": "https://royaleapi.github.io/cr-api-assets/cards/archers.png", "key": "archers", "elixir": 3,
+		"type": "Troop", "arena": 1, "description": "A pair of lightly armored ranged attackers.", "id": 26000001
+	}]`), &[]*goroyale.Card{&knight, &archers})
+	if err != nil {
+		tb.Fatal(err)
+	}
+	return
+}
+
+func testPlayer(tb testing.TB) goroyale.Player {
+	knight, archers := testCards(tb)
+	return goroyale.Player{
+		Tag:      "9890JJJV",
+		Name:     "Hello World",
+		Trophies: 4812,
+		Rank:     1203,
+		Arena:    testArena,
+		Clan: goroyale.PlayerClan{
+			Tag:               "R008Q8",
+			Name:              "Some Clan",
+			Role:              "coLeader",
+			Donations:         250,
+			DonationsReceived: 120,
+			DonationsDelta:    -40,
+			Badge:             testBadge,
+		},
+		Stats: goroyale.PlayerStats{
+			TournamentCardsWon: 50,
+			MaxTrophies:        5012,
+			ThreeCrownWins:     811,
+			CardsFound:         86,
+			TotalDonations:     31000,
+			ChallengeMaxWins:   12,
+			ChallengeCardsWon:  9000,
+			Level:              13,
+		},
+		Games: goroyale.PlayerGames{
+			Total:           4000,
+			TournamentGames: 200,
+			Wins:            2100,
+			WinsPercent:     0.525,
+			Losses:          1700,
+			LossesPercent:   0.425,
+			Draws:           200,
+			DrawsPercent:    0.05,
+		},
+		DeckLink:    "https://link.clashroyale.com/deck/en?deck=26000000;26000001",
+		CurrentDeck: []goroyale.Card{knight},
+		Cards:       []goroyale.Card{archers, knight},
+	}
+}
+
+func testClan() goroyale.Clan {
+	return goroyale.Clan{
+		Tag:           "2CCCP",
+		Name:          "Some Clan",
+		Description:   "Be active!",
+		Type:          "inviteOnly",
+		Score:         45120,
+		MemberCount:   2,
+		RequiredScore: 4000,
+		Donations:     6200,
+		Badge:         testBadge,
+		Location:      goroyale.Location{Name: "United States", IsCountry: true, Code: "US"},
+		Members: []goroyale.ClanMember{{
+			Name:              "Hello World",
+			Tag:               "9890JJJV",
+			Rank:              1,
+			PreviousRank:      2,
+			Role:              "leader",
+			EXPLevel:          13,
+			Trophies:          4812,
+			ClanChestCrowns:   30,
+			Donations:         400,
+			DonationsReceived: 200,
+			DonationsDelta:    -10,
+			DonationsPercent:  6.45,
+			Arena:             testArena,
+		}, {
+			Name:              "Second",
+			Tag:               "2PP",
+			Rank:              2,
+			PreviousRank:      1,
+			Role:              "member",
+			EXPLevel:          9,
+			Trophies:          3100,
+			ClanChestCrowns:   4,
+			Donations:         120,
+			DonationsReceived: 360,
+			DonationsDelta:    5,
+			DonationsPercent:  1.93,
+			Arena:             goroyale.Arena{Name: "Spooky Town", Arena: "Arena 9", ArenaID: 9, TrophyLimit: 3300},
+		}},
+	}
+}
+
+func testBattle(tb testing.TB) goroyale.Battle {
+	knight, archers := testCards(tb)
+	return goroyale.Battle{
+		Type:          "clanWarWarDay",
+		ChallengeType: "grandChallenge",
+		Mode: goroyale.BattleMode{
+			Name:            "Ladder",
+			Deck:            "Collection",
+			CardLevels:      "Ladder",
+			OvertimeSeconds: 60,
+			Players:         "1v1",
+			SameDeck:        true,
+		},
+		WinCountBefore: 3,
+		UTCTime:        1527852948,
+		DeckType:       "Collection",
+		TeamSize:       1,
+		Winner:         -1,
+		TeamCrowns:     1,
+		OpponentCrowns: 2,
+		Team: []goroyale.TeamMember{{
+			Tag:           "9890JJJV",
+			Name:          "Hello World",
+			CrownsEarned:  1,
+			TrophyChange:  -29,
+			StartTrophies: 4812,
+			Clan:          goroyale.TeamClan{Tag: "R008Q8", Name: "Some Clan", Badge: testBadge},
+			DeckLink:      "https://link.clashroyale.com/deck/en?deck=26000000",
+			Deck:          []goroyale.Card{knight},
+		}},
+		Opponent: []goroyale.TeamMember{{
+			Tag:           "2PP",
+			Name:          "Second",
+			CrownsEarned:  2,
+			TrophyChange:  29,
+			StartTrophies: 4790,
+			Clan: goroyale.TeamClan{Tag: "2CCCP", Name: "Other Clan", Badge: goroyale.Badge{
+				Name:     "Cherry_Blossom_02",
+				Category: "02_Flower",
+				ID:       16000140,
+				Image:    "https://royaleapi.github.io/cr-api-assets/badges/Cherry_Blossom_02.png",
+			}},
+			DeckLink: "https://link.clashroyale.com/deck/en?deck=26000001",
+			Deck:     []goroyale.Card{archers},
+		}},
+		Arena: testArena,
+	}
+}
+
+func readTestdata(tb testing.TB, name string) []byte {
+	tb.Helper()
+	data, err := os.ReadFile(filepath.Join("testdata", name))
+	if err != nil {
+		tb.Fatal(err)
+	}
+	return data
+}
+
+func TestGolden(t *testing.T) {
+	for _, tt := range []struct {
+		name      string
+		value     interface{}
+		marshal   func() ([]byte, error)
+		unmarshal func([]byte) (interface{}, error)
+	}{
+		{"player", testPlayer(t),
+			func() ([]byte, error) { return MarshalPlayer(testPlayer(t)) },
+			func(b []byte) (interface{}, error) { return UnmarshalPlayer(b) }},
+		{"clan", testClan(),
+			func() ([]byte, error) { return MarshalClan(testClan()) },
+			func(b []byte) (interface{}, error) { return UnmarshalClan(b) }},
+		{"battle", testBattle(t),
+			func() ([]byte, error) { return MarshalBattle(testBattle(t)) },
+			func(b []byte) (interface{}, error) { return UnmarshalBattle(b) }},
+	} {
+		golden := readTestdata(t, tt.name+".binpb")
+		got, err := tt.marshal()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(got, golden) {
+			t.Errorf("the %s doesn't encode to testdata/%s.binpb:\n got %x\nwant %x", tt.name, tt.name, got, golden)
+		}
+		decoded, err := tt.unmarshal(golden)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(decoded, tt.value) {
+			t.Errorf("testdata/%s.binpb decodes to\n%+v\nwant\n%+v", tt.name, decoded, tt.value)
+		}
+	}
+}
+
+// TestRecordedResponses round trips the recorded API responses in goroyale's testdata.
+// The messages don't have every field of the structs, so the decoded structs are encoded again and compared as messages.
+func TestRecordedResponses(t *testing.T) {
+	read := func(name string, v interface{}) {
+		data, err := os.ReadFile(filepath.Join("..", "testdata", name))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err = json.Unmarshal(data, v); err != nil {
+			t.Fatal(err)
+		}
+	}
+	var (
+		player  goroyale.Player
+		clan    goroyale.Clan
+		battles []goroyale.Battle
+	)
+	read("player.json", &player)
+	read("clan.json", &clan)
+	read("battles.json", &battles)
+
+	check := func(name string, pb, again proto.Message) {
+		if !proto.Equal(pb, again) {
+			t.Errorf("the recorded %s changed in a round trip", name)
+		}
+	}
+	data, _ := MarshalPlayer(player)
+	p, err := UnmarshalPlayer(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	check("player", FromPlayer(player), FromPlayer(p))
+	if p.Tag != player.Tag || len(p.Cards) != len(player.Cards) || p.Cards[0] != player.Cards[0] {
+		t.Errorf("the recorded player decoded to %+v", p)
+	}
+
+	data, _ = MarshalClan(clan)
+	c, err := UnmarshalClan(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	check("clan", FromClan(clan), FromClan(c))
+
+	for i, b := range battles {
+		data, _ = MarshalBattle(b)
+		decoded, err := UnmarshalBattle(data)
+		if err != nil {
+			t.Fatal(err)
+		}
+		check("battle", FromBattle(b), FromBattle(decoded))
+		if i == 0 && !reflect.DeepEqual(decoded.Team, b.Team) {
+			t.Errorf("the recorded battle's team decoded to %+v, want %+v", decoded.Team, b.Team)
+		}
+	}
+}
+
+func TestToNil(t *testing.T) {
+	if p := ToPlayer(nil); !reflect.DeepEqual(p, goroyale.Player{}) {
+		t.Errorf("ToPlayer(nil) = %+v", p)
+	}
+	if b := ToBattle(&Battle{}); !reflect.DeepEqual(b, goroyale.Battle{}) {
+		t.Errorf("ToBattle of an empty message = %+v", b)
+	}
+}
@@ -0,0 +1,15 @@
+// Package goroyalepb converts goroyale's core structs to and from protocol buffers.
+//
+// goroyale.proto in this directory defines the messages, so other languages can generate code for them
+// and gRPC services can use them. goroyale.pb.go is generated from it by protoc-gen-go,
+// and the From and To functions convert between its messages and the goroyale structs.
+// Only the fields listed in goroyale.proto are kept.
+//
+// service.proto defines the Royale gRPC service, which cmd/goroyale-grpc serves.
+// Its messages and stubs are in service.pb.go and service_grpc.pb.go, generated by protoc-gen-go-grpc.
+//
+// This package depends on google.golang.org/protobuf and google.golang.org/grpc,
+// the goroyale package itself still only uses the standard library.
+package goroyalepb
+
+//go:generate protoc --go_out=. --go_opt=paths=source_relative --go-grpc_out=. --go-grpc_opt=paths=source_relative goroyale.proto service.proto
@@ -0,0 +1,1742 @@
+// Protocol buffer definitions mirroring the core goroyale structs.
+// The Go types in goroyale.pb.go are generated from this file, run go generate after changing it.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.5
+// 	protoc        (unknown)
+// source: goroyale.proto
+
+package goroyalepb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Arena struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Arena         string                 `protobuf:"bytes,2,opt,name=arena,proto3" json:"arena,omitempty"`
+	ArenaId       int64                  `protobuf:"varint,3,opt,name=arena_id,json=arenaId,proto3" json:"arena_id,omitempty"`
+	TrophyLimit   int64                  `protobuf:"varint,4,opt,name=trophy_limit,json=trophyLimit,proto3" json:"trophy_limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Arena) Reset() {
+	*x = Arena{}
+	mi := &file_goroyale_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Arena) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Arena) ProtoMessage() {}
+
+func (x *Arena) ProtoReflect() protoreflect.Message {
+	mi := &file_goroyale_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Arena.ProtoReflect.Descriptor instead.
+func (*Arena) Descriptor() ([]byte, []int) {
+	return file_goroyale_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Arena) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Arena) GetArena() string {
+	if x != nil {
+		return x.Arena
+	}
+	return ""
+}
+
+func (x *Arena) GetArenaId() int64 {
+	if x != nil {
+		return x.ArenaId
+	}
+	return 0
+}
+
+func (x *Arena) GetTrophyLimit() int64 {
+	if x != nil {
+		return x.TrophyLimit
+	}
+	return 0
+}
+
+type Badge struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Category      string                 `protobuf:"bytes,2,opt,name=category,proto3" json:"category,omitempty"`
+	Id            int64                  `protobuf:"varint,3,opt,name=id,proto3" json:"id,omitempty"`
+	Image         string                 `protobuf:"bytes,4,opt,name=image,proto3" json:"image,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Badge) Reset() {
+	*x = Badge{}
+	mi := &file_goroyale_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Badge) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Badge) ProtoMessage() {}
+
+func (x *Badge) ProtoReflect() protoreflect.Message {
+	mi := &file_goroyale_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Badge.ProtoReflect.Descriptor instead.
+func (*Badge) Descriptor() ([]byte, []int) {
+	return file_goroyale_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Badge) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Badge) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *Badge) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Badge) GetImage() string {
+	if x != nil {
+		return x.Image
+	}
+	return ""
+}
+
+type PlayerClan struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Tag               string                 `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
+	Name              string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Role              string                 `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"`
+	Donations         int64                  `protobuf:"varint,4,opt,name=donations,proto3" json:"donations,omitempty"`
+	DonationsReceived int64                  `protobuf:"varint,5,opt,name=donations_received,json=donationsReceived,proto3" json:"donations_received,omitempty"`
+	DonationsDelta    int64                  `protobuf:"varint,6,opt,name=donations_delta,json=donationsDelta,proto3" json:"donations_delta,omitempty"`
+	Badge             *Badge                 `protobuf:"bytes,7,opt,name=badge,proto3" json:"badge,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *PlayerClan) Reset() {
+	*x = PlayerClan{}
+	mi := &file_goroyale_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlayerClan) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayerClan) ProtoMessage() {}
+
+func (x *PlayerClan) ProtoReflect() protoreflect.Message {
+	mi := &file_goroyale_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayerClan.ProtoReflect.Descriptor instead.
+func (*PlayerClan) Descriptor() ([]byte, []int) {
+	return file_goroyale_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *PlayerClan) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+func (x *PlayerClan) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PlayerClan) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *PlayerClan) GetDonations() int64 {
+	if x != nil {
+		return x.Donations
+	}
+	return 0
+}
+
+func (x *PlayerClan) GetDonationsReceived() int64 {
+	if x != nil {
+		return x.DonationsReceived
+	}
+	return 0
+}
+
+func (x *PlayerClan) GetDonationsDelta() int64 {
+	if x != nil {
+		return x.DonationsDelta
+	}
+	return 0
+}
+
+func (x *PlayerClan) GetBadge() *Badge {
+	if x != nil {
+		return x.Badge
+	}
+	return nil
+}
+
+type PlayerStats struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	TournamentCardsWon int64                  `protobuf:"varint,1,opt,name=tournament_cards_won,json=tournamentCardsWon,proto3" json:"tournament_cards_won,omitempty"`
+	MaxTrophies        int64                  `protobuf:"varint,2,opt,name=max_trophies,json=maxTrophies,proto3" json:"max_trophies,omitempty"`
+	ThreeCrownWins     int64                  `protobuf:"varint,3,opt,name=three_crown_wins,json=threeCrownWins,proto3" json:"three_crown_wins,omitempty"`
+	CardsFound         int64                  `protobuf:"varint,4,opt,name=cards_found,json=cardsFound,proto3" json:"cards_found,omitempty"`
+	TotalDonations     int64                  `protobuf:"varint,5,opt,name=total_donations,json=totalDonations,proto3" json:"total_donations,omitempty"`
+	ChallengeMaxWins   int64                  `protobuf:"varint,6,opt,name=challenge_max_wins,json=challengeMaxWins,proto3" json:"challenge_max_wins,omitempty"`
+	ChallengeCardsWon  int64                  `protobuf:"varint,7,opt,name=challenge_cards_won,json=challengeCardsWon,proto3" json:"challenge_cards_won,omitempty"`
+	Level              int64                  `protobuf:"varint,8,opt,name=level,proto3" json:"level,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *PlayerStats) Reset() {
+	*x = PlayerStats{}
+	mi := &file_goroyale_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlayerStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayerStats) ProtoMessage() {}
+
+func (x *PlayerStats) ProtoReflect() protoreflect.Message {
+	mi := &file_goroyale_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayerStats.ProtoReflect.Descriptor instead.
+func (*PlayerStats) Descriptor() ([]byte, []int) {
+	return file_goroyale_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *PlayerStats) GetTournamentCardsWon() int64 {
+	if x != nil {
+		return x.TournamentCardsWon
+	}
+	return 0
+}
+
+func (x *PlayerStats) GetMaxTrophies() int64 {
+	if x != nil {
+		return x.MaxTrophies
+	}
+	return 0
+}
+
+func (x *PlayerStats) GetThreeCrownWins() int64 {
+	if x != nil {
+		return x.ThreeCrownWins
+	}
+	return 0
+}
+
+func (x *PlayerStats) GetCardsFound() int64 {
+	if x != nil {
+		return x.CardsFound
+	}
+	return 0
+}
+
+func (x *PlayerStats) GetTotalDonations() int64 {
+	if x != nil {
+		return x.TotalDonations
+	}
+	return 0
+}
+
+func (x *PlayerStats) GetChallengeMaxWins() int64 {
+	if x != nil {
+		return x.ChallengeMaxWins
+	}
+	return 0
+}
+
+func (x *PlayerStats) GetChallengeCardsWon() int64 {
+	if x != nil {
+		return x.ChallengeCardsWon
+	}
+	return 0
+}
+
+func (x *PlayerStats) GetLevel() int64 {
+	if x != nil {
+		return x.Level
+	}
+	return 0
+}
+
+type PlayerGames struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Total           int64                  `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
+	TournamentGames int64                  `protobuf:"varint,2,opt,name=tournament_games,json=tournamentGames,proto3" json:"tournament_games,omitempty"`
+	Wins            int64                  `protobuf:"varint,3,opt,name=wins,proto3" json:"wins,omitempty"`
+	WinsPercent     float64                `protobuf:"fixed64,4,opt,name=wins_percent,json=winsPercent,proto3" json:"wins_percent,omitempty"`
+	Losses          int64                  `protobuf:"varint,5,opt,name=losses,proto3" json:"losses,omitempty"`
+	LossesPercent   float64                `protobuf:"fixed64,6,opt,name=losses_percent,json=lossesPercent,proto3" json:"losses_percent,omitempty"`
+	Draws           int64                  `protobuf:"varint,7,opt,name=draws,proto3" json:"draws,omitempty"`
+	DrawsPercent    float64                `protobuf:"fixed64,8,opt,name=draws_percent,json=drawsPercent,proto3" json:"draws_percent,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *PlayerGames) Reset() {
+	*x = PlayerGames{}
+	mi := &file_goroyale_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlayerGames) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayerGames) ProtoMessage() {}
+
+func (x *PlayerGames) ProtoReflect() protoreflect.Message {
+	mi := &file_goroyale_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayerGames.ProtoReflect.Descriptor instead.
+func (*PlayerGames) Descriptor() ([]byte, []int) {
+	return file_goroyale_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *PlayerGames) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *PlayerGames) GetTournamentGames() int64 {
+	if x != nil {
+		return x.TournamentGames
+	}
+	return 0
+}
+
+func (x *PlayerGames) GetWins() int64 {
+	if x != nil {
+		return x.Wins
+	}
+	return 0
+}
+
+func (x *PlayerGames) GetWinsPercent() float64 {
+	if x != nil {
+		return x.WinsPercent
+	}
+	return 0
+}
+
+func (x *PlayerGames) GetLosses() int64 {
+	if x != nil {
+		return x.Losses
+	}
+	return 0
+}
+
+func (x *PlayerGames) GetLossesPercent() float64 {
+	if x != nil {
+		return x.LossesPercent
+	}
+	return 0
+}
+
+func (x *PlayerGames) GetDraws() int64 {
+	if x != nil {
+		return x.Draws
+	}
+	return 0
+}
+
+func (x *PlayerGames) GetDrawsPercent() float64 {
+	if x != nil {
+		return x.DrawsPercent
+	}
+	return 0
+}
+
+type Card struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Name               string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Level              int64                  `protobuf:"varint,2,opt,name=level,proto3" json:"level,omitempty"`
+	MaxLevel           int64                  `protobuf:"varint,3,opt,name=max_level,json=maxLevel,proto3" json:"max_level,omitempty"`
+	Count              int64                  `protobuf:"varint,4,opt,name=count,proto3" json:"count,omitempty"`
+	Rarity             string                 `protobuf:"bytes,5,opt,name=rarity,proto3" json:"rarity,omitempty"`
+	RequiredForUpgrade int64                  `protobuf:"varint,6,opt,name=required_for_upgrade,json=requiredForUpgrade,proto3" json:"required_for_upgrade,omitempty"` // -1 if the card is max level
+	Icon               string                 `protobuf:"bytes,7,opt,name=icon,proto3" json:"icon,omitempty"`
+	Key                string                 `protobuf:"bytes,8,opt,name=key,proto3" json:"key,omitempty"`
+	Elixir             int64                  `protobuf:"varint,9,opt,name=elixir,proto3" json:"elixir,omitempty"`
+	Type               string                 `protobuf:"bytes,10,opt,name=type,proto3" json:"type,omitempty"`
+	Arena              int64                  `protobuf:"varint,11,opt,name=arena,proto3" json:"arena,omitempty"`
+	Description        string                 `protobuf:"bytes,12,opt,name=description,proto3" json:"description,omitempty"`
+	Id                 int64                  `protobuf:"varint,13,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *Card) Reset() {
+	*x = Card{}
+	mi := &file_goroyale_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Card) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Card) ProtoMessage() {}
+
+func (x *Card) ProtoReflect() protoreflect.Message {
+	mi := &file_goroyale_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Card.ProtoReflect.Descriptor instead.
+func (*Card) Descriptor() ([]byte, []int) {
+	return file_goroyale_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *Card) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Card) GetLevel() int64 {
+	if x != nil {
+		return x.Level
+	}
+	return 0
+}
+
+func (x *Card) GetMaxLevel() int64 {
+	if x != nil {
+		return x.MaxLevel
+	}
+	return 0
+}
+
+func (x *Card) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *Card) GetRarity() string {
+	if x != nil {
+		return x.Rarity
+	}
+	return ""
+}
+
+func (x *Card) GetRequiredForUpgrade() int64 {
+	if x != nil {
+		return x.RequiredForUpgrade
+	}
+	return 0
+}
+
+func (x *Card) GetIcon() string {
+	if x != nil {
+		return x.Icon
+	}
+	return ""
+}
+
+func (x *Card) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *Card) GetElixir() int64 {
+	if x != nil {
+		return x.Elixir
+	}
+	return 0
+}
+
+func (x *Card) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Card) GetArena() int64 {
+	if x != nil {
+		return x.Arena
+	}
+	return 0
+}
+
+func (x *Card) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Card) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type Player struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tag           string                 `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Trophies      int64                  `protobuf:"varint,3,opt,name=trophies,proto3" json:"trophies,omitempty"`
+	Rank          int64                  `protobuf:"varint,4,opt,name=rank,proto3" json:"rank,omitempty"`
+	Arena         *Arena                 `protobuf:"bytes,5,opt,name=arena,proto3" json:"arena,omitempty"`
+	Clan          *PlayerClan            `protobuf:"bytes,6,opt,name=clan,proto3" json:"clan,omitempty"`
+	Stats         *PlayerStats           `protobuf:"bytes,7,opt,name=stats,proto3" json:"stats,omitempty"`
+	Games         *PlayerGames           `protobuf:"bytes,8,opt,name=games,proto3" json:"games,omitempty"`
+	DeckLink      string                 `protobuf:"bytes,9,opt,name=deck_link,json=deckLink,proto3" json:"deck_link,omitempty"`
+	CurrentDeck   []*Card                `protobuf:"bytes,10,rep,name=current_deck,json=currentDeck,proto3" json:"current_deck,omitempty"`
+	Cards         []*Card                `protobuf:"bytes,11,rep,name=cards,proto3" json:"cards,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Player) Reset() {
+	*x = Player{}
+	mi := &file_goroyale_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Player) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Player) ProtoMessage() {}
+
+func (x *Player) ProtoReflect() protoreflect.Message {
+	mi := &file_goroyale_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Player.ProtoReflect.Descriptor instead.
+func (*Player) Descriptor() ([]byte, []int) {
+	return file_goroyale_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *Player) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+func (x *Player) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Player) GetTrophies() int64 {
+	if x != nil {
+		return x.Trophies
+	}
+	return 0
+}
+
+func (x *Player) GetRank() int64 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *Player) GetArena() *Arena {
+	if x != nil {
+		return x.Arena
+	}
+	return nil
+}
+
+func (x *Player) GetClan() *PlayerClan {
+	if x != nil {
+		return x.Clan
+	}
+	return nil
+}
+
+func (x *Player) GetStats() *PlayerStats {
+	if x != nil {
+		return x.Stats
+	}
+	return nil
+}
+
+func (x *Player) GetGames() *PlayerGames {
+	if x != nil {
+		return x.Games
+	}
+	return nil
+}
+
+func (x *Player) GetDeckLink() string {
+	if x != nil {
+		return x.DeckLink
+	}
+	return ""
+}
+
+func (x *Player) GetCurrentDeck() []*Card {
+	if x != nil {
+		return x.CurrentDeck
+	}
+	return nil
+}
+
+func (x *Player) GetCards() []*Card {
+	if x != nil {
+		return x.Cards
+	}
+	return nil
+}
+
+type Location struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	IsCountry     bool                   `protobuf:"varint,2,opt,name=is_country,json=isCountry,proto3" json:"is_country,omitempty"`
+	Code          string                 `protobuf:"bytes,3,opt,name=code,proto3" json:"code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Location) Reset() {
+	*x = Location{}
+	mi := &file_goroyale_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Location) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Location) ProtoMessage() {}
+
+func (x *Location) ProtoReflect() protoreflect.Message {
+	mi := &file_goroyale_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Location.ProtoReflect.Descriptor instead.
+func (*Location) Descriptor() ([]byte, []int) {
+	return file_goroyale_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *Location) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Location) GetIsCountry() bool {
+	if x != nil {
+		return x.IsCountry
+	}
+	return false
+}
+
+func (x *Location) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type ClanMember struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Name              string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Tag               string                 `protobuf:"bytes,2,opt,name=tag,proto3" json:"tag,omitempty"`
+	Rank              int64                  `protobuf:"varint,3,opt,name=rank,proto3" json:"rank,omitempty"`
+	PreviousRank      int64                  `protobuf:"varint,4,opt,name=previous_rank,json=previousRank,proto3" json:"previous_rank,omitempty"`
+	Role              string                 `protobuf:"bytes,5,opt,name=role,proto3" json:"role,omitempty"`
+	ExpLevel          int64                  `protobuf:"varint,6,opt,name=exp_level,json=expLevel,proto3" json:"exp_level,omitempty"`
+	Trophies          int64                  `protobuf:"varint,7,opt,name=trophies,proto3" json:"trophies,omitempty"`
+	ClanChestCrowns   int64                  `protobuf:"varint,8,opt,name=clan_chest_crowns,json=clanChestCrowns,proto3" json:"clan_chest_crowns,omitempty"`
+	Donations         int64                  `protobuf:"varint,9,opt,name=donations,proto3" json:"donations,omitempty"`
+	DonationsReceived int64                  `protobuf:"varint,10,opt,name=donations_received,json=donationsReceived,proto3" json:"donations_received,omitempty"`
+	DonationsDelta    int64                  `protobuf:"varint,11,opt,name=donations_delta,json=donationsDelta,proto3" json:"donations_delta,omitempty"`
+	DonationsPercent  float64                `protobuf:"fixed64,12,opt,name=donations_percent,json=donationsPercent,proto3" json:"donations_percent,omitempty"`
+	Arena             *Arena                 `protobuf:"bytes,13,opt,name=arena,proto3" json:"arena,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ClanMember) Reset() {
+	*x = ClanMember{}
+	mi := &file_goroyale_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClanMember) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClanMember) ProtoMessage() {}
+
+func (x *ClanMember) ProtoReflect() protoreflect.Message {
+	mi := &file_goroyale_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClanMember.ProtoReflect.Descriptor instead.
+func (*ClanMember) Descriptor() ([]byte, []int) {
+	return file_goroyale_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ClanMember) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ClanMember) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+func (x *ClanMember) GetRank() int64 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *ClanMember) GetPreviousRank() int64 {
+	if x != nil {
+		return x.PreviousRank
+	}
+	return 0
+}
+
+func (x *ClanMember) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *ClanMember) GetExpLevel() int64 {
+	if x != nil {
+		return x.ExpLevel
+	}
+	return 0
+}
+
+func (x *ClanMember) GetTrophies() int64 {
+	if x != nil {
+		return x.Trophies
+	}
+	return 0
+}
+
+func (x *ClanMember) GetClanChestCrowns() int64 {
+	if x != nil {
+		return x.ClanChestCrowns
+	}
+	return 0
+}
+
+func (x *ClanMember) GetDonations() int64 {
+	if x != nil {
+		return x.Donations
+	}
+	return 0
+}
+
+func (x *ClanMember) GetDonationsReceived() int64 {
+	if x != nil {
+		return x.DonationsReceived
+	}
+	return 0
+}
+
+func (x *ClanMember) GetDonationsDelta() int64 {
+	if x != nil {
+		return x.DonationsDelta
+	}
+	return 0
+}
+
+func (x *ClanMember) GetDonationsPercent() float64 {
+	if x != nil {
+		return x.DonationsPercent
+	}
+	return 0
+}
+
+func (x *ClanMember) GetArena() *Arena {
+	if x != nil {
+		return x.Arena
+	}
+	return nil
+}
+
+type Clan struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tag           string                 `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Type          string                 `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
+	Score         int64                  `protobuf:"varint,5,opt,name=score,proto3" json:"score,omitempty"`
+	MemberCount   int64                  `protobuf:"varint,6,opt,name=member_count,json=memberCount,proto3" json:"member_count,omitempty"`
+	RequiredScore int64                  `protobuf:"varint,7,opt,name=required_score,json=requiredScore,proto3" json:"required_score,omitempty"`
+	Donations     int64                  `protobuf:"varint,8,opt,name=donations,proto3" json:"donations,omitempty"`
+	Badge         *Badge                 `protobuf:"bytes,9,opt,name=badge,proto3" json:"badge,omitempty"`
+	Location      *Location              `protobuf:"bytes,10,opt,name=location,proto3" json:"location,omitempty"`
+	Members       []*ClanMember          `protobuf:"bytes,11,rep,name=members,proto3" json:"members,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Clan) Reset() {
+	*x = Clan{}
+	mi := &file_goroyale_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Clan) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Clan) ProtoMessage() {}
+
+func (x *Clan) ProtoReflect() protoreflect.Message {
+	mi := &file_goroyale_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Clan.ProtoReflect.Descriptor instead.
+func (*Clan) Descriptor() ([]byte, []int) {
+	return file_goroyale_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *Clan) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+func (x *Clan) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Clan) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Clan) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Clan) GetScore() int64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *Clan) GetMemberCount() int64 {
+	if x != nil {
+		return x.MemberCount
+	}
+	return 0
+}
+
+func (x *Clan) GetRequiredScore() int64 {
+	if x != nil {
+		return x.RequiredScore
+	}
+	return 0
+}
+
+func (x *Clan) GetDonations() int64 {
+	if x != nil {
+		return x.Donations
+	}
+	return 0
+}
+
+func (x *Clan) GetBadge() *Badge {
+	if x != nil {
+		return x.Badge
+	}
+	return nil
+}
+
+func (x *Clan) GetLocation() *Location {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+func (x *Clan) GetMembers() []*ClanMember {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+type BattleMode struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Name            string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Deck            string                 `protobuf:"bytes,2,opt,name=deck,proto3" json:"deck,omitempty"`
+	CardLevels      string                 `protobuf:"bytes,3,opt,name=card_levels,json=cardLevels,proto3" json:"card_levels,omitempty"`
+	OvertimeSeconds int64                  `protobuf:"varint,4,opt,name=overtime_seconds,json=overtimeSeconds,proto3" json:"overtime_seconds,omitempty"`
+	Players         string                 `protobuf:"bytes,5,opt,name=players,proto3" json:"players,omitempty"`
+	SameDeck        bool                   `protobuf:"varint,6,opt,name=same_deck,json=sameDeck,proto3" json:"same_deck,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *BattleMode) Reset() {
+	*x = BattleMode{}
+	mi := &file_goroyale_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BattleMode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BattleMode) ProtoMessage() {}
+
+func (x *BattleMode) ProtoReflect() protoreflect.Message {
+	mi := &file_goroyale_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BattleMode.ProtoReflect.Descriptor instead.
+func (*BattleMode) Descriptor() ([]byte, []int) {
+	return file_goroyale_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *BattleMode) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *BattleMode) GetDeck() string {
+	if x != nil {
+		return x.Deck
+	}
+	return ""
+}
+
+func (x *BattleMode) GetCardLevels() string {
+	if x != nil {
+		return x.CardLevels
+	}
+	return ""
+}
+
+func (x *BattleMode) GetOvertimeSeconds() int64 {
+	if x != nil {
+		return x.OvertimeSeconds
+	}
+	return 0
+}
+
+func (x *BattleMode) GetPlayers() string {
+	if x != nil {
+		return x.Players
+	}
+	return ""
+}
+
+func (x *BattleMode) GetSameDeck() bool {
+	if x != nil {
+		return x.SameDeck
+	}
+	return false
+}
+
+type TeamClan struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tag           string                 `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Badge         *Badge                 `protobuf:"bytes,3,opt,name=badge,proto3" json:"badge,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TeamClan) Reset() {
+	*x = TeamClan{}
+	mi := &file_goroyale_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TeamClan) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TeamClan) ProtoMessage() {}
+
+func (x *TeamClan) ProtoReflect() protoreflect.Message {
+	mi := &file_goroyale_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TeamClan.ProtoReflect.Descriptor instead.
+func (*TeamClan) Descriptor() ([]byte, []int) {
+	return file_goroyale_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *TeamClan) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+func (x *TeamClan) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TeamClan) GetBadge() *Badge {
+	if x != nil {
+		return x.Badge
+	}
+	return nil
+}
+
+type TeamMember struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tag           string                 `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	CrownsEarned  int64                  `protobuf:"varint,3,opt,name=crowns_earned,json=crownsEarned,proto3" json:"crowns_earned,omitempty"`
+	TrophyChange  int64                  `protobuf:"varint,4,opt,name=trophy_change,json=trophyChange,proto3" json:"trophy_change,omitempty"`
+	StartTrophies int64                  `protobuf:"varint,5,opt,name=start_trophies,json=startTrophies,proto3" json:"start_trophies,omitempty"`
+	Clan          *TeamClan              `protobuf:"bytes,6,opt,name=clan,proto3" json:"clan,omitempty"`
+	DeckLink      string                 `protobuf:"bytes,7,opt,name=deck_link,json=deckLink,proto3" json:"deck_link,omitempty"`
+	Deck          []*Card                `protobuf:"bytes,8,rep,name=deck,proto3" json:"deck,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TeamMember) Reset() {
+	*x = TeamMember{}
+	mi := &file_goroyale_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TeamMember) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TeamMember) ProtoMessage() {}
+
+func (x *TeamMember) ProtoReflect() protoreflect.Message {
+	mi := &file_goroyale_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TeamMember.ProtoReflect.Descriptor instead.
+func (*TeamMember) Descriptor() ([]byte, []int) {
+	return file_goroyale_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *TeamMember) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+func (x *TeamMember) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TeamMember) GetCrownsEarned() int64 {
+	if x != nil {
+		return x.CrownsEarned
+	}
+	return 0
+}
+
+func (x *TeamMember) GetTrophyChange() int64 {
+	if x != nil {
+		return x.TrophyChange
+	}
+	return 0
+}
+
+func (x *TeamMember) GetStartTrophies() int64 {
+	if x != nil {
+		return x.StartTrophies
+	}
+	return 0
+}
+
+func (x *TeamMember) GetClan() *TeamClan {
+	if x != nil {
+		return x.Clan
+	}
+	return nil
+}
+
+func (x *TeamMember) GetDeckLink() string {
+	if x != nil {
+		return x.DeckLink
+	}
+	return ""
+}
+
+func (x *TeamMember) GetDeck() []*Card {
+	if x != nil {
+		return x.Deck
+	}
+	return nil
+}
+
+type Battle struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Type           string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	ChallengeType  string                 `protobuf:"bytes,2,opt,name=challenge_type,json=challengeType,proto3" json:"challenge_type,omitempty"`
+	Mode           *BattleMode            `protobuf:"bytes,3,opt,name=mode,proto3" json:"mode,omitempty"`
+	WinCountBefore int64                  `protobuf:"varint,4,opt,name=win_count_before,json=winCountBefore,proto3" json:"win_count_before,omitempty"`
+	UtcTime        int64                  `protobuf:"varint,5,opt,name=utc_time,json=utcTime,proto3" json:"utc_time,omitempty"`
+	DeckType       string                 `protobuf:"bytes,6,opt,name=deck_type,json=deckType,proto3" json:"deck_type,omitempty"`
+	TeamSize       int64                  `protobuf:"varint,7,opt,name=team_size,json=teamSize,proto3" json:"team_size,omitempty"`
+	Winner         int64                  `protobuf:"varint,8,opt,name=winner,proto3" json:"winner,omitempty"`
+	TeamCrowns     int64                  `protobuf:"varint,9,opt,name=team_crowns,json=teamCrowns,proto3" json:"team_crowns,omitempty"`
+	OpponentCrowns int64                  `protobuf:"varint,10,opt,name=opponent_crowns,json=opponentCrowns,proto3" json:"opponent_crowns,omitempty"`
+	Team           []*TeamMember          `protobuf:"bytes,11,rep,name=team,proto3" json:"team,omitempty"`
+	Opponent       []*TeamMember          `protobuf:"bytes,12,rep,name=opponent,proto3" json:"opponent,omitempty"`
+	Arena          *Arena                 `protobuf:"bytes,13,opt,name=arena,proto3" json:"arena,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Battle) Reset() {
+	*x = Battle{}
+	mi := &file_goroyale_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Battle) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Battle) ProtoMessage() {}
+
+func (x *Battle) ProtoReflect() protoreflect.Message {
+	mi := &file_goroyale_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Battle.ProtoReflect.Descriptor instead.
+func (*Battle) Descriptor() ([]byte, []int) {
+	return file_goroyale_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *Battle) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Battle) GetChallengeType() string {
+	if x != nil {
+		return x.ChallengeType
+	}
+	return ""
+}
+
+func (x *Battle) GetMode() *BattleMode {
+	if x != nil {
+		return x.Mode
+	}
+	return nil
+}
+
+func (x *Battle) GetWinCountBefore() int64 {
+	if x != nil {
+		return x.WinCountBefore
+	}
+	return 0
+}
+
+func (x *Battle) GetUtcTime() int64 {
+	if x != nil {
+		return x.UtcTime
+	}
+	return 0
+}
+
+func (x *Battle) GetDeckType() string {
+	if x != nil {
+		return x.DeckType
+	}
+	return ""
+}
+
+func (x *Battle) GetTeamSize() int64 {
+	if x != nil {
+		return x.TeamSize
+	}
+	return 0
+}
+
+func (x *Battle) GetWinner() int64 {
+	if x != nil {
+		return x.Winner
+	}
+	return 0
+}
+
+func (x *Battle) GetTeamCrowns() int64 {
+	if x != nil {
+		return x.TeamCrowns
+	}
+	return 0
+}
+
+func (x *Battle) GetOpponentCrowns() int64 {
+	if x != nil {
+		return x.OpponentCrowns
+	}
+	return 0
+}
+
+func (x *Battle) GetTeam() []*TeamMember {
+	if x != nil {
+		return x.Team
+	}
+	return nil
+}
+
+func (x *Battle) GetOpponent() []*TeamMember {
+	if x != nil {
+		return x.Opponent
+	}
+	return nil
+}
+
+func (x *Battle) GetArena() *Arena {
+	if x != nil {
+		return x.Arena
+	}
+	return nil
+}
+
+var File_goroyale_proto protoreflect.FileDescriptor
+
+var file_goroyale_proto_rawDesc = string([]byte{
+	0x0a, 0x0e, 0x67, 0x6f, 0x72, 0x6f, 0x79, 0x61, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x12, 0x08, 0x67, 0x6f, 0x72, 0x6f, 0x79, 0x61, 0x6c, 0x65, 0x22, 0x6f, 0x0a, 0x05, 0x41, 0x72,
+	0x65, 0x6e, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x72, 0x65, 0x6e, 0x61,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x72, 0x65, 0x6e, 0x61, 0x12, 0x19, 0x0a,
+	0x08, 0x61, 0x72, 0x65, 0x6e, 0x61, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x07, 0x61, 0x72, 0x65, 0x6e, 0x61, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x72, 0x6f, 0x70,
+	0x68, 0x79, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b,
+	0x74, 0x72, 0x6f, 0x70, 0x68, 0x79, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x5d, 0x0a, 0x05, 0x42,
+	0x61, 0x64, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x74, 0x65,
+	0x67, 0x6f, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x61, 0x74, 0x65,
+	0x67, 0x6f, 0x72, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x22, 0xe3, 0x01, 0x0a, 0x0a, 0x50,
+	0x6c, 0x61, 0x79, 0x65, 0x72, 0x43, 0x6c, 0x61, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x61, 0x67,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x74, 0x61, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72,
+	0x6f, 0x6c, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x6f, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x64, 0x6f, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x12, 0x2d, 0x0a, 0x12, 0x64, 0x6f, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x5f, 0x72,
+	0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x64,
+	0x6f, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64,
+	0x12, 0x27, 0x0a, 0x0f, 0x64, 0x6f, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x5f, 0x64, 0x65,
+	0x6c, 0x74, 0x61, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x64, 0x6f, 0x6e, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x44, 0x65, 0x6c, 0x74, 0x61, 0x12, 0x25, 0x0a, 0x05, 0x62, 0x61, 0x64,
+	0x67, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x67, 0x6f, 0x72, 0x6f, 0x79,
+	0x61, 0x6c, 0x65, 0x2e, 0x42, 0x61, 0x64, 0x67, 0x65, 0x52, 0x05, 0x62, 0x61, 0x64, 0x67, 0x65,
+	0x22, 0xca, 0x02, 0x0a, 0x0b, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x73,
+	0x12, 0x30, 0x0a, 0x14, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x63,
+	0x61, 0x72, 0x64, 0x73, 0x5f, 0x77, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12,
+	0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x61, 0x72, 0x64, 0x73, 0x57,
+	0x6f, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x61, 0x78, 0x5f, 0x74, 0x72, 0x6f, 0x70, 0x68, 0x69,
+	0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x6d, 0x61, 0x78, 0x54, 0x72, 0x6f,
+	0x70, 0x68, 0x69, 0x65, 0x73, 0x12, 0x28, 0x0a, 0x10, 0x74, 0x68, 0x72, 0x65, 0x65, 0x5f, 0x63,
+	0x72, 0x6f, 0x77, 0x6e, 0x5f, 0x77, 0x69, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0e, 0x74, 0x68, 0x72, 0x65, 0x65, 0x43, 0x72, 0x6f, 0x77, 0x6e, 0x57, 0x69, 0x6e, 0x73, 0x12,
+	0x1f, 0x0a, 0x0b, 0x63, 0x61, 0x72, 0x64, 0x73, 0x5f, 0x66, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x63, 0x61, 0x72, 0x64, 0x73, 0x46, 0x6f, 0x75, 0x6e, 0x64,
+	0x12, 0x27, 0x0a, 0x0f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x64, 0x6f, 0x6e, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x44, 0x6f, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x63, 0x68, 0x61,
+	0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x77, 0x69, 0x6e, 0x73, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65,
+	0x4d, 0x61, 0x78, 0x57, 0x69, 0x6e, 0x73, 0x12, 0x2e, 0x0a, 0x13, 0x63, 0x68, 0x61, 0x6c, 0x6c,
+	0x65, 0x6e, 0x67, 0x65, 0x5f, 0x63, 0x61, 0x72, 0x64, 0x73, 0x5f, 0x77, 0x6f, 0x6e, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x43,
+	0x61, 0x72, 0x64, 0x73, 0x57, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x22, 0xff, 0x01,
+	0x0a, 0x0b, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x47, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x14, 0x0a,
+	0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x12, 0x29, 0x0a, 0x10, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e,
+	0x74, 0x5f, 0x67, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x74,
+	0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x12,
+	0x0a, 0x04, 0x77, 0x69, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x77, 0x69,
+	0x6e, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x77, 0x69, 0x6e, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65,
+	0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x77, 0x69, 0x6e, 0x73, 0x50, 0x65,
+	0x72, 0x63, 0x65, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x6f, 0x73, 0x73, 0x65, 0x73, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6c, 0x6f, 0x73, 0x73, 0x65, 0x73, 0x12, 0x25, 0x0a,
+	0x0e, 0x6c, 0x6f, 0x73, 0x73, 0x65, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x6c, 0x6f, 0x73, 0x73, 0x65, 0x73, 0x50, 0x65, 0x72,
+	0x63, 0x65, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x72, 0x61, 0x77, 0x73, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x05, 0x64, 0x72, 0x61, 0x77, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x64, 0x72,
+	0x61, 0x77, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x0c, 0x64, 0x72, 0x61, 0x77, 0x73, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x22,
+	0xc7, 0x02, 0x0a, 0x04, 0x43, 0x61, 0x72, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05,
+	0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6c, 0x65, 0x76,
+	0x65, 0x6c, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12,
+	0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x61, 0x72, 0x69, 0x74, 0x79, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x61, 0x72, 0x69, 0x74, 0x79, 0x12, 0x30, 0x0a,
+	0x14, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x5f, 0x66, 0x6f, 0x72, 0x5f, 0x75, 0x70,
+	0x67, 0x72, 0x61, 0x64, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x72, 0x65, 0x71,
+	0x75, 0x69, 0x72, 0x65, 0x64, 0x46, 0x6f, 0x72, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x69, 0x63, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x69,
+	0x63, 0x6f, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x6c, 0x69, 0x78, 0x69, 0x72, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x65, 0x6c, 0x69, 0x78, 0x69, 0x72, 0x12, 0x12, 0x0a,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x72, 0x65, 0x6e, 0x61, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x05, 0x61, 0x72, 0x65, 0x6e, 0x61, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x0d, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x22, 0xff, 0x02, 0x0a, 0x06, 0x50, 0x6c,
+	0x61, 0x79, 0x65, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x61, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x74, 0x61, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x72,
+	0x6f, 0x70, 0x68, 0x69, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x74, 0x72,
+	0x6f, 0x70, 0x68, 0x69, 0x65, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x12, 0x25, 0x0a, 0x05, 0x61, 0x72,
+	0x65, 0x6e, 0x61, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x67, 0x6f, 0x72, 0x6f,
+	0x79, 0x61, 0x6c, 0x65, 0x2e, 0x41, 0x72, 0x65, 0x6e, 0x61, 0x52, 0x05, 0x61, 0x72, 0x65, 0x6e,
+	0x61, 0x12, 0x28, 0x0a, 0x04, 0x63, 0x6c, 0x61, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x14, 0x2e, 0x67, 0x6f, 0x72, 0x6f, 0x79, 0x61, 0x6c, 0x65, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65,
+	0x72, 0x43, 0x6c, 0x61, 0x6e, 0x52, 0x04, 0x63, 0x6c, 0x61, 0x6e, 0x12, 0x2b, 0x0a, 0x05, 0x73,
+	0x74, 0x61, 0x74, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x67, 0x6f, 0x72,
+	0x6f, 0x79, 0x61, 0x6c, 0x65, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74,
+	0x73, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x12, 0x2b, 0x0a, 0x05, 0x67, 0x61, 0x6d, 0x65,
+	0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x67, 0x6f, 0x72, 0x6f, 0x79, 0x61,
+	0x6c, 0x65, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x47, 0x61, 0x6d, 0x65, 0x73, 0x52, 0x05,
+	0x67, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x65, 0x63, 0x6b, 0x5f, 0x6c, 0x69,
+	0x6e, 0x6b, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x65, 0x63, 0x6b, 0x4c, 0x69,
+	0x6e, 0x6b, 0x12, 0x31, 0x0a, 0x0c, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x64, 0x65,
+	0x63, 0x6b, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x67, 0x6f, 0x72, 0x6f, 0x79,
+	0x61, 0x6c, 0x65, 0x2e, 0x43, 0x61, 0x72, 0x64, 0x52, 0x0b, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x74, 0x44, 0x65, 0x63, 0x6b, 0x12, 0x24, 0x0a, 0x05, 0x63, 0x61, 0x72, 0x64, 0x73, 0x18, 0x0b,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x67, 0x6f, 0x72, 0x6f, 0x79, 0x61, 0x6c, 0x65, 0x2e,
+	0x43, 0x61, 0x72, 0x64, 0x52, 0x05, 0x63, 0x61, 0x72, 0x64, 0x73, 0x22, 0x51, 0x0a, 0x08, 0x4c,
+	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x69,
+	0x73, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x09, 0x69, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f,
+	0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x22, 0xae,
+	0x03, 0x0a, 0x0a, 0x43, 0x6c, 0x61, 0x6e, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x61, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x74, 0x61, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x72, 0x65, 0x76, 0x69,
+	0x6f, 0x75, 0x73, 0x5f, 0x72, 0x61, 0x6e, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c,
+	0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x52, 0x61, 0x6e, 0x6b, 0x12, 0x12, 0x0a, 0x04,
+	0x72, 0x6f, 0x6c, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65,
+	0x12, 0x1b, 0x0a, 0x09, 0x65, 0x78, 0x70, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x08, 0x65, 0x78, 0x70, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x1a, 0x0a,
+	0x08, 0x74, 0x72, 0x6f, 0x70, 0x68, 0x69, 0x65, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x08, 0x74, 0x72, 0x6f, 0x70, 0x68, 0x69, 0x65, 0x73, 0x12, 0x2a, 0x0a, 0x11, 0x63, 0x6c, 0x61,
+	0x6e, 0x5f, 0x63, 0x68, 0x65, 0x73, 0x74, 0x5f, 0x63, 0x72, 0x6f, 0x77, 0x6e, 0x73, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x63, 0x6c, 0x61, 0x6e, 0x43, 0x68, 0x65, 0x73, 0x74, 0x43,
+	0x72, 0x6f, 0x77, 0x6e, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x6f, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x64, 0x6f, 0x6e, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x12, 0x2d, 0x0a, 0x12, 0x64, 0x6f, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x5f, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x11, 0x64, 0x6f, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76,
+	0x65, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x64, 0x6f, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x5f,
+	0x64, 0x65, 0x6c, 0x74, 0x61, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x64, 0x6f, 0x6e,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x44, 0x65, 0x6c, 0x74, 0x61, 0x12, 0x2b, 0x0a, 0x11, 0x64,
+	0x6f, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74,
+	0x18, 0x0c, 0x20, 0x01, 0x28, 0x01, 0x52, 0x10, 0x64, 0x6f, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x12, 0x25, 0x0a, 0x05, 0x61, 0x72, 0x65, 0x6e,
+	0x61, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x67, 0x6f, 0x72, 0x6f, 0x79, 0x61,
+	0x6c, 0x65, 0x2e, 0x41, 0x72, 0x65, 0x6e, 0x61, 0x52, 0x05, 0x61, 0x72, 0x65, 0x6e, 0x61, 0x22,
+	0xe7, 0x02, 0x0a, 0x04, 0x43, 0x6c, 0x61, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x61, 0x67, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x74, 0x61, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20,
+	0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x65,
+	0x6d, 0x62, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0b, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x25, 0x0a,
+	0x0e, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x53,
+	0x63, 0x6f, 0x72, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x6f, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x64, 0x6f, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x12, 0x25, 0x0a, 0x05, 0x62, 0x61, 0x64, 0x67, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0f, 0x2e, 0x67, 0x6f, 0x72, 0x6f, 0x79, 0x61, 0x6c, 0x65, 0x2e, 0x42, 0x61, 0x64,
+	0x67, 0x65, 0x52, 0x05, 0x62, 0x61, 0x64, 0x67, 0x65, 0x12, 0x2e, 0x0a, 0x08, 0x6c, 0x6f, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x67, 0x6f,
+	0x72, 0x6f, 0x79, 0x61, 0x6c, 0x65, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2e, 0x0a, 0x07, 0x6d, 0x65, 0x6d,
+	0x62, 0x65, 0x72, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x72,
+	0x6f, 0x79, 0x61, 0x6c, 0x65, 0x2e, 0x43, 0x6c, 0x61, 0x6e, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72,
+	0x52, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x22, 0xb7, 0x01, 0x0a, 0x0a, 0x42, 0x61,
+	0x74, 0x74, 0x6c, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x64, 0x65, 0x63, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x65, 0x63, 0x6b,
+	0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x61, 0x72, 0x64, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x73, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x61, 0x72, 0x64, 0x4c, 0x65, 0x76, 0x65, 0x6c,
+	0x73, 0x12, 0x29, 0x0a, 0x10, 0x6f, 0x76, 0x65, 0x72, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x73, 0x65,
+	0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x6f, 0x76, 0x65,
+	0x72, 0x74, 0x69, 0x6d, 0x65, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x18, 0x0a, 0x07,
+	0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70,
+	0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x61, 0x6d, 0x65, 0x5f, 0x64,
+	0x65, 0x63, 0x6b, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x73, 0x61, 0x6d, 0x65, 0x44,
+	0x65, 0x63, 0x6b, 0x22, 0x57, 0x0a, 0x08, 0x54, 0x65, 0x61, 0x6d, 0x43, 0x6c, 0x61, 0x6e, 0x12,
+	0x10, 0x0a, 0x03, 0x74, 0x61, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x74, 0x61,
+	0x67, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x25, 0x0a, 0x05, 0x62, 0x61, 0x64, 0x67, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x67, 0x6f, 0x72, 0x6f, 0x79, 0x61, 0x6c, 0x65, 0x2e,
+	0x42, 0x61, 0x64, 0x67, 0x65, 0x52, 0x05, 0x62, 0x61, 0x64, 0x67, 0x65, 0x22, 0x8c, 0x02, 0x0a,
+	0x0a, 0x54, 0x65, 0x61, 0x6d, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x74,
+	0x61, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x74, 0x61, 0x67, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x72, 0x6f, 0x77, 0x6e, 0x73, 0x5f, 0x65, 0x61, 0x72, 0x6e,
+	0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x63, 0x72, 0x6f, 0x77, 0x6e, 0x73,
+	0x45, 0x61, 0x72, 0x6e, 0x65, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x72, 0x6f, 0x70, 0x68, 0x79,
+	0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x74,
+	0x72, 0x6f, 0x70, 0x68, 0x79, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x72, 0x6f, 0x70, 0x68, 0x69, 0x65, 0x73, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0d, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x72, 0x6f, 0x70, 0x68, 0x69,
+	0x65, 0x73, 0x12, 0x26, 0x0a, 0x04, 0x63, 0x6c, 0x61, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x12, 0x2e, 0x67, 0x6f, 0x72, 0x6f, 0x79, 0x61, 0x6c, 0x65, 0x2e, 0x54, 0x65, 0x61, 0x6d,
+	0x43, 0x6c, 0x61, 0x6e, 0x52, 0x04, 0x63, 0x6c, 0x61, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x65,
+	0x63, 0x6b, 0x5f, 0x6c, 0x69, 0x6e, 0x6b, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64,
+	0x65, 0x63, 0x6b, 0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x22, 0x0a, 0x04, 0x64, 0x65, 0x63, 0x6b, 0x18,
+	0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x67, 0x6f, 0x72, 0x6f, 0x79, 0x61, 0x6c, 0x65,
+	0x2e, 0x43, 0x61, 0x72, 0x64, 0x52, 0x04, 0x64, 0x65, 0x63, 0x6b, 0x22, 0xd1, 0x03, 0x0a, 0x06,
+	0x42, 0x61, 0x74, 0x74, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x68,
+	0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0d, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x28, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x14, 0x2e, 0x67, 0x6f, 0x72, 0x6f, 0x79, 0x61, 0x6c, 0x65, 0x2e, 0x42, 0x61, 0x74, 0x74, 0x6c,
+	0x65, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x12, 0x28, 0x0a, 0x10, 0x77,
+	0x69, 0x6e, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x77, 0x69, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x42,
+	0x65, 0x66, 0x6f, 0x72, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x75, 0x74, 0x63, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x75, 0x74, 0x63, 0x54, 0x69, 0x6d, 0x65,
+	0x12, 0x1b, 0x0a, 0x09, 0x64, 0x65, 0x63, 0x6b, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x65, 0x63, 0x6b, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1b, 0x0a,
+	0x09, 0x74, 0x65, 0x61, 0x6d, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x08, 0x74, 0x65, 0x61, 0x6d, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x69,
+	0x6e, 0x6e, 0x65, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x77, 0x69, 0x6e, 0x6e,
+	0x65, 0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x65, 0x61, 0x6d, 0x5f, 0x63, 0x72, 0x6f, 0x77, 0x6e,
+	0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x74, 0x65, 0x61, 0x6d, 0x43, 0x72, 0x6f,
+	0x77, 0x6e, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x6f, 0x70, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x5f,
+	0x63, 0x72, 0x6f, 0x77, 0x6e, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x6f, 0x70,
+	0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x43, 0x72, 0x6f, 0x77, 0x6e, 0x73, 0x12, 0x28, 0x0a, 0x04,
+	0x74, 0x65, 0x61, 0x6d, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x72,
+	0x6f, 0x79, 0x61, 0x6c, 0x65, 0x2e, 0x54, 0x65, 0x61, 0x6d, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72,
+	0x52, 0x04, 0x74, 0x65, 0x61, 0x6d, 0x12, 0x30, 0x0a, 0x08, 0x6f, 0x70, 0x70, 0x6f, 0x6e, 0x65,
+	0x6e, 0x74, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x72, 0x6f, 0x79,
+	0x61, 0x6c, 0x65, 0x2e, 0x54, 0x65, 0x61, 0x6d, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x08,
+	0x6f, 0x70, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x12, 0x25, 0x0a, 0x05, 0x61, 0x72, 0x65, 0x6e,
+	0x61, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x67, 0x6f, 0x72, 0x6f, 0x79, 0x61,
+	0x6c, 0x65, 0x2e, 0x41, 0x72, 0x65, 0x6e, 0x61, 0x52, 0x05, 0x61, 0x72, 0x65, 0x6e, 0x61, 0x42,
+	0x28, 0x5a, 0x26, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6a, 0x65,
+	0x67, 0x66, 0x69, 0x73, 0x68, 0x2f, 0x67, 0x6f, 0x72, 0x6f, 0x79, 0x61, 0x6c, 0x65, 0x2f, 0x67,
+	0x6f, 0x72, 0x6f, 0x79, 0x61, 0x6c, 0x65, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
+})
+
+var (
+	file_goroyale_proto_rawDescOnce sync.Once
+	file_goroyale_proto_rawDescData []byte
+)
+
+func file_goroyale_proto_rawDescGZIP() []byte {
+	file_goroyale_proto_rawDescOnce.Do(func() {
+		file_goroyale_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_goroyale_proto_rawDesc), len(file_goroyale_proto_rawDesc)))
+	})
+	return file_goroyale_proto_rawDescData
+}
+
+var file_goroyale_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
+var file_goroyale_proto_goTypes = []any{
+	(*Arena)(nil),       // 0: goroyale.Arena
+	(*Badge)(nil),       // 1: goroyale.Badge
+	(*PlayerClan)(nil),  // 2: goroyale.PlayerClan
+	(*PlayerStats)(nil), // 3: goroyale.PlayerStats
+	(*PlayerGames)(nil), // 4: goroyale.PlayerGames
+	(*Card)(nil),        // 5: goroyale.Card
+	(*Player)(nil),      // 6: goroyale.Player
+	(*Location)(nil),    // 7: goroyale.Location
+	(*ClanMember)(nil),  // 8: goroyale.ClanMember
+	(*Clan)(nil),        // 9: goroyale.Clan
+	(*BattleMode)(nil),  // 10: goroyale.BattleMode
+	(*TeamClan)(nil),    // 11: goroyale.TeamClan
+	(*TeamMember)(nil),  // 12: goroyale.TeamMember
+	(*Battle)(nil),      // 13: goroyale.Battle
+}
+var file_goroyale_proto_depIdxs = []int32{
+	1,  // 0: goroyale.PlayerClan.badge:type_name -> goroyale.Badge
+	0,  // 1: goroyale.Player.arena:type_name -> goroyale.Arena
+	2,  // 2: goroyale.Player.clan:type_name -> goroyale.PlayerClan
+	3,  // 3: goroyale.Player.stats:type_name -> goroyale.PlayerStats
+	4,  // 4: goroyale.Player.games:type_name -> goroyale.PlayerGames
+	5,  // 5: goroyale.Player.current_deck:type_name -> goroyale.Card
+	5,  // 6: goroyale.Player.cards:type_name -> goroyale.Card
+	0,  // 7: goroyale.ClanMember.arena:type_name -> goroyale.Arena
+	1,  // 8: goroyale.Clan.badge:type_name -> goroyale.Badge
+	7,  // 9: goroyale.Clan.location:type_name -> goroyale.Location
+	8,  // 10: goroyale.Clan.members:type_name -> goroyale.ClanMember
+	1,  // 11: goroyale.TeamClan.badge:type_name -> goroyale.Badge
+	11, // 12: goroyale.TeamMember.clan:type_name -> goroyale.TeamClan
+	5,  // 13: goroyale.TeamMember.deck:type_name -> goroyale.Card
+	10, // 14: goroyale.Battle.mode:type_name -> goroyale.BattleMode
+	12, // 15: goroyale.Battle.team:type_name -> goroyale.TeamMember
+	12, // 16: goroyale.Battle.opponent:type_name -> goroyale.TeamMember
+	0,  // 17: goroyale.Battle.arena:type_name -> goroyale.Arena
+	18, // [18:18] is the sub-list for method output_type
+	18, // [18:18] is the sub-list for method input_type
+	18, // [18:18] is the sub-list for extension type_name
+	18, // [18:18] is the sub-list for extension extendee
+	0,  // [0:18] is the sub-list for field type_name
+}
+
+func init() { file_goroyale_proto_init() }
+func file_goroyale_proto_init() {
+	if File_goroyale_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_goroyale_proto_rawDesc), len(file_goroyale_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   14,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_goroyale_proto_goTypes,
+		DependencyIndexes: file_goroyale_proto_depIdxs,
+		MessageInfos:      file_goroyale_proto_msgTypes,
+	}.Build()
+	File_goroyale_proto = out.File
+	file_goroyale_proto_goTypes = nil
+	file_goroyale_proto_depIdxs = nil
+}
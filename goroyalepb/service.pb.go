@@ -0,0 +1,228 @@
+// Service definition for a gRPC gateway in front of a goroyale Client.
+// One gateway can hold the API token, cache, and ratelimit for many services.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.5
+// 	protoc        (unknown)
+// source: service.proto
+
+package goroyalepb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type TagRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Tag   string                 `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
+	// Field filtering, see https://docs.royaleapi.com/#/field_filter
+	Keys          []string `protobuf:"bytes,2,rep,name=keys,proto3" json:"keys,omitempty"`
+	Exclude       []string `protobuf:"bytes,3,rep,name=exclude,proto3" json:"exclude,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TagRequest) Reset() {
+	*x = TagRequest{}
+	mi := &file_service_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TagRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TagRequest) ProtoMessage() {}
+
+func (x *TagRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TagRequest.ProtoReflect.Descriptor instead.
+func (*TagRequest) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *TagRequest) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+func (x *TagRequest) GetKeys() []string {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
+func (x *TagRequest) GetExclude() []string {
+	if x != nil {
+		return x.Exclude
+	}
+	return nil
+}
+
+type BattlesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Battles       []*Battle              `protobuf:"bytes,1,rep,name=battles,proto3" json:"battles,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BattlesResponse) Reset() {
+	*x = BattlesResponse{}
+	mi := &file_service_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BattlesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BattlesResponse) ProtoMessage() {}
+
+func (x *BattlesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BattlesResponse.ProtoReflect.Descriptor instead.
+func (*BattlesResponse) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *BattlesResponse) GetBattles() []*Battle {
+	if x != nil {
+		return x.Battles
+	}
+	return nil
+}
+
+var File_service_proto protoreflect.FileDescriptor
+
+var file_service_proto_rawDesc = string([]byte{
+	0x0a, 0x0d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
+	0x08, 0x67, 0x6f, 0x72, 0x6f, 0x79, 0x61, 0x6c, 0x65, 0x1a, 0x0e, 0x67, 0x6f, 0x72, 0x6f, 0x79,
+	0x61, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x4c, 0x0a, 0x0a, 0x54, 0x61, 0x67,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x61, 0x67, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x74, 0x61, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x65, 0x79,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x12, 0x18, 0x0a,
+	0x07, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07,
+	0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x22, 0x3d, 0x0a, 0x0f, 0x42, 0x61, 0x74, 0x74, 0x6c,
+	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x07, 0x62, 0x61,
+	0x74, 0x74, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x67, 0x6f,
+	0x72, 0x6f, 0x79, 0x61, 0x6c, 0x65, 0x2e, 0x42, 0x61, 0x74, 0x74, 0x6c, 0x65, 0x52, 0x07, 0x62,
+	0x61, 0x74, 0x74, 0x6c, 0x65, 0x73, 0x32, 0xf6, 0x01, 0x0a, 0x06, 0x52, 0x6f, 0x79, 0x61, 0x6c,
+	0x65, 0x12, 0x33, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x12, 0x14,
+	0x2e, 0x67, 0x6f, 0x72, 0x6f, 0x79, 0x61, 0x6c, 0x65, 0x2e, 0x54, 0x61, 0x67, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x67, 0x6f, 0x72, 0x6f, 0x79, 0x61, 0x6c, 0x65, 0x2e,
+	0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x12, 0x43, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61,
+	0x79, 0x65, 0x72, 0x42, 0x61, 0x74, 0x74, 0x6c, 0x65, 0x73, 0x12, 0x14, 0x2e, 0x67, 0x6f, 0x72,
+	0x6f, 0x79, 0x61, 0x6c, 0x65, 0x2e, 0x54, 0x61, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x19, 0x2e, 0x67, 0x6f, 0x72, 0x6f, 0x79, 0x61, 0x6c, 0x65, 0x2e, 0x42, 0x61, 0x74, 0x74,
+	0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x07, 0x47,
+	0x65, 0x74, 0x43, 0x6c, 0x61, 0x6e, 0x12, 0x14, 0x2e, 0x67, 0x6f, 0x72, 0x6f, 0x79, 0x61, 0x6c,
+	0x65, 0x2e, 0x54, 0x61, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x67,
+	0x6f, 0x72, 0x6f, 0x79, 0x61, 0x6c, 0x65, 0x2e, 0x43, 0x6c, 0x61, 0x6e, 0x12, 0x41, 0x0a, 0x0e,
+	0x47, 0x65, 0x74, 0x43, 0x6c, 0x61, 0x6e, 0x42, 0x61, 0x74, 0x74, 0x6c, 0x65, 0x73, 0x12, 0x14,
+	0x2e, 0x67, 0x6f, 0x72, 0x6f, 0x79, 0x61, 0x6c, 0x65, 0x2e, 0x54, 0x61, 0x67, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x67, 0x6f, 0x72, 0x6f, 0x79, 0x61, 0x6c, 0x65, 0x2e,
+	0x42, 0x61, 0x74, 0x74, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42,
+	0x28, 0x5a, 0x26, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6a, 0x65,
+	0x67, 0x66, 0x69, 0x73, 0x68, 0x2f, 0x67, 0x6f, 0x72, 0x6f, 0x79, 0x61, 0x6c, 0x65, 0x2f, 0x67,
+	0x6f, 0x72, 0x6f, 0x79, 0x61, 0x6c, 0x65, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
+})
+
+var (
+	file_service_proto_rawDescOnce sync.Once
+	file_service_proto_rawDescData []byte
+)
+
+func file_service_proto_rawDescGZIP() []byte {
+	file_service_proto_rawDescOnce.Do(func() {
+		file_service_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_service_proto_rawDesc), len(file_service_proto_rawDesc)))
+	})
+	return file_service_proto_rawDescData
+}
+
+var file_service_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_service_proto_goTypes = []any{
+	(*TagRequest)(nil),      // 0: goroyale.TagRequest
+	(*BattlesResponse)(nil), // 1: goroyale.BattlesResponse
+	(*Battle)(nil),          // 2: goroyale.Battle
+	(*Player)(nil),          // 3: goroyale.Player
+	(*Clan)(nil),            // 4: goroyale.Clan
+}
+var file_service_proto_depIdxs = []int32{
+	2, // 0: goroyale.BattlesResponse.battles:type_name -> goroyale.Battle
+	0, // 1: goroyale.Royale.GetPlayer:input_type -> goroyale.TagRequest
+	0, // 2: goroyale.Royale.GetPlayerBattles:input_type -> goroyale.TagRequest
+	0, // 3: goroyale.Royale.GetClan:input_type -> goroyale.TagRequest
+	0, // 4: goroyale.Royale.GetClanBattles:input_type -> goroyale.TagRequest
+	3, // 5: goroyale.Royale.GetPlayer:output_type -> goroyale.Player
+	1, // 6: goroyale.Royale.GetPlayerBattles:output_type -> goroyale.BattlesResponse
+	4, // 7: goroyale.Royale.GetClan:output_type -> goroyale.Clan
+	1, // 8: goroyale.Royale.GetClanBattles:output_type -> goroyale.BattlesResponse
+	5, // [5:9] is the sub-list for method output_type
+	1, // [1:5] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_service_proto_init() }
+func file_service_proto_init() {
+	if File_service_proto != nil {
+		return
+	}
+	file_goroyale_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_service_proto_rawDesc), len(file_service_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_service_proto_goTypes,
+		DependencyIndexes: file_service_proto_depIdxs,
+		MessageInfos:      file_service_proto_msgTypes,
+	}.Build()
+	File_service_proto = out.File
+	file_service_proto_goTypes = nil
+	file_service_proto_depIdxs = nil
+}
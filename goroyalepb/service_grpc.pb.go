@@ -0,0 +1,238 @@
+// Service definition for a gRPC gateway in front of a goroyale Client.
+// One gateway can hold the API token, cache, and ratelimit for many services.
+
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: service.proto
+
+package goroyalepb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	Royale_GetPlayer_FullMethodName        = "/goroyale.Royale/GetPlayer"
+	Royale_GetPlayerBattles_FullMethodName = "/goroyale.Royale/GetPlayerBattles"
+	Royale_GetClan_FullMethodName          = "/goroyale.Royale/GetClan"
+	Royale_GetClanBattles_FullMethodName   = "/goroyale.Royale/GetClanBattles"
+)
+
+// RoyaleClient is the client API for Royale service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type RoyaleClient interface {
+	GetPlayer(ctx context.Context, in *TagRequest, opts ...grpc.CallOption) (*Player, error)
+	GetPlayerBattles(ctx context.Context, in *TagRequest, opts ...grpc.CallOption) (*BattlesResponse, error)
+	GetClan(ctx context.Context, in *TagRequest, opts ...grpc.CallOption) (*Clan, error)
+	GetClanBattles(ctx context.Context, in *TagRequest, opts ...grpc.CallOption) (*BattlesResponse, error)
+}
+
+type royaleClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewRoyaleClient(cc grpc.ClientConnInterface) RoyaleClient {
+	return &royaleClient{cc}
+}
+
+func (c *royaleClient) GetPlayer(ctx context.Context, in *TagRequest, opts ...grpc.CallOption) (*Player, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Player)
+	err := c.cc.Invoke(ctx, Royale_GetPlayer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *royaleClient) GetPlayerBattles(ctx context.Context, in *TagRequest, opts ...grpc.CallOption) (*BattlesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BattlesResponse)
+	err := c.cc.Invoke(ctx, Royale_GetPlayerBattles_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *royaleClient) GetClan(ctx context.Context, in *TagRequest, opts ...grpc.CallOption) (*Clan, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Clan)
+	err := c.cc.Invoke(ctx, Royale_GetClan_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *royaleClient) GetClanBattles(ctx context.Context, in *TagRequest, opts ...grpc.CallOption) (*BattlesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BattlesResponse)
+	err := c.cc.Invoke(ctx, Royale_GetClanBattles_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RoyaleServer is the server API for Royale service.
+// All implementations must embed UnimplementedRoyaleServer
+// for forward compatibility.
+type RoyaleServer interface {
+	GetPlayer(context.Context, *TagRequest) (*Player, error)
+	GetPlayerBattles(context.Context, *TagRequest) (*BattlesResponse, error)
+	GetClan(context.Context, *TagRequest) (*Clan, error)
+	GetClanBattles(context.Context, *TagRequest) (*BattlesResponse, error)
+	mustEmbedUnimplementedRoyaleServer()
+}
+
+// UnimplementedRoyaleServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedRoyaleServer struct{}
+
+func (UnimplementedRoyaleServer) GetPlayer(context.Context, *TagRequest) (*Player, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPlayer not implemented")
+}
+func (UnimplementedRoyaleServer) GetPlayerBattles(context.Context, *TagRequest) (*BattlesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPlayerBattles not implemented")
+}
+func (UnimplementedRoyaleServer) GetClan(context.Context, *TagRequest) (*Clan, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetClan not implemented")
+}
+func (UnimplementedRoyaleServer) GetClanBattles(context.Context, *TagRequest) (*BattlesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetClanBattles not implemented")
+}
+func (UnimplementedRoyaleServer) mustEmbedUnimplementedRoyaleServer() {}
+func (UnimplementedRoyaleServer) testEmbeddedByValue()                {}
+
+// UnsafeRoyaleServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to RoyaleServer will
+// result in compilation errors.
+type UnsafeRoyaleServer interface {
+	mustEmbedUnimplementedRoyaleServer()
+}
+
+func RegisterRoyaleServer(s grpc.ServiceRegistrar, srv RoyaleServer) {
+	// If the following call pancis, it indicates UnimplementedRoyaleServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Royale_ServiceDesc, srv)
+}
+
+func _Royale_GetPlayer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TagRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoyaleServer).GetPlayer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Royale_GetPlayer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoyaleServer).GetPlayer(ctx, req.(*TagRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Royale_GetPlayerBattles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TagRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoyaleServer).GetPlayerBattles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Royale_GetPlayerBattles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoyaleServer).GetPlayerBattles(ctx, req.(*TagRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Royale_GetClan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TagRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoyaleServer).GetClan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Royale_GetClan_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoyaleServer).GetClan(ctx, req.(*TagRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Royale_GetClanBattles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TagRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoyaleServer).GetClanBattles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Royale_GetClanBattles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoyaleServer).GetClanBattles(ctx, req.(*TagRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Royale_ServiceDesc is the grpc.ServiceDesc for Royale service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Royale_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "goroyale.Royale",
+	HandlerType: (*RoyaleServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetPlayer",
+			Handler:    _Royale_GetPlayer_Handler,
+		},
+		{
+			MethodName: "GetPlayerBattles",
+			Handler:    _Royale_GetPlayerBattles_Handler,
+		},
+		{
+			MethodName: "GetClan",
+			Handler:    _Royale_GetClan_Handler,
+		},
+		{
+			MethodName: "GetClanBattles",
+			Handler:    _Royale_GetClanBattles_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "service.proto",
+}
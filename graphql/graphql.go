@@ -0,0 +1,1042 @@
+// Package graphql serves a read only GraphQL API in front of a goroyale Client,
+// so front-ends can ask for exactly the fields they need in one round trip.
+//
+//	{
+//	  me: player(tag: "8L9L9GL") { name trophies clan { tag name } }
+//	  clan(tag: "2CCCP") { name members { name role } }
+//	  battles(tag: "8L9L9GL") { mode { name } winner }
+//	}
+//
+// The root fields are player(tag), players(tags), clan(tag), clans(tags), and battles(tag).
+// Every other field is a field of the goroyale struct being returned, with its first letter lower cased,
+// ex: Player.CurrentDeck is currentDeck. Names are matched ignoring case.
+//
+// Root fields are batched like a dataloader: all the players asked for by a query are requested together
+// with Client.Players, every tag is only requested once, and the same goes for clans and battles.
+// Set up caching on the Client itself with SetCache to reuse responses across queries.
+//
+// Fragments work, named or inline, and their type conditions are the names of goroyale structs:
+//
+//	{ player(tag: "8L9L9GL") { ...profile } }
+//	fragment profile on Player { name clan { ... on PlayerClan { tag } } }
+//
+// Only queries are supported, there's no introspection or directives.
+//
+//	http.Handle("/graphql", &graphql.Handler{Client: c})
+package graphql
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jegfish/goroyale"
+)
+
+// Request is a GraphQL request, as sent in the body of a POST.
+type Request struct {
+	Query         string                 `json:"query"`
+	OperationName string                 `json:"operationName,omitempty"`
+	Variables     map[string]interface{} `json:"variables,omitempty"`
+}
+
+// Error is a GraphQL error. Path is the field it happened at, as names and list indexes.
+type Error struct {
+	Message string        `json:"message"`
+	Path    []interface{} `json:"path,omitempty"`
+}
+
+func (err Error) Error() string {
+	if len(err.Path) == 0 {
+		return err.Message
+	}
+	parts := make([]string, len(err.Path))
+	for i, p := range err.Path {
+		parts[i] = fmt.Sprint(p)
+	}
+	return strings.Join(parts, ".") + ": " + err.Message
+}
+
+// Response is a GraphQL response. Data is nil if the query couldn't be run at all.
+type Response struct {
+	Data   interface{} `json:"data"`
+	Errors []Error     `json:"errors,omitempty"`
+}
+
+// Execute runs a query against c.
+func Execute(ctx context.Context, c *goroyale.Client, req Request) Response {
+	op, err := parse(req.Query, req.OperationName)
+	if err == nil {
+		op.selection, err = collect(op.selection, "Query")
+	}
+	if err != nil {
+		return Response{Errors: []Error{{Message: err.Error()}}}
+	}
+	vars := make(map[string]interface{}, len(op.defaults)+len(req.Variables))
+	for name, v := range op.defaults {
+		vars[name] = v
+	}
+	for name, v := range req.Variables {
+		vars[name] = v
+	}
+
+	e := executor{vars: vars, loader: newLoader(c.WithContext(ctx))}
+	roots := make([]rootField, len(op.selection))
+	for i, f := range op.selection {
+		roots[i] = e.plan(f)
+	}
+	e.loader.load()
+
+	data := make(object, 0, len(roots))
+	for _, r := range roots {
+		path := []interface{}{r.field.key()}
+		var v interface{}
+		if r.err != nil {
+			e.fail(path, r.err)
+		} else {
+			v = e.resolveRoot(r, path)
+		}
+		data = append(data, member{r.field.key(), v})
+	}
+	return Response{Data: data, Errors: e.errors}
+}
+
+// Handler serves GraphQL over HTTP, as a POST with a JSON Request body or a GET with query,
+// operationName, and variables URL parameters.
+type Handler struct {
+	Client *goroyale.Client
+	// MaxBodyBytes limits the size of POST bodies, 1 MiB if it is 0.
+	MaxBodyBytes int64
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var req Request
+	switch r.Method {
+	case http.MethodGet:
+		q := r.URL.Query()
+		req.Query, req.OperationName = q.Get("query"), q.Get("operationName")
+		if v := q.Get("variables"); v != "" {
+			if err := json.Unmarshal([]byte(v), &req.Variables); err != nil {
+				http.Error(w, "variables must be a JSON object", http.StatusBadRequest)
+				return
+			}
+		}
+	case http.MethodPost:
+		limit := h.MaxBodyBytes
+		if limit <= 0 {
+			limit = 1 << 20
+		}
+		if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, limit)).Decode(&req); err != nil {
+			http.Error(w, "body must be a JSON GraphQL request", http.StatusBadRequest)
+			return
+		}
+	default:
+		http.Error(w, "only GET and POST are supported", http.StatusMethodNotAllowed)
+		return
+	}
+
+	resp := Execute(r.Context(), h.Client, req)
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(resp)
+}
+
+// object is a JSON object that keeps its keys in the order the query asked for them.
+type object []member
+
+type member struct {
+	key   string
+	value interface{}
+}
+
+// MarshalJSON writes o's members in order.
+func (o object) MarshalJSON() ([]byte, error) {
+	var b strings.Builder
+	b.WriteByte('{')
+	for i, m := range o {
+		if i > 0 {
+			b.WriteByte(',')
+		}
+		key, _ := json.Marshal(m.key)
+		value, err := json.Marshal(m.value)
+		if err != nil {
+			return nil, err
+		}
+		b.Write(key)
+		b.WriteByte(':')
+		b.Write(value)
+	}
+	b.WriteByte('}')
+	return []byte(b.String()), nil
+}
+
+// Queries
+
+type field struct {
+	alias, name string
+	args        map[string]value
+	selection   []field
+
+	// fragment is set for inline fragments, which put their selection in place of themselves
+	// if the object is of type on, or whatever its type if on is empty.
+	fragment bool
+	on       string
+	// spread names the fragment of a fragment spread, they're replaced by inline fragments once the query is parsed.
+	spread string
+	pos    int
+}
+
+// key is the name of the field in the response.
+func (f field) key() string {
+	if f.alias != "" {
+		return f.alias
+	}
+	return f.name
+}
+
+// value is an argument value as written in the query, variables are looked up when it's used.
+type value struct {
+	variable string
+	literal  interface{}
+}
+
+type operation struct {
+	selection []field
+	defaults  map[string]interface{}
+}
+
+type fragment struct {
+	on        string
+	selection []field
+}
+
+// parse reads the operation to run from a query document.
+func parse(query, operationName string) (op operation, err error) {
+	p := parser{lex: lexer{src: query}}
+	p.next()
+	var ops []operation
+	var names []string
+	fragments := make(map[string]fragment)
+	for p.tok.kind != tokEOF {
+		if p.is("fragment") {
+			if err = p.fragmentDefinition(fragments); err != nil {
+				return
+			}
+			continue
+		}
+		var name string
+		var o operation
+		if name, o, err = p.operation(); err != nil {
+			return
+		}
+		ops, names = append(ops, o), append(names, name)
+	}
+	switch {
+	case len(ops) == 0:
+		err = errors.New("query has no operations")
+	case operationName != "":
+		found := false
+		for i, name := range names {
+			if name == operationName {
+				op, found = ops[i], true
+				break
+			}
+		}
+		if !found {
+			err = fmt.Errorf("no operation named %q", operationName)
+		}
+	case len(ops) > 1:
+		err = errors.New("query has more than one operation, operationName is needed")
+	default:
+		op = ops[0]
+	}
+	if err == nil {
+		op.selection, err = expand(op.selection, fragments, make(map[string]bool))
+	}
+	return
+}
+
+// expand replaces the fragment spreads in fields with inline fragments.
+// spreading holds the fragments being expanded, so ones that spread themselves are caught.
+func expand(fields []field, fragments map[string]fragment, spreading map[string]bool) ([]field, error) {
+	expanded := make([]field, len(fields))
+	for i, f := range fields {
+		if f.spread != "" {
+			frag, ok := fragments[f.spread]
+			if !ok {
+				return nil, fmt.Errorf("unknown fragment %q at %d", f.spread, f.pos)
+			}
+			if spreading[f.spread] {
+				return nil, fmt.Errorf("fragment %q spreads itself at %d", f.spread, f.pos)
+			}
+			spreading[f.spread] = true
+			selection, err := expand(frag.selection, fragments, spreading)
+			delete(spreading, f.spread)
+			if err != nil {
+				return nil, err
+			}
+			f = field{fragment: true, on: frag.on, selection: selection, pos: f.pos}
+		} else if len(f.selection) > 0 {
+			var err error
+			if f.selection, err = expand(f.selection, fragments, spreading); err != nil {
+				return nil, err
+			}
+		}
+		expanded[i] = f
+	}
+	return expanded, nil
+}
+
+// collect returns the fields selected from an object of type typeName: the fields of matching inline fragments
+// are put in their place, and fields asked for more than once under the same name are merged.
+func collect(selection []field, typeName string) (fields []field, err error) {
+	index := make(map[string]int)
+	var add func(selection []field) error
+	add = func(selection []field) error {
+		for _, f := range selection {
+			if f.fragment {
+				if f.on != "" && !strings.EqualFold(f.on, typeName) {
+					return fmt.Errorf("fragment on %s at %d can't be used on %s", f.on, f.pos, typeName)
+				}
+				if err := add(f.selection); err != nil {
+					return err
+				}
+				continue
+			}
+			i, ok := index[f.key()]
+			if !ok {
+				index[f.key()] = len(fields)
+				fields = append(fields, f)
+				continue
+			}
+			prev := &fields[i]
+			if !strings.EqualFold(prev.name, f.name) || !reflect.DeepEqual(prev.args, f.args) {
+				return fmt.Errorf("%s is asked for twice with different fields or arguments, use an alias", f.key())
+			}
+			prev.selection = append(append([]field(nil), prev.selection...), f.selection...)
+		}
+		return nil
+	}
+	err = add(selection)
+	return
+}
+
+type tokenKind int
+
+const (
+	tokEOF tokenKind = iota
+	tokPunct
+	tokName
+	tokString
+	tokInt
+	tokFloat
+)
+
+type token struct {
+	kind tokenKind
+	text string
+	pos  int
+}
+
+type lexer struct {
+	src string
+	pos int
+}
+
+func (l *lexer) next() (token, error) {
+	for l.pos < len(l.src) {
+		c := l.src[l.pos]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r' || c == ',':
+			l.pos++
+			continue
+		case c == '#':
+			for l.pos < len(l.src) && l.src[l.pos] != '\n' {
+				l.pos++
+			}
+			continue
+		}
+		break
+	}
+	start := l.pos
+	if l.pos >= len(l.src) {
+		return token{tokEOF, "", start}, nil
+	}
+
+	c := l.src[l.pos]
+	switch {
+	case strings.HasPrefix(l.src[l.pos:], "..."):
+		l.pos += 3
+		return token{tokPunct, "...", start}, nil
+	case strings.IndexByte("{}()[]:$!=@", c) != -1:
+		l.pos++
+		return token{tokPunct, string(c), start}, nil
+	case c == '_' || isLetter(c):
+		for l.pos < len(l.src) && (l.src[l.pos] == '_' || isLetter(l.src[l.pos]) || isDigit(l.src[l.pos])) {
+			l.pos++
+		}
+		return token{tokName, l.src[start:l.pos], start}, nil
+	case c == '-' || isDigit(c):
+		l.pos++
+		kind := tokInt
+		for l.pos < len(l.src) {
+			d := l.src[l.pos]
+			if d == '.' || d == 'e' || d == 'E' || ((d == '+' || d == '-') && (l.src[l.pos-1] == 'e' || l.src[l.pos-1] == 'E')) {
+				kind = tokFloat
+			} else if !isDigit(d) {
+				break
+			}
+			l.pos++
+		}
+		return token{kind, l.src[start:l.pos], start}, nil
+	case c == '"':
+		l.pos++
+		for l.pos < len(l.src) && l.src[l.pos] != '"' {
+			if l.src[l.pos] == '\\' {
+				l.pos++
+			}
+			l.pos++
+		}
+		if l.pos >= len(l.src) {
+			return token{}, fmt.Errorf("unterminated string at %d", start)
+		}
+		l.pos++
+		s, err := strconv.Unquote(l.src[start:l.pos])
+		if err != nil {
+			return token{}, fmt.Errorf("bad string at %d: %v", start, err)
+		}
+		return token{tokString, s, start}, nil
+	}
+	return token{}, fmt.Errorf("unexpected %q at %d", c, start)
+}
+
+func isLetter(c byte) bool { return (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') }
+func isDigit(c byte) bool  { return c >= '0' && c <= '9' }
+
+type parser struct {
+	lex lexer
+	tok token
+	err error
+}
+
+// next moves on to the next token, the first lexing error sticks.
+func (p *parser) next() {
+	if p.err != nil {
+		return
+	}
+	if p.tok, p.err = p.lex.next(); p.err != nil {
+		p.tok = token{kind: tokEOF}
+	}
+}
+
+func (p *parser) is(text string) bool {
+	return (p.tok.kind == tokPunct || p.tok.kind == tokName) && p.tok.text == text
+}
+
+func (p *parser) expect(text string) error {
+	if p.err != nil {
+		return p.err
+	}
+	if !p.is(text) {
+		return p.unexpected("expected " + strconv.Quote(text))
+	}
+	p.next()
+	return nil
+}
+
+func (p *parser) unexpected(want string) error {
+	if p.err != nil {
+		return p.err
+	}
+	if p.tok.kind == tokEOF {
+		return fmt.Errorf("%s, got the end of the query", want)
+	}
+	return fmt.Errorf("%s, got %q at %d", want, p.tok.text, p.tok.pos)
+}
+
+func (p *parser) name() (string, error) {
+	if p.tok.kind != tokName {
+		return "", p.unexpected("expected a name")
+	}
+	name := p.tok.text
+	p.next()
+	return name, nil
+}
+
+func (p *parser) operation() (name string, op operation, err error) {
+	op.defaults = make(map[string]interface{})
+	if p.is("{") {
+		op.selection, err = p.selectionSet()
+		return
+	}
+	switch {
+	case p.is("query"):
+		p.next()
+	case p.is("mutation") || p.is("subscription"):
+		return "", op, fmt.Errorf("%ss aren't supported, only queries", p.tok.text)
+	default:
+		return "", op, p.unexpected("expected an operation")
+	}
+	if p.tok.kind == tokName {
+		name = p.tok.text
+		p.next()
+	}
+	if p.is("(") {
+		if err = p.variableDefinitions(op.defaults); err != nil {
+			return
+		}
+	}
+	op.selection, err = p.selectionSet()
+	return
+}
+
+func (p *parser) variableDefinitions(defaults map[string]interface{}) error {
+	p.next()
+	for !p.is(")") {
+		if err := p.expect("$"); err != nil {
+			return err
+		}
+		name, err := p.name()
+		if err != nil {
+			return err
+		}
+		if err = p.expect(":"); err != nil {
+			return err
+		}
+		// types aren't checked, arguments are converted when they're used
+		if err = p.skipType(); err != nil {
+			return err
+		}
+		if p.is("=") {
+			p.next()
+			v, err := p.value()
+			if err != nil {
+				return err
+			}
+			defaults[name] = v.literal
+		}
+	}
+	p.next()
+	return nil
+}
+
+func (p *parser) skipType() error {
+	if p.is("[") {
+		p.next()
+		if err := p.skipType(); err != nil {
+			return err
+		}
+		if err := p.expect("]"); err != nil {
+			return err
+		}
+	} else if _, err := p.name(); err != nil {
+		return err
+	}
+	if p.is("!") {
+		p.next()
+	}
+	return nil
+}
+
+func (p *parser) selectionSet() (fields []field, err error) {
+	if err = p.expect("{"); err != nil {
+		return
+	}
+	for !p.is("}") {
+		if p.tok.kind == tokEOF {
+			return nil, p.unexpected(`expected "}"`)
+		}
+		var f field
+		if p.is("...") {
+			f, err = p.fragment()
+		} else {
+			f, err = p.field()
+		}
+		if err != nil {
+			return
+		}
+		fields = append(fields, f)
+	}
+	p.next()
+	if len(fields) == 0 {
+		err = errors.New("empty selection")
+	}
+	return
+}
+
+// fragmentDefinition adds the fragment defined at the current token to fragments.
+func (p *parser) fragmentDefinition(fragments map[string]fragment) (err error) {
+	p.next()
+	pos := p.tok.pos
+	if p.is("on") {
+		return p.unexpected("expected a fragment name")
+	}
+	name, err := p.name()
+	if err != nil {
+		return
+	}
+	if _, ok := fragments[name]; ok {
+		return fmt.Errorf("fragment %q at %d is already defined", name, pos)
+	}
+	var frag fragment
+	if err = p.expect("on"); err != nil {
+		return
+	}
+	if frag.on, err = p.name(); err != nil {
+		return
+	}
+	if p.is("@") {
+		return errors.New("directives aren't supported")
+	}
+	if frag.selection, err = p.selectionSet(); err != nil {
+		return
+	}
+	fragments[name] = frag
+	return
+}
+
+// fragment parses a fragment spread or an inline fragment, starting at its "...".
+func (p *parser) fragment() (f field, err error) {
+	f.fragment, f.pos = true, p.tok.pos
+	p.next()
+	if p.tok.kind == tokName && p.tok.text != "on" {
+		f.spread = p.tok.text
+		p.next()
+		if p.is("@") {
+			err = errors.New("directives aren't supported")
+		}
+		return
+	}
+	if p.is("on") {
+		p.next()
+		if f.on, err = p.name(); err != nil {
+			return
+		}
+	}
+	if p.is("@") {
+		return f, errors.New("directives aren't supported")
+	}
+	f.selection, err = p.selectionSet()
+	return
+}
+
+func (p *parser) field() (f field, err error) {
+	if f.name, err = p.name(); err != nil {
+		return
+	}
+	if p.is(":") {
+		p.next()
+		f.alias = f.name
+		if f.name, err = p.name(); err != nil {
+			return
+		}
+	}
+	if p.is("(") {
+		p.next()
+		f.args = make(map[string]value)
+		for !p.is(")") {
+			var name string
+			if name, err = p.name(); err != nil {
+				return
+			}
+			if err = p.expect(":"); err != nil {
+				return
+			}
+			if f.args[name], err = p.value(); err != nil {
+				return
+			}
+		}
+		p.next()
+	}
+	if p.is("@") {
+		return f, errors.New("directives aren't supported")
+	}
+	if p.is("{") {
+		f.selection, err = p.selectionSet()
+	}
+	return
+}
+
+func (p *parser) value() (v value, err error) {
+	tok := p.tok
+	switch {
+	case p.is("$"):
+		p.next()
+		v.variable, err = p.name()
+		return
+	case p.is("["):
+		p.next()
+		var list []interface{}
+		for !p.is("]") {
+			if p.tok.kind == tokEOF {
+				return v, p.unexpected(`expected "]"`)
+			}
+			var item value
+			if item, err = p.value(); err != nil {
+				return
+			}
+			if item.variable != "" {
+				return v, errors.New("variables inside lists aren't supported")
+			}
+			list = append(list, item.literal)
+		}
+		p.next()
+		v.literal = list
+		return
+	case tok.kind == tokString:
+		v.literal = tok.text
+	case tok.kind == tokInt:
+		v.literal, err = strconv.ParseInt(tok.text, 10, 64)
+	case tok.kind == tokFloat:
+		v.literal, err = strconv.ParseFloat(tok.text, 64)
+	case tok.kind == tokName:
+		switch tok.text {
+		case "true":
+			v.literal = true
+		case "false":
+			v.literal = false
+		case "null":
+		default:
+			// enum values are passed on as strings
+			v.literal = tok.text
+		}
+	default:
+		return v, p.unexpected("expected a value")
+	}
+	p.next()
+	return
+}
+
+// Resolving
+
+type executor struct {
+	vars   map[string]interface{}
+	loader *loader
+	errors []Error
+}
+
+func (e *executor) fail(path []interface{}, err error) {
+	e.errors = append(e.errors, Error{err.Error(), append([]interface{}(nil), path...)})
+}
+
+func (e *executor) arg(f field, name string) (interface{}, bool) {
+	v, ok := f.args[name]
+	if !ok {
+		return nil, false
+	}
+	if v.variable != "" {
+		val, ok := e.vars[v.variable]
+		return val, ok
+	}
+	return v.literal, true
+}
+
+func (e *executor) stringArg(f field, name string) (string, error) {
+	v, _ := e.arg(f, name)
+	s, ok := v.(string)
+	if !ok || s == "" {
+		return "", fmt.Errorf("%s needs a %s string argument", f.name, name)
+	}
+	return s, nil
+}
+
+func (e *executor) stringsArg(f field, name string) ([]string, error) {
+	v, _ := e.arg(f, name)
+	list, ok := v.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("%s needs a %s list argument", f.name, name)
+	}
+	tags := make([]string, len(list))
+	for i, item := range list {
+		if tags[i], ok = item.(string); !ok {
+			return nil, fmt.Errorf("%s needs a list of strings for %s", f.name, name)
+		}
+	}
+	return tags, nil
+}
+
+// rootField is a root field along with the tags it needs loaded.
+type rootField struct {
+	field field
+	kind  loadKind
+	tags  []string
+	list  bool
+	err   error
+}
+
+func (e *executor) plan(f field) (r rootField) {
+	r.field = f
+	switch f.name {
+	case "player", "clan", "battles":
+		r.kind = map[string]loadKind{"player": loadPlayer, "clan": loadClan, "battles": loadBattles}[f.name]
+		var tag string
+		if tag, r.err = e.stringArg(f, "tag"); r.err == nil {
+			r.tags = []string{goroyale.NormalizeTag(tag)}
+		}
+	case "players", "clans":
+		r.kind = map[string]loadKind{"players": loadPlayer, "clans": loadClan}[f.name]
+		r.list = true
+		if r.tags, r.err = e.stringsArg(f, "tags"); r.err == nil {
+			for i, tag := range r.tags {
+				r.tags[i] = goroyale.NormalizeTag(tag)
+			}
+		}
+	case "__typename":
+		return
+	default:
+		r.err = fmt.Errorf("unknown root field %q, use player, players, clan, clans, or battles", f.name)
+		return
+	}
+	if r.err == nil && len(f.selection) == 0 {
+		r.err = fmt.Errorf("%s needs a selection of fields", f.name)
+	}
+	for _, tag := range r.tags {
+		e.loader.want(r.kind, tag)
+	}
+	return
+}
+
+func (e *executor) resolveRoot(r rootField, path []interface{}) interface{} {
+	if r.field.name == "__typename" {
+		return "Query"
+	}
+	if !r.list {
+		v, err := e.loader.get(r.kind, r.tags[0])
+		if err != nil {
+			e.fail(path, err)
+			return nil
+		}
+		return e.project(reflect.ValueOf(v), r.field.selection, path)
+	}
+	list := make([]interface{}, len(r.tags))
+	for i, tag := range r.tags {
+		itemPath := append(path, i)
+		v, err := e.loader.get(r.kind, tag)
+		if err != nil {
+			e.fail(itemPath, err)
+			continue
+		}
+		list[i] = e.project(reflect.ValueOf(v), r.field.selection, itemPath)
+	}
+	return list
+}
+
+var (
+	timeType      = reflect.TypeOf(time.Time{})
+	marshalerType = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+)
+
+// leaf reports whether values of t are written out whole instead of having fields selected.
+func leaf(t reflect.Type) bool {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	switch t.Kind() {
+	case reflect.Struct:
+		return t == timeType || t.Implements(marshalerType) || reflect.PtrTo(t).Implements(marshalerType)
+	case reflect.Slice, reflect.Array:
+		return leaf(t.Elem())
+	}
+	return true
+}
+
+// project picks the selected fields out of v.
+func (e *executor) project(v reflect.Value, selection []field, path []interface{}) interface{} {
+	for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return nil
+		}
+		v = v.Elem()
+	}
+	if leaf(v.Type()) {
+		if len(selection) > 0 {
+			e.fail(path, fmt.Errorf("%s can't have a selection of fields", path[len(path)-1]))
+			return nil
+		}
+		return v.Interface()
+	}
+	if len(selection) == 0 {
+		e.fail(path, fmt.Errorf("%s needs a selection of fields", path[len(path)-1]))
+		return nil
+	}
+
+	if v.Kind() == reflect.Slice || v.Kind() == reflect.Array {
+		if v.Kind() == reflect.Slice && v.IsNil() {
+			return []interface{}{}
+		}
+		list := make([]interface{}, v.Len())
+		for i := range list {
+			list[i] = e.project(v.Index(i), selection, append(path, i))
+		}
+		return list
+	}
+
+	selection, err := collect(selection, v.Type().Name())
+	if err != nil {
+		e.fail(path, err)
+		return nil
+	}
+
+	obj := make(object, 0, len(selection))
+	for _, f := range selection {
+		fieldPath := append(path, f.key())
+		if f.name == "__typename" {
+			obj = append(obj, member{f.key(), v.Type().Name()})
+			continue
+		}
+		if len(f.args) > 0 {
+			e.fail(fieldPath, fmt.Errorf("%s doesn't take arguments", f.name))
+			obj = append(obj, member{f.key(), nil})
+			continue
+		}
+		sf, ok := v.Type().FieldByNameFunc(func(name string) bool { return strings.EqualFold(name, f.name) })
+		if !ok || !sf.IsExported() {
+			e.fail(fieldPath, fmt.Errorf("%s has no field %q", v.Type().Name(), f.name))
+			obj = append(obj, member{f.key(), nil})
+			continue
+		}
+		obj = append(obj, member{f.key(), e.project(v.FieldByIndex(sf.Index), f.selection, fieldPath)})
+	}
+	return obj
+}
+
+// Loading
+
+type loadKind int
+
+const (
+	loadPlayer loadKind = iota
+	loadClan
+	loadBattles
+)
+
+// batchSizes are how many tags are put in one request for each kind.
+var batchSizes = map[loadKind]int{
+	loadPlayer:  7,
+	loadClan:    10,
+	loadBattles: 7,
+}
+
+type loadResult struct {
+	value interface{}
+	err   error
+}
+
+// loader collects the tags a query needs then requests each of them once, in as few requests as it can.
+type loader struct {
+	client  *goroyale.Client
+	wanted  map[loadKind][]string
+	results map[loadKind]map[string]loadResult
+}
+
+func newLoader(c *goroyale.Client) *loader {
+	return &loader{
+		client:  c,
+		wanted:  make(map[loadKind][]string),
+		results: make(map[loadKind]map[string]loadResult),
+	}
+}
+
+func (l *loader) want(kind loadKind, tag string) {
+	for _, t := range l.wanted[kind] {
+		if t == tag {
+			return
+		}
+	}
+	l.wanted[kind] = append(l.wanted[kind], tag)
+}
+
+func (l *loader) get(kind loadKind, tag string) (interface{}, error) {
+	r, ok := l.results[kind][tag]
+	if !ok {
+		return nil, fmt.Errorf("%s wasn't loaded", tag)
+	}
+	return r.value, r.err
+}
+
+func (l *loader) load() {
+	for kind, tags := range l.wanted {
+		results := make(map[string]loadResult, len(tags))
+		size := batchSizes[kind]
+		for start := 0; start < len(tags); start += size {
+			end := start + size
+			if end > len(tags) {
+				end = len(tags)
+			}
+			l.loadBatch(kind, tags[start:end], results)
+		}
+		l.results[kind] = results
+	}
+}
+
+// loadBatch requests tags and stores what came back for each of them in results.
+// A single tag uses the single tag endpoint since the API only returns a list for more than one.
+func (l *loader) loadBatch(kind loadKind, tags []string, results map[string]loadResult) {
+	var values []interface{}
+	var err error
+	switch kind {
+	case loadPlayer:
+		var players []goroyale.Player
+		if len(tags) == 1 {
+			var p goroyale.Player
+			p, err = l.client.Player(tags[0], nil)
+			players = []goroyale.Player{p}
+		} else {
+			players, err = l.client.Players(tags, nil)
+		}
+		for _, p := range players {
+			values = append(values, p)
+		}
+	case loadClan:
+		var clans []goroyale.Clan
+		if len(tags) == 1 {
+			var c goroyale.Clan
+			c, err = l.client.Clan(tags[0], nil)
+			clans = []goroyale.Clan{c}
+		} else {
+			clans, err = l.client.Clans(tags, nil)
+		}
+		for _, c := range clans {
+			values = append(values, c)
+		}
+	case loadBattles:
+		var battles [][]goroyale.Battle
+		if len(tags) == 1 {
+			var b []goroyale.Battle
+			b, err = l.client.PlayerBattles(tags[0], nil)
+			battles = [][]goroyale.Battle{b}
+		} else {
+			battles, err = l.client.PlayersBattles(tags, nil)
+		}
+		for _, b := range battles {
+			values = append(values, b)
+		}
+	}
+
+	// players and clans are matched up by tag in case the API reorders them, battles have nothing to go on but order
+	byTag := make(map[string]interface{}, len(values))
+	for _, v := range values {
+		if rv := reflect.ValueOf(v); rv.Kind() == reflect.Struct {
+			byTag[goroyale.NormalizeTag(rv.FieldByName("Tag").String())] = v
+		}
+	}
+	for i, tag := range tags {
+		v, ok := byTag[tag]
+		if !ok && kind == loadBattles && i < len(values) {
+			v, ok = values[i], true
+		}
+		switch {
+		case err != nil:
+			results[tag] = loadResult{err: err}
+		case ok:
+			results[tag] = loadResult{value: v}
+		default:
+			results[tag] = loadResult{err: fmt.Errorf("no result for %s", tag)}
+		}
+	}
+}
@@ -0,0 +1,282 @@
+package graphql
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/jegfish/goroyale"
+)
+
+var testPlayers = map[string]string{
+	"2PP":     `{"tag":"2PP","name":"Second","trophies":4790,"clan":{"tag":"2CCCP","name":"Clan","role":"leader"}}`,
+	"8L9L9GL": `{"tag":"8L9L9GL","name":"Eighth","trophies":5012}`,
+}
+
+// testClient returns a Client for an API with testPlayers, the clan 2CCCP, and a battle for each player.
+// The paths it requested are added to requests.
+func testClient(t *testing.T) (c *goroyale.Client, requests func() []string) {
+	var mu sync.Mutex
+	var paths []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		paths = append(paths, r.URL.Path)
+		mu.Unlock()
+		path := strings.TrimPrefix(r.URL.Path, "/")
+		battles := strings.HasSuffix(path, "/battles")
+		path = strings.TrimSuffix(path, "/battles")
+		kind, list, _ := strings.Cut(path, "/")
+		tags := strings.Split(list, ",")
+
+		var items []string
+		for _, tag := range tags {
+			item := ""
+			switch {
+			case kind == "player" && battles && testPlayers[tag] != "":
+				item = `[{"type":"PvP","mode":{"name":"Ladder"},"winner":1,"team":[{"tag":"` + tag + `"}]}]`
+			case kind == "player":
+				item = testPlayers[tag]
+			case kind == "clan" && tag == "2CCCP":
+				item = `{"tag":"2CCCP","name":"Clan","members":[{"tag":"2PP","name":"Second"},{"tag":"8L9L9GL","name":"Eighth"}]}`
+			}
+			if item == "" {
+				w.WriteHeader(http.StatusNotFound)
+				w.Write([]byte(`{"error":true,"status":404,"message":"Not found"}`))
+				return
+			}
+			items = append(items, item)
+		}
+		if len(items) == 1 {
+			w.Write([]byte(items[0]))
+			return
+		}
+		w.Write([]byte("[" + strings.Join(items, ",") + "]"))
+	}))
+	t.Cleanup(srv.Close)
+	c, err := goroyale.New("token", goroyale.WithBaseURL(srv.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return c, func() []string {
+		mu.Lock()
+		defer mu.Unlock()
+		return append([]string(nil), paths...)
+	}
+}
+
+func TestExecute(t *testing.T) {
+	for _, test := range []struct {
+		name      string
+		req       Request
+		want      string
+		wantError string // a substring of the only error, if there's one
+	}{
+		{
+			name: "aliases",
+			req:  Request{Query: `{ me: player(tag: "2PP") { name cups: trophies } them: player(tag: "#8l9l9gl") { name } }`},
+			want: `{"me":{"name":"Second","cups":4790},"them":{"name":"Eighth"}}`,
+		},
+		{
+			name: "nested fields and lists",
+			req:  Request{Query: `{ clan(tag: "2CCCP") { name members { tag } } battles(tag: "2PP") { mode { name } winner } }`},
+			want: `{"clan":{"name":"Clan","members":[{"tag":"2PP"},{"tag":"8L9L9GL"}]},"battles":[{"mode":{"name":"Ladder"},"winner":1}]}`,
+		},
+		{
+			name: "variables and defaults",
+			req: Request{
+				Query:     `query Q($tag: String = "2PP", $tags: [String!]!) { player(tag: $tag) { name } players(tags: $tags) { tag } }`,
+				Variables: map[string]interface{}{"tags": []interface{}{"8L9L9GL", "2PP"}},
+			},
+			want: `{"player":{"name":"Second"},"players":[{"tag":"8L9L9GL"},{"tag":"2PP"}]}`,
+		},
+		{
+			name: "variables override defaults",
+			req: Request{
+				Query:     `query ($tag: String = "2PP") { player(tag: $tag) { name } }`,
+				Variables: map[string]interface{}{"tag": "8L9L9GL"},
+			},
+			want: `{"player":{"name":"Eighth"}}`,
+		},
+		{
+			name: "typename and comments",
+			req: Request{Query: `# the query's type
+			{
+				__typename
+				player(tag: "2PP") { __typename clan { __typename tag } } # the player and their clan
+			}`},
+			want: `{"__typename":"Query","player":{"__typename":"Player","clan":{"__typename":"PlayerClan","tag":"2CCCP"}}}`,
+		},
+		{
+			name: "operation name",
+			req:  Request{Query: `query A { player(tag: "2PP") { name } } query B { clan(tag: "2CCCP") { name } }`, OperationName: "B"},
+			want: `{"clan":{"name":"Clan"}}`,
+		},
+		{
+			name: "named fragments",
+			req: Request{Query: `
+				{ player(tag: "2PP") { ...profile } players(tags: ["8L9L9GL"]) { ...profile } }
+				fragment profile on Player { name ...clan }
+				fragment clan on Player { clan { tag } }`},
+			want: `{"player":{"name":"Second","clan":{"tag":"2CCCP"}},"players":[{"name":"Eighth","clan":{"tag":""}}]}`,
+		},
+		{
+			name: "inline fragments",
+			req:  Request{Query: `{ ... on Query { player(tag: "2PP") { ... { name } clan { ... on PlayerClan { role } } } } }`},
+			want: `{"player":{"name":"Second","clan":{"role":"leader"}}}`,
+		},
+		{
+			name: "fields asked for twice are merged",
+			req:  Request{Query: `{ player(tag: "2PP") { name clan { tag } ...more } } fragment more on Player { name clan { name } }`},
+			want: `{"player":{"name":"Second","clan":{"tag":"2CCCP","name":"Clan"}}}`,
+		},
+		{
+			name:      "unknown field",
+			req:       Request{Query: `{ player(tag: "2PP") { name nickname } }`},
+			want:      `{"player":{"name":"Second","nickname":null}}`,
+			wantError: `player.nickname: Player has no field "nickname"`,
+		},
+		{
+			name:      "unknown root field",
+			req:       Request{Query: `{ me { name } }`},
+			want:      `{"me":null}`,
+			wantError: `me: unknown root field "me"`,
+		},
+		{
+			name:      "arguments on a field",
+			req:       Request{Query: `{ player(tag: "2PP") { name(short: true) } }`},
+			want:      `{"player":{"name":null}}`,
+			wantError: "player.name: name doesn't take arguments",
+		},
+		{
+			name:      "selection on a leaf",
+			req:       Request{Query: `{ player(tag: "2PP") { name { first } } }`},
+			want:      `{"player":{"name":null}}`,
+			wantError: "player.name: name can't have a selection of fields",
+		},
+		{
+			name:      "no selection on an object",
+			req:       Request{Query: `{ player(tag: "2PP") { clan } }`},
+			want:      `{"player":{"clan":null}}`,
+			wantError: "player.clan: clan needs a selection of fields",
+		},
+		{
+			name:      "missing argument",
+			req:       Request{Query: `query ($tag: String) { player(tag: $tag) { name } }`},
+			want:      `{"player":null}`,
+			wantError: "player: player needs a tag string argument",
+		},
+		{
+			name:      "fragment on the wrong type",
+			req:       Request{Query: `{ player(tag: "2PP") { ... on Clan { name } } }`},
+			want:      `{"player":null}`,
+			wantError: "player: fragment on Clan at 23 can't be used on Player",
+		},
+		{
+			name:      "conflicting fields",
+			req:       Request{Query: `{ player(tag: "2PP") { name name: trophies } }`},
+			want:      `{"player":null}`,
+			wantError: "player: name is asked for twice with different fields or arguments, use an alias",
+		},
+		{
+			name:      "API errors",
+			req:       Request{Query: `{ player(tag: "2PP") { name } clan(tag: "MISSING") { name } }`},
+			want:      `{"player":{"name":"Second"},"clan":null}`,
+			wantError: "clan: ",
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			c, _ := testClient(t)
+			resp := Execute(context.Background(), c, test.req)
+			data, err := json.Marshal(resp.Data)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(data) != test.want {
+				t.Errorf("got %s, want %s", data, test.want)
+			}
+			switch {
+			case test.wantError == "" && len(resp.Errors) > 0:
+				t.Errorf("got errors %v", resp.Errors)
+			case test.wantError != "" && (len(resp.Errors) != 1 || !strings.Contains(resp.Errors[0].Error(), test.wantError)):
+				t.Errorf("got errors %v, want %q", resp.Errors, test.wantError)
+			}
+		})
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	for _, test := range []struct {
+		query, operationName, want string
+	}{
+		{``, "", "query has no operations"},
+		{`{ player(tag: "2PP") { name }`, "", `expected "}", got the end of the query`},
+		{`{ player(tag: ) { name } }`, "", `expected a value, got ")" at 14`},
+		{`{ player(tag: "2PP" { name } }`, "", `expected a name, got "{" at 20`},
+		{`{ player(tag: "2PP) { name } }`, "", "unterminated string at 14"},
+		{`{ player ^ }`, "", `unexpected '^' at 9`},
+		{`{ }`, "", "empty selection"},
+		{`query ($tag String) { a }`, "", `expected ":", got "String" at 12`},
+		{`{ player @include(if: true) }`, "", "directives aren't supported"},
+		{`mutation { a }`, "", "mutations aren't supported, only queries"},
+		{`query A { a } query B { b }`, "", "query has more than one operation, operationName is needed"},
+		{`query A { a }`, "C", `no operation named "C"`},
+		{`{ ...missing }`, "", `unknown fragment "missing" at 2`},
+		{`{ ...loop } fragment loop on Query { ...loop }`, "", `fragment "loop" spreads itself at 37`},
+		{`{ a } fragment f on Query { a } fragment f on Query { b }`, "", `fragment "f" at 41 is already defined`},
+		{`{ a } fragment on Query { a }`, "", `expected a fragment name, got "on" at 15`},
+		{`{ a } fragment f { a }`, "", `expected "on", got "{" at 17`},
+	} {
+		_, err := parse(test.query, test.operationName)
+		if err == nil || err.Error() != test.want {
+			t.Errorf("%s: got %v, want %s", test.query, err, test.want)
+		}
+	}
+}
+
+func TestExecuteBatches(t *testing.T) {
+	c, requests := testClient(t)
+	resp := Execute(context.Background(), c, Request{Query: `{
+		a: player(tag: "2PP") { name }
+		b: players(tags: ["8L9L9GL", "#2pp"]) { name }
+		c: player(tag: "8L9L9GL") { trophies }
+	}`})
+	if len(resp.Errors) > 0 {
+		t.Fatal(resp.Errors)
+	}
+	if got := requests(); len(got) != 1 || got[0] != "/player/2PP,8L9L9GL" {
+		t.Errorf("a query for 2 players requested %v, want them together once", got)
+	}
+}
+
+func TestHandler(t *testing.T) {
+	c, _ := testClient(t)
+	srv := httptest.NewServer(&Handler{Client: c})
+	defer srv.Close()
+
+	post, err := http.Post(srv.URL, "application/json", strings.NewReader(`{"query":"query ($t: String) { player(tag: $t) { name } }","variables":{"t":"2PP"}}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer post.Body.Close()
+	var resp struct {
+		Data struct {
+			Player struct{ Name string }
+		}
+	}
+	if err := json.NewDecoder(post.Body).Decode(&resp); err != nil || resp.Data.Player.Name != "Second" {
+		t.Errorf("POST got %+v, %v", resp, err)
+	}
+
+	get, err := http.Get(srv.URL + `?query={player(tag:"2PP"){name}}&variables=nope`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	get.Body.Close()
+	if get.StatusCode != http.StatusBadRequest {
+		t.Errorf("GET with bad variables is %s, want 400", get.Status)
+	}
+}
@@ -0,0 +1,92 @@
+package goroyale
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"time"
+)
+
+// HealthStatus is how the API is doing according to Client.Health.
+type HealthStatus string
+
+// Statuses returned by Client.Health.
+const (
+	HealthUp       HealthStatus = "up"
+	HealthDegraded HealthStatus = "degraded"
+	HealthDown     HealthStatus = "down"
+)
+
+// Thresholds used by Client.Health.
+const (
+	// DefaultHealthTimeout is how long the probe can take before the API is considered down.
+	DefaultHealthTimeout = 5 * time.Second
+	// DegradedLatency is how slow the probe can be before the API is considered degraded.
+	DegradedLatency = 2 * time.Second
+	// DegradedErrorRate is the fraction of recent requests that can fail before the API is considered degraded.
+	DegradedErrorRate = 0.2
+)
+
+// Health is the result of Client.Health.
+type Health struct {
+	Status  HealthStatus
+	Version string        // as returned by /version, empty if the probe failed or was skipped
+	Latency time.Duration // how long the probe took
+	// ErrorRate is the fraction of responses within the usage window that were network errors or 5xx responses.
+	ErrorRate float64
+	Responses int
+	// Err is why the probe failed, nil if it succeeded.
+	// If the Client is out of requests the probe is skipped and Err is a RateLimitWaitError,
+	// Status then only goes off of ErrorRate.
+	Err error
+}
+
+// Health probes /version with a DefaultHealthTimeout timeout and combines the result with the error rate
+// of the Client's recent requests, so services can report the API's status in their own health checks.
+// The probe skips the cache and doesn't wait for the ratelimit.
+func (c *Client) Health(ctx context.Context) (h Health) {
+	ctx, cancel := context.WithTimeout(NoWait(ctx), DefaultHealthTimeout)
+	defer cancel()
+
+	buf := bufferPool.Get().(*bytes.Buffer)
+	buf.Reset()
+	defer bufferPool.Put(buf)
+
+	start := c.now()
+	resp, err := c.send(ctx, "/version", nil, buf)
+	h.Latency = c.now().Sub(start)
+	if err == nil && resp.StatusCode != 200 {
+		err = apiError(resp, buf.Bytes())
+	}
+	if err == nil {
+		h.Version = buf.String()
+	}
+	h.Err = err
+
+	usage := c.Usage()
+	h.Responses = usage.Responses
+	if usage.Responses > 0 {
+		h.ErrorRate = float64(usage.Failed) / float64(usage.Responses)
+	}
+
+	var apiErr APIError
+	switch {
+	case errors.Is(err, ErrRateLimitWait):
+		// being out of requests says nothing about the API
+		if h.ErrorRate > DegradedErrorRate {
+			h.Status = HealthDegraded
+		} else {
+			h.Status = HealthUp
+		}
+	case errors.As(err, &apiErr) && apiErr.StatusCode < 500:
+		// the API answered, ex: a bad token
+		h.Status = HealthDegraded
+	case err != nil:
+		h.Status = HealthDown
+	case h.Latency > DegradedLatency || h.ErrorRate > DegradedErrorRate:
+		h.Status = HealthDegraded
+	default:
+		h.Status = HealthUp
+	}
+	return
+}
@@ -0,0 +1,62 @@
+package goroyale
+
+import (
+	"strconv"
+	"strings"
+)
+
+// AssetsBaseURL is where image URLs point to.
+// It's a var so it can be changed if the CDN moves.
+var AssetsBaseURL = "https://royaleapi.github.io/cr-api-assets"
+
+// ImageSize selects one of the resized copies of an image on the CDN.
+type ImageSize string
+
+// Image sizes available on the CDN, ImageSizeFull is the original image.
+const (
+	ImageSizeFull ImageSize = ""
+	ImageSize150  ImageSize = "-150"
+	ImageSize75   ImageSize = "-75"
+)
+
+func assetURL(dir string, size ImageSize, file string) string {
+	return AssetsBaseURL + "/" + dir + string(size) + "/" + file + ".png"
+}
+
+// slug turns a name like "Super Magical" into "super-magical".
+func slug(name string) string {
+	return strings.ToLower(strings.Join(strings.Fields(name), "-"))
+}
+
+// CardIconURL returns the image of the card with the key, ex: "hog-rider".
+// golden selects the gold card frame.
+func CardIconURL(key string, golden bool, size ImageSize) string {
+	dir := "cards"
+	if golden {
+		dir = "cards-gold"
+	}
+	return assetURL(dir, size, key)
+}
+
+// BadgeImageURL returns the image of a clan badge, see Badge.ImageURL.
+func BadgeImageURL(badge Badge, size ImageSize) string {
+	return badge.ImageURL(size)
+}
+
+// ArenaImageURL returns the image of an arena.
+func ArenaImageURL(arena Arena, size ImageSize) string {
+	return assetURL("arenas", size, "arena"+strconv.Itoa(arena.ArenaID))
+}
+
+// ChestImageURL returns the image of a chest by name, ex: "Super Magical" or "superMagical".
+func ChestImageURL(name string, size ImageSize) string {
+	// split camel case names like the ones in PlayerChests.Upcoming
+	var b strings.Builder
+	for i, r := range name {
+		if i > 0 && r >= 'A' && r <= 'Z' && name[i-1] != ' ' {
+			b.WriteByte(' ')
+		}
+		b.WriteRune(r)
+	}
+	return assetURL("chests", size, "chest-"+slug(b.String()))
+}
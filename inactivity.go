@@ -0,0 +1,93 @@
+package goroyale
+
+import (
+	"fmt"
+	"sort"
+	"time"
+)
+
+// LastBattleTime returns when the player last played a battle, from their battle log.
+// It's zero if the battle log is empty, which the API also sends for players who haven't played in a long time.
+func (c *Client) LastBattleTime(tag string) (last time.Time, err error) {
+	battles, err := c.PlayerBattles(tag, nil)
+	last = lastBattle(battles)
+	return
+}
+
+func lastBattle(battles []Battle) (last time.Time) {
+	for _, b := range battles {
+		if t := time.Unix(int64(b.UTCTime), 0); t.After(last) {
+			last = t
+		}
+	}
+	return
+}
+
+// InactiveMember is a clan member who hasn't played a battle for a while.
+type InactiveMember struct {
+	ClanMember
+	LastBattle time.Time // zero if their battle log is empty
+}
+
+// Inactive returns how long the member had gone without a battle at now.
+// It's 0 if their battle log was empty.
+func (m InactiveMember) Inactive(now time.Time) time.Duration {
+	if m.LastBattle.IsZero() {
+		return 0
+	}
+	return now.Sub(m.LastBattle)
+}
+
+// InactiveMembers requests the battle logs of the members of clan and returns the ones
+// whose last battle was more than threshold ago, longest inactive first.
+// Members with an empty battle log come before everyone else.
+// If some battle logs can't be fetched the rest are still checked and a MembersError is returned with them.
+func (c *Client) InactiveMembers(clan Clan, threshold time.Duration) (inactive []InactiveMember, err error) {
+	now := c.now()
+	failed := MembersError{Clan: clan.Tag, Errors: make(map[string]error)}
+	batch := maxTags["/player"]
+	for start := 0; start < len(clan.Members); start += batch {
+		members := clan.Members[start:min(start+batch, len(clan.Members))]
+		tags := make([]string, len(members))
+		for i, m := range members {
+			tags[i] = NormalizeTag(m.Tag)
+		}
+		var logs [][]Battle
+		var batchErr error
+		if len(tags) == 1 {
+			// with one tag the API sends a single battle log instead of a list of them
+			var battles []Battle
+			battles, batchErr = c.PlayerBattles(tags[0], nil)
+			logs = [][]Battle{battles}
+		} else {
+			logs, batchErr = c.PlayersBattles(tags, nil)
+		}
+		if batchErr == nil && len(logs) != len(tags) {
+			batchErr = fmt.Errorf("got %d battle logs for %d players", len(logs), len(tags))
+		}
+		if batchErr != nil {
+			for _, m := range members {
+				failed.Errors[m.Tag] = batchErr
+			}
+			continue
+		}
+		for i, m := range members {
+			last := lastBattle(logs[i])
+			if last.IsZero() || now.Sub(last) > threshold {
+				inactive = append(inactive, InactiveMember{m, last})
+			}
+		}
+	}
+
+	sort.SliceStable(inactive, func(i, j int) bool {
+		a, b := inactive[i].LastBattle, inactive[j].LastBattle
+		if a.IsZero() || b.IsZero() {
+			return a.IsZero() && !b.IsZero()
+		}
+		return a.Before(b)
+	})
+	if len(failed.Errors) > 0 {
+		err = failed
+	}
+	return
+}
@@ -0,0 +1,51 @@
+// Command schemagen writes JSON Schemas for the structs in goroyale.
+// It's run by go generate in the root of the repository.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/jegfish/goroyale"
+)
+
+var types = map[string]interface{}{
+	"Player":             goroyale.Player{},
+	"Battle":             goroyale.Battle{},
+	"PlayerChests":       goroyale.PlayerChests{},
+	"Clan":               goroyale.Clan{},
+	"ClanSearch":         goroyale.ClanSearch{},
+	"ClanWar":            goroyale.ClanWar{},
+	"ClanWarLogEntry":    goroyale.ClanWarLogEntry{},
+	"ClanTracking":       goroyale.ClanTracking{},
+	"Tournament":         goroyale.Tournament{},
+	"SpecificTournament": goroyale.SpecificTournament{},
+	"TopPlayer":          goroyale.TopPlayer{},
+	"TopClan":            goroyale.TopClan{},
+	"PopularDeck":        goroyale.PopularDeck{},
+}
+
+func main() {
+	out := flag.String("out", "schema", "directory to write the schemas to")
+	flag.Parse()
+
+	if err := os.MkdirAll(*out, 0755); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	for name, v := range types {
+		b, err := goroyale.JSONSchema(v)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, name+":", err)
+			os.Exit(1)
+		}
+		path := filepath.Join(*out, name+".json")
+		if err = ioutil.WriteFile(path, append(b, '\n'), 0644); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+	}
+}
@@ -0,0 +1,94 @@
+// Package serve has what the servers in cmd share, so they're configured and report errors the same way.
+package serve
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"net/http"
+	"time"
+
+	"github.com/jegfish/goroyale"
+	"google.golang.org/grpc/codes"
+)
+
+// CacheFlags are the -cache-size and -cache-ttl flags of a server.
+type CacheFlags struct {
+	fs   *flag.FlagSet
+	size *int
+	ttl  *time.Duration
+}
+
+// AddCacheFlags adds -cache-size and -cache-ttl to fs.
+func AddCacheFlags(fs *flag.FlagSet) *CacheFlags {
+	return &CacheFlags{
+		fs:   fs,
+		size: fs.Int("cache-size", 10000, "how many responses to keep in memory, overrides ROYALEAPI_CACHE_SIZE"),
+		ttl:  fs.Duration("cache-ttl", time.Minute, "how long to cache responses for, overrides ROYALEAPI_CACHE_TTL"),
+	}
+}
+
+// Config reads a Config from ROYALEAPI_* environment variables, see goroyale.ConfigFromEnv.
+// The flags override the environment's cache settings when they're given, and their defaults are used
+// where the environment doesn't set up a cache, so a server always has one unless -cache-size is 0.
+func (f *CacheFlags) Config() (cfg goroyale.Config, err error) {
+	if cfg, err = goroyale.ConfigFromEnv(); err != nil {
+		return
+	}
+	set := make(map[string]bool)
+	f.fs.Visit(func(fl *flag.Flag) { set[fl.Name] = true })
+	configured := cfg.Cache.Size > 0 || cfg.Cache.Dir != ""
+	if set["cache-size"] || !configured {
+		cfg.Cache.Size = *f.size
+	}
+	if set["cache-ttl"] || !configured && cfg.Cache.TTL == 0 {
+		cfg.Cache.TTL = goroyale.ConfigDuration(*f.ttl)
+	}
+	return
+}
+
+// NewClient creates a Client configured by the environment and the flags, see Config.
+func (f *CacheFlags) NewClient() (*goroyale.Client, error) {
+	cfg, err := f.Config()
+	if err != nil {
+		return nil, err
+	}
+	return cfg.NewClient()
+}
+
+// clientClosedRequest is the status nginx logs for requests the client gave up on, it has no name in net/http.
+const clientClosedRequest = 499
+
+// Status picks the HTTP status and gRPC code to pass an error from the Client on with.
+// Both come from the same case so goroyale-server and goroyale-grpc report an error the same way.
+func Status(err error) (httpStatus int, code codes.Code) {
+	var apiErr goroyale.APIError
+	switch {
+	case errors.Is(err, context.Canceled):
+		return clientClosedRequest, codes.Canceled
+	case errors.Is(err, context.DeadlineExceeded):
+		return http.StatusGatewayTimeout, codes.DeadlineExceeded
+	case errors.Is(err, goroyale.ErrUnknownLocation) || errors.Is(err, goroyale.ErrInvalidRequest):
+		return http.StatusBadRequest, codes.InvalidArgument
+	case errors.Is(err, goroyale.ErrNotTracked):
+		return http.StatusNotFound, codes.NotFound
+	case errors.Is(err, goroyale.ErrRateLimitWait):
+		return http.StatusTooManyRequests, codes.ResourceExhausted
+	case errors.As(err, &apiErr):
+		switch apiErr.StatusCode {
+		case http.StatusBadRequest:
+			return http.StatusBadRequest, codes.InvalidArgument
+		case http.StatusNotFound:
+			return http.StatusNotFound, codes.NotFound
+		case http.StatusUnauthorized, http.StatusForbidden:
+			// the server's token was rejected, which isn't something the caller can fix
+			return http.StatusBadGateway, codes.Internal
+		case http.StatusTooManyRequests:
+			return http.StatusTooManyRequests, codes.ResourceExhausted
+		}
+		if apiErr.StatusCode >= 400 && apiErr.StatusCode < 500 {
+			return apiErr.StatusCode, codes.FailedPrecondition
+		}
+	}
+	return http.StatusBadGateway, codes.Unavailable
+}
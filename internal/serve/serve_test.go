@@ -0,0 +1,88 @@
+package serve
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/jegfish/goroyale"
+	"google.golang.org/grpc/codes"
+)
+
+func TestCacheFlagsConfig(t *testing.T) {
+	type cache struct {
+		size int
+		dir  string
+		ttl  time.Duration
+	}
+	for _, test := range []struct {
+		name string
+		env  map[string]string
+		args []string
+		want cache
+	}{
+		{"defaults", nil, nil, cache{10000, "", time.Minute}},
+		{"environment", map[string]string{"ROYALEAPI_CACHE_SIZE": "50", "ROYALEAPI_CACHE_TTL": "5m"}, nil, cache{50, "", 5 * time.Minute}},
+		{"environment dir", map[string]string{"ROYALEAPI_CACHE_DIR": "/tmp/cache"}, nil, cache{0, "/tmp/cache", 0}},
+		{"only a ttl in the environment", map[string]string{"ROYALEAPI_CACHE_TTL": "5m"}, nil, cache{10000, "", 5 * time.Minute}},
+		{"flags", map[string]string{"ROYALEAPI_CACHE_SIZE": "50", "ROYALEAPI_CACHE_TTL": "5m"}, []string{"-cache-size", "20", "-cache-ttl", "10s"}, cache{20, "", 10 * time.Second}},
+		{"size flag", map[string]string{"ROYALEAPI_CACHE_SIZE": "50", "ROYALEAPI_CACHE_TTL": "5m"}, []string{"-cache-size", "20"}, cache{20, "", 5 * time.Minute}},
+		{"no cache", nil, []string{"-cache-size", "0"}, cache{0, "", time.Minute}},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			for _, name := range []string{"ROYALEAPI_CACHE_SIZE", "ROYALEAPI_CACHE_DIR", "ROYALEAPI_CACHE_TTL"} {
+				t.Setenv(name, test.env[name])
+			}
+			fs := flag.NewFlagSet("server", flag.ContinueOnError)
+			flags := AddCacheFlags(fs)
+			if err := fs.Parse(test.args); err != nil {
+				t.Fatal(err)
+			}
+			cfg, err := flags.Config()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := (cache{cfg.Cache.Size, cfg.Cache.Dir, time.Duration(cfg.Cache.TTL)}); got != test.want {
+				t.Errorf("got %+v, want %+v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestCacheFlagsNewClient(t *testing.T) {
+	t.Setenv("ROYALEAPI_RETRY_ATTEMPTS", "lots")
+	flags := AddCacheFlags(flag.NewFlagSet("server", flag.ContinueOnError))
+	if _, err := flags.NewClient(); err == nil {
+		t.Error("a bad environment variable didn't stop the Client being made")
+	}
+}
+
+func TestStatus(t *testing.T) {
+	for _, test := range []struct {
+		err        error
+		httpStatus int
+		code       codes.Code
+	}{
+		{context.Canceled, clientClosedRequest, codes.Canceled},
+		{fmt.Errorf("fetching: %w", context.DeadlineExceeded), http.StatusGatewayTimeout, codes.DeadlineExceeded},
+		{goroyale.ErrInvalidRequest, http.StatusBadRequest, codes.InvalidArgument},
+		{goroyale.ErrUnknownLocation, http.StatusBadRequest, codes.InvalidArgument},
+		{goroyale.ErrNotTracked, http.StatusNotFound, codes.NotFound},
+		{goroyale.RateLimitWaitError{Wait: time.Minute}, http.StatusTooManyRequests, codes.ResourceExhausted},
+		{goroyale.APIError{StatusCode: http.StatusBadRequest}, http.StatusBadRequest, codes.InvalidArgument},
+		{goroyale.APIError{StatusCode: http.StatusNotFound}, http.StatusNotFound, codes.NotFound},
+		{goroyale.APIError{StatusCode: http.StatusForbidden}, http.StatusBadGateway, codes.Internal},
+		{goroyale.APIError{StatusCode: http.StatusTooManyRequests}, http.StatusTooManyRequests, codes.ResourceExhausted},
+		{goroyale.APIError{StatusCode: http.StatusConflict}, http.StatusConflict, codes.FailedPrecondition},
+		{goroyale.APIError{StatusCode: http.StatusInternalServerError}, http.StatusBadGateway, codes.Unavailable},
+		{errors.New("connection refused"), http.StatusBadGateway, codes.Unavailable},
+	} {
+		if httpStatus, code := Status(test.err); httpStatus != test.httpStatus || code != test.code {
+			t.Errorf("%v: got %d, %v, want %d, %v", test.err, httpStatus, code, test.httpStatus, test.code)
+		}
+	}
+}
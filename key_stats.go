@@ -0,0 +1,149 @@
+package goroyale
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"time"
+)
+
+// EndpointUsage is how many requests a token has made to one endpoint, from a key of APIKeyStats.RequestCount.
+type EndpointUsage struct {
+	Key      string // the key as the API sent it
+	Endpoint string // the first part of Path, ex: "/player", the same as SetConcurrencyLimit and Usage.Endpoints use
+	Path     string // the key without its window, ex: "/player/:tag/battles"
+	// Window is how far back Requests go, 0 for every request the token has made.
+	// Keys ending in a window, ex: "/player/:tag:day" or "/clan/:tag:1h", have one.
+	Window   time.Duration
+	Requests int
+}
+
+// requestWindows are the window names RequestCount keys can end in.
+var requestWindows = map[string]time.Duration{
+	"second": time.Second,
+	"minute": time.Minute,
+	"hour":   time.Hour,
+	"day":    24 * time.Hour,
+	"week":   7 * 24 * time.Hour,
+	"month":  30 * 24 * time.Hour,
+}
+
+// parseRequestKey splits a RequestCount key into an EndpointUsage.
+// Paths have parameters like ":tag" so only a last part that's a window name or a duration is taken as the window.
+func parseRequestKey(key string, requests int) EndpointUsage {
+	usage := EndpointUsage{Key: key, Path: key, Requests: requests}
+	if i := strings.LastIndexByte(key, ':'); i != -1 {
+		suffix := strings.ToLower(key[i+1:])
+		window, ok := requestWindows[suffix]
+		if !ok {
+			if d, err := time.ParseDuration(suffix); err == nil && d > 0 {
+				window, ok = d, true
+			}
+		}
+		if ok {
+			usage.Path, usage.Window = key[:i], window
+		}
+	}
+	usage.Endpoint = endpointOf(usage.Path)
+	return usage
+}
+
+// Endpoints returns RequestCount parsed into EndpointUsages, sorted by path and then window, shortest first.
+func (s APIKeyStats) Endpoints() []EndpointUsage {
+	usage := make([]EndpointUsage, 0, len(s.RequestCount))
+	for key, n := range s.RequestCount {
+		usage = append(usage, parseRequestKey(key, n))
+	}
+	sort.Slice(usage, func(i, j int) bool {
+		if usage[i].Path != usage[j].Path {
+			return usage[i].Path < usage[j].Path
+		}
+		if usage[i].Window != usage[j].Window {
+			// 0 is every request, which is the longest window
+			return usage[j].Window == 0 || usage[i].Window != 0 && usage[i].Window < usage[j].Window
+		}
+		return usage[i].Key < usage[j].Key
+	})
+	return usage
+}
+
+// paths returns the usage of each path in its longest window, sorted by path.
+// Windows of the same path overlap, so counting more than one of them would count requests twice.
+func (s APIKeyStats) paths() []EndpointUsage {
+	all := s.Endpoints()
+	paths := all[:0]
+	for i, u := range all {
+		// Endpoints puts the longest window of each path last
+		if i == len(all)-1 || all[i+1].Path != u.Path {
+			paths = append(paths, u)
+		}
+	}
+	return paths
+}
+
+// TotalRequests returns the number of requests made to every endpoint, with each path counted in its longest window.
+func (s APIKeyStats) TotalRequests() (total int) {
+	for _, u := range s.paths() {
+		total += u.Requests
+	}
+	return
+}
+
+// MostUsedEndpoints returns the n paths with the most requests in their longest window, most used first.
+// Every path is returned if n is less than 1 or more than there are paths.
+func (s APIKeyStats) MostUsedEndpoints(n int) []EndpointUsage {
+	usage := s.paths()
+	// stable so ties stay sorted by path
+	sort.SliceStable(usage, func(i, j int) bool { return usage[i].Requests > usage[j].Requests })
+	if n > 0 && n < len(usage) {
+		usage = usage[:n]
+	}
+	return usage
+}
+
+// LastRequestTime returns LastRequest as a time.Time, or the zero time if there hasn't been one.
+func (s APIKeyStats) LastRequestTime() time.Time {
+	if s.LastRequest == 0 {
+		return time.Time{}
+	}
+	return time.Unix(int64(s.LastRequest), 0)
+}
+
+// KeyReport is a token's usage along with its ratelimit, see Client.KeyReport.
+type KeyReport struct {
+	ID          string
+	LastRequest time.Time // zero if the token hasn't made a request
+	Endpoints   []EndpointUsage
+	Total       int // see APIKeyStats.TotalRequests
+
+	// Limit is how many requests the token can make per second, 0 if the API didn't say.
+	Limit int
+	// Remaining is how many requests were left when the report was made, -1 if the API didn't say.
+	Remaining int
+	// Reset is when requests become available again after Remaining hit 0, zero if they haven't run out.
+	Reset time.Time
+}
+
+// Report puts the token's stats and ratelimit together.
+func (info TokenInfo) Report() KeyReport {
+	return KeyReport{
+		ID:          info.Stats.ID,
+		LastRequest: info.Stats.LastRequestTime(),
+		Endpoints:   info.Stats.Endpoints(),
+		Total:       info.Stats.TotalRequests(),
+		Limit:       info.Limit,
+		Remaining:   info.Remaining,
+		Reset:       info.Reset,
+	}
+}
+
+// KeyReport requests the token's stats and returns them with its ratelimit, ex: for a quota audit.
+// It's the same request as VerifyToken, so its errors match ErrInvalidToken the same way.
+func (c *Client) KeyReport(ctx context.Context) (report KeyReport, err error) {
+	info, err := c.VerifyToken(ctx)
+	if err != nil {
+		return
+	}
+	report = info.Report()
+	return
+}
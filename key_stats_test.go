@@ -0,0 +1,95 @@
+package goroyale
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestParseRequestKey(t *testing.T) {
+	for _, tt := range []struct {
+		key            string
+		endpoint, path string
+		window         time.Duration
+	}{
+		{"/player", "/player", "/player", 0},
+		{"/player/:tag", "/player", "/player/:tag", 0},
+		{"/player/:tag/battles", "/player", "/player/:tag/battles", 0},
+		{"/player/:tag:day", "/player", "/player/:tag", 24 * time.Hour},
+		{"/clan/:tag/war:Hour", "/clan", "/clan/:tag/war", time.Hour},
+		{"/clan/:tag:15m", "/clan", "/clan/:tag", 15 * time.Minute},
+		{"/top/players/:location", "/top", "/top/players/:location", 0},
+		{"constants", "/constants", "constants", 0},
+	} {
+		u := parseRequestKey(tt.key, 3)
+		if u.Key != tt.key || u.Endpoint != tt.endpoint || u.Path != tt.path || u.Window != tt.window || u.Requests != 3 {
+			t.Errorf("parseRequestKey(%q) = %+v, want endpoint %q, path %q, window %v", tt.key, u, tt.endpoint, tt.path, tt.window)
+		}
+	}
+}
+
+func TestKeyStatsWindows(t *testing.T) {
+	stats := APIKeyStats{RequestCount: map[string]int{
+		"/player/:tag":         100,
+		"/player/:tag:day":     40,
+		"/player/:tag:hour":    5,
+		"/clan/:tag:day":       30,
+		"/clan/:tag:hour":      2,
+		"/player/:tag/battles": 60,
+	}}
+
+	var keys []string
+	for _, u := range stats.Endpoints() {
+		keys = append(keys, u.Key)
+	}
+	want := []string{"/clan/:tag:hour", "/clan/:tag:day", "/player/:tag:hour", "/player/:tag:day", "/player/:tag", "/player/:tag/battles"}
+	if len(keys) != len(want) {
+		t.Fatalf("got %q, want %q", keys, want)
+	}
+	for i := range want {
+		if keys[i] != want[i] {
+			t.Fatalf("got %q, want %q", keys, want)
+		}
+	}
+
+	// each path only counts once, in its longest window
+	if total := stats.TotalRequests(); total != 100+30+60 {
+		t.Errorf("TotalRequests() = %d, want %d", total, 100+30+60)
+	}
+	top := stats.MostUsedEndpoints(2)
+	if len(top) != 2 || top[0].Key != "/player/:tag" || top[1].Key != "/player/:tag/battles" {
+		t.Errorf("MostUsedEndpoints(2) = %+v", top)
+	}
+}
+
+func TestKeyReport(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("x-ratelimit-limit", "5")
+		w.Header().Set("x-ratelimit-remaining", "0")
+		w.Header().Set("x-ratelimit-retry-after", "2")
+		w.Write([]byte(`{"id":"key-1","lastRequest":1527854400,"requestCount":{"/player/:tag:day":7,"/clan/:tag":3}}`))
+	}))
+	defer srv.Close()
+	clock := NewManualClock(testStart)
+	c, err := New("token", WithBaseURL(srv.URL), WithClock(clock))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+
+	report, err := c.KeyReport(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if report.ID != "key-1" || report.Total != 10 || len(report.Endpoints) != 2 {
+		t.Errorf("got %+v", report)
+	}
+	if !report.LastRequest.Equal(time.Unix(1527854400, 0)) {
+		t.Errorf("LastRequest is %v", report.LastRequest)
+	}
+	if report.Limit != 5 || report.Remaining != 0 || !report.Reset.Equal(testStart.Add(2*time.Second)) {
+		t.Errorf("got limit %d, remaining %d, reset %v, want 5, 0, %v", report.Limit, report.Remaining, report.Reset, testStart.Add(2*time.Second))
+	}
+}
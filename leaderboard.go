@@ -0,0 +1,101 @@
+package goroyale
+
+// RankChange is a player or clan that was on both leaderboard snapshots.
+// Change is positive when they moved up the leaderboard.
+type RankChange struct {
+	Tag     string
+	Name    string
+	OldRank int
+	NewRank int
+	Change  int
+}
+
+// LeaderboardMovement is the movement between two snapshots of TopPlayers.
+type LeaderboardMovement struct {
+	Movers      []RankChange // everyone on both snapshots, in the order of the new one
+	NewEntrants []TopPlayer  // only on the new snapshot
+	Dropouts    []TopPlayer  // only on the old snapshot
+}
+
+// ClanLeaderboardMovement is the movement between two snapshots of TopClans.
+type ClanLeaderboardMovement struct {
+	Movers      []RankChange
+	NewEntrants []TopClan
+	Dropouts    []TopClan
+}
+
+type rankEntry struct {
+	tag, name string
+	rank      int
+}
+
+// diffRanks matches entries by tag and returns the rank changes plus the
+// indexes of entries only in new and only in old.
+func diffRanks(old, new []rankEntry) (movers []RankChange, added, dropped []int) {
+	oldByTag := make(map[string]rankEntry, len(old))
+	for _, e := range old {
+		oldByTag[e.tag] = e
+	}
+	newTags := make(map[string]bool, len(new))
+	for i, e := range new {
+		newTags[e.tag] = true
+		o, ok := oldByTag[e.tag]
+		if !ok {
+			added = append(added, i)
+			continue
+		}
+		movers = append(movers, RankChange{
+			Tag:     e.tag,
+			Name:    e.name,
+			OldRank: o.rank,
+			NewRank: e.rank,
+			Change:  o.rank - e.rank,
+		})
+	}
+	for i, e := range old {
+		if !newTags[e.tag] {
+			dropped = append(dropped, i)
+		}
+	}
+	return
+}
+
+// LeaderboardDiff compares two snapshots of TopPlayers.
+func LeaderboardDiff(old, new []TopPlayer) (diff LeaderboardMovement) {
+	toEntries := func(players []TopPlayer) []rankEntry {
+		entries := make([]rankEntry, len(players))
+		for i, p := range players {
+			entries[i] = rankEntry{p.Tag, p.Name, p.Rank}
+		}
+		return entries
+	}
+	movers, added, dropped := diffRanks(toEntries(old), toEntries(new))
+	diff.Movers = movers
+	for _, i := range added {
+		diff.NewEntrants = append(diff.NewEntrants, new[i])
+	}
+	for _, i := range dropped {
+		diff.Dropouts = append(diff.Dropouts, old[i])
+	}
+	return
+}
+
+// ClanLeaderboardDiff compares two snapshots of TopClans.
+func ClanLeaderboardDiff(old, new []TopClan) (diff ClanLeaderboardMovement) {
+	toEntries := func(clans []TopClan) []rankEntry {
+		entries := make([]rankEntry, len(clans))
+		for i, c := range clans {
+			entries[i] = rankEntry{c.Tag, c.Name, c.Rank}
+		}
+		return entries
+	}
+	movers, added, dropped := diffRanks(toEntries(old), toEntries(new))
+	diff.Movers = movers
+	for _, i := range added {
+		diff.NewEntrants = append(diff.NewEntrants, new[i])
+	}
+	for _, i := range dropped {
+		diff.Dropouts = append(diff.Dropouts, old[i])
+	}
+	return
+}
@@ -0,0 +1,198 @@
+package goroyale
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"os"
+	"sync"
+	"time"
+)
+
+// LeaderboardSnapshot is the TopPlayers and TopClans leaderboards of a location at one point in time.
+// Location is the key passed to TopPlayers and TopClans, "" for the global leaderboards.
+type LeaderboardSnapshot struct {
+	Location string
+	Time     time.Time
+	Players  []TopPlayer `json:",omitempty"`
+	Clans    []TopClan   `json:",omitempty"`
+}
+
+// SnapshotStore keeps leaderboard snapshots for LeaderboardRecorder.
+type SnapshotStore interface {
+	SaveSnapshot(s LeaderboardSnapshot) error
+	// Snapshots returns the snapshots of the location taken at or after since, oldest first.
+	Snapshots(location string, since time.Time) ([]LeaderboardSnapshot, error)
+}
+
+// MemorySnapshotStore is a SnapshotStore that keeps snapshots in memory.
+type MemorySnapshotStore struct {
+	mu        sync.Mutex
+	snapshots []LeaderboardSnapshot
+}
+
+// SaveSnapshot adds s to the store.
+func (m *MemorySnapshotStore) SaveSnapshot(s LeaderboardSnapshot) error {
+	m.mu.Lock()
+	m.snapshots = append(m.snapshots, s)
+	m.mu.Unlock()
+	return nil
+}
+
+// Snapshots returns the snapshots of the location taken at or after since.
+func (m *MemorySnapshotStore) Snapshots(location string, since time.Time) (snapshots []LeaderboardSnapshot, err error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, s := range m.snapshots {
+		if s.Location == location && !s.Time.Before(since) {
+			snapshots = append(snapshots, s)
+		}
+	}
+	return
+}
+
+// FileSnapshotStore is a SnapshotStore that appends snapshots to a file, one JSON object per line.
+// A missing file is treated as an empty store.
+type FileSnapshotStore struct {
+	Path string
+
+	mu sync.Mutex
+}
+
+// SaveSnapshot appends s to the file.
+func (f *FileSnapshotStore) SaveSnapshot(s LeaderboardSnapshot) error {
+	b, err := json.Marshal(s)
+	if err != nil {
+		return err
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	file, err := os.OpenFile(f.Path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return err
+	}
+	if _, err = file.Write(append(b, '\n')); err != nil {
+		file.Close()
+		return err
+	}
+	return file.Close()
+}
+
+// Snapshots reads the snapshots of the location taken at or after since from the file.
+func (f *FileSnapshotStore) Snapshots(location string, since time.Time) (snapshots []LeaderboardSnapshot, err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	file, err := os.Open(f.Path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	// a full leaderboard is a few hundred KB
+	scanner.Buffer(nil, 16<<20)
+	for scanner.Scan() {
+		var s LeaderboardSnapshot
+		if err = json.Unmarshal(scanner.Bytes(), &s); err != nil {
+			return nil, err
+		}
+		if s.Location == location && !s.Time.Before(since) {
+			snapshots = append(snapshots, s)
+		}
+	}
+	err = scanner.Err()
+	return
+}
+
+// LeaderboardRecorder snapshots the leaderboards of a set of locations into Store every Interval,
+// so it can answer questions the API can't, like a player's peak global rank.
+// If neither Players nor Clans is set both leaderboards are recorded.
+type LeaderboardRecorder struct {
+	Client    *Client
+	Store     SnapshotStore
+	Locations []string // location keys, "" for global
+	Players   bool
+	Clans     bool
+	Interval  time.Duration
+	// Bus gets a WatchError for every failed request or save, it can be nil.
+	Bus *EventBus
+}
+
+// Run snapshots until ctx is done.
+func (r *LeaderboardRecorder) Run(ctx context.Context) error {
+	return poll(ctx, r.Client, r.Interval, func() {
+		for _, location := range r.Locations {
+			if err := r.Snapshot(location); err != nil && r.Bus != nil {
+				r.Bus.Publish(WatchError{location, err})
+			}
+		}
+	})
+}
+
+// Snapshot takes and saves a single snapshot of the location.
+func (r *LeaderboardRecorder) Snapshot(location string) (err error) {
+	s := LeaderboardSnapshot{Location: location, Time: r.Client.now()}
+	both := !r.Players && !r.Clans
+	if r.Players || both {
+		if s.Players, err = r.Client.TopPlayers(location, nil); err != nil {
+			return
+		}
+	}
+	if r.Clans || both {
+		if s.Clans, err = r.Client.TopClans(location, nil); err != nil {
+			return
+		}
+	}
+	return r.Store.SaveSnapshot(s)
+}
+
+// RankPoint is a player's or clan's rank in one snapshot.
+type RankPoint struct {
+	Time time.Time
+	Rank int
+}
+
+// RankHistory returns the rank of the player or clan in every snapshot of the location since the time they were on it.
+// Snapshots they weren't on are left out.
+func (r *LeaderboardRecorder) RankHistory(tag, location string, since time.Time) (history []RankPoint, err error) {
+	snapshots, err := r.Store.Snapshots(location, since)
+	if err != nil {
+		return
+	}
+	tag = NormalizeTag(tag)
+	for _, s := range snapshots {
+		if rank, ok := s.rank(tag); ok {
+			history = append(history, RankPoint{s.Time, rank})
+		}
+	}
+	return
+}
+
+// PeakRank returns the best rank the player or clan has had on the location's leaderboard since the time.
+func (r *LeaderboardRecorder) PeakRank(tag, location string, since time.Time) (peak RankPoint, ok bool, err error) {
+	history, err := r.RankHistory(tag, location, since)
+	for _, p := range history {
+		if !ok || p.Rank < peak.Rank {
+			peak, ok = p, true
+		}
+	}
+	return
+}
+
+// rank finds the normalized tag on either leaderboard of the snapshot.
+func (s LeaderboardSnapshot) rank(tag string) (int, bool) {
+	for _, p := range s.Players {
+		if NormalizeTag(p.Tag) == tag {
+			return p.Rank, true
+		}
+	}
+	for _, c := range s.Clans {
+		if NormalizeTag(c.Tag) == tag {
+			return c.Rank, true
+		}
+	}
+	return 0, false
+}
@@ -0,0 +1,124 @@
+package goroyale
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// MultiError holds every error found while decoding a response in lenient mode.
+type MultiError []error
+
+func (m MultiError) Error() string {
+	msgs := make([]string, len(m))
+	for i, err := range m {
+		msgs[i] = err.Error()
+	}
+	return fmt.Sprintf("%d decoding errors: %s", len(m), strings.Join(msgs, "; "))
+}
+
+// Unwrap lets errors.Is and errors.As look through each error.
+func (m MultiError) Unwrap() []error {
+	return m
+}
+
+// unmarshalLenient decodes data into v, skipping the parts that don't fit.
+// Slice elements that fail to decode are left out and struct fields that fail are left as they are,
+// everything that went wrong is returned as a MultiError.
+func (c *Client) unmarshalLenient(data []byte, v interface{}) error {
+	var errs MultiError
+	c.decodeLenient(data, reflect.ValueOf(v).Elem(), "", &errs)
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+var unmarshalerType = reflect.TypeOf((*json.Unmarshaler)(nil)).Elem()
+
+func (c *Client) decodeLenient(data []byte, v reflect.Value, path string, errs *MultiError) {
+	err := c.unmarshal(data, v.Addr().Interface())
+	if err == nil {
+		return
+	}
+	// types that decode themselves have to be all or nothing
+	if reflect.PtrTo(v.Type()).Implements(unmarshalerType) {
+		*errs = append(*errs, pathError(path, err))
+		return
+	}
+
+	switch v.Kind() {
+	case reflect.Slice:
+		var elems []json.RawMessage
+		if json.Unmarshal(data, &elems) != nil {
+			break
+		}
+		s := reflect.MakeSlice(v.Type(), 0, len(elems))
+		for i, raw := range elems {
+			elem := reflect.New(v.Type().Elem()).Elem()
+			if err := c.unmarshal(raw, elem.Addr().Interface()); err != nil {
+				*errs = append(*errs, pathError(fmt.Sprintf("%s[%d]", path, i), err))
+				continue
+			}
+			s = reflect.Append(s, elem)
+		}
+		v.Set(s)
+		return
+	case reflect.Struct:
+		var fields map[string]json.RawMessage
+		if json.Unmarshal(data, &fields) != nil {
+			break
+		}
+		for key, raw := range fields {
+			f, ok := fieldByJSONName(v, key)
+			if !ok {
+				continue
+			}
+			c.decodeLenient(raw, f, path+"."+key, errs)
+		}
+		return
+	}
+	*errs = append(*errs, pathError(path, err))
+}
+
+func pathError(path string, err error) error {
+	if path == "" {
+		return err
+	}
+	return fmt.Errorf("%s: %w", strings.TrimPrefix(path, "."), err)
+}
+
+// fieldByJSONName finds the field encoding/json would decode key into, including promoted fields.
+func fieldByJSONName(v reflect.Value, key string) (reflect.Value, bool) {
+	t := v.Type()
+	var fold reflect.Value
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if f.PkgPath != "" && !f.Anonymous {
+			continue
+		}
+		tag := f.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name := strings.Split(tag, ",")[0]
+		if f.Anonymous && name == "" && f.Type.Kind() == reflect.Struct {
+			if field, ok := fieldByJSONName(v.Field(i), key); ok {
+				return field, true
+			}
+			continue
+		}
+		if name == "" {
+			name = f.Name
+		}
+		if name == key {
+			return v.Field(i), true
+		}
+		// encoding/json falls back to a case-insensitive match
+		if !fold.IsValid() && strings.EqualFold(name, key) {
+			fold = v.Field(i)
+		}
+	}
+	return fold, fold.IsValid()
+}
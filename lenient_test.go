@@ -0,0 +1,78 @@
+package goroyale
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+// fuzzDecode checks that strict and lenient decoding of data into a new T never panic,
+// and that lenient decoding gets the same result whenever strict decoding works.
+// Seeds are the recorded responses in testdata and the corpus in testdata/fuzz. They're big enough that
+// minimizing takes most of a run, so fuzz with -fuzzminimizetime 0, ex:
+//
+//	go test -run XXX -fuzz FuzzDecodePlayer -fuzzminimizetime 0
+func fuzzDecode[T any](f *testing.F, seeds ...string) {
+	for _, seed := range seeds {
+		f.Add(readTestdata(f, seed))
+	}
+	c := &Client{}
+	f.Fuzz(func(t *testing.T, data []byte) {
+		var strict, lenient T
+		strictErr := c.unmarshal(data, &strict)
+		lenientErr := c.unmarshalLenient(data, &lenient)
+		if strictErr != nil {
+			return
+		}
+		if lenientErr != nil {
+			t.Fatalf("strict decoding worked but lenient decoding failed: %v", lenientErr)
+		}
+		if !reflect.DeepEqual(strict, lenient) {
+			t.Fatalf("lenient decoding got\n%+v\nstrict decoding got\n%+v", lenient, strict)
+		}
+	})
+}
+
+func FuzzDecodePlayer(f *testing.F) {
+	fuzzDecode[Player](f, "player.json")
+}
+
+func FuzzDecodeClan(f *testing.F) {
+	fuzzDecode[Clan](f, "clan.json")
+}
+
+func FuzzDecodeBattles(f *testing.F) {
+	fuzzDecode[[]Battle](f, "battles.json")
+}
+
+func TestLenientDecodeKeepsGoodFields(t *testing.T) {
+	var player Player
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(readTestdata(t, "player.json"), &fields); err != nil {
+		t.Fatal(err)
+	}
+	// the kind of thing the API has sent: a number as an object, and one bad card in the deck
+	fields["trophies"] = json.RawMessage(`{"value":4812}`)
+	var cards []json.RawMessage
+	if err := json.Unmarshal(fields["currentDeck"], &cards); err != nil {
+		t.Fatal(err)
+	}
+	cards[3] = json.RawMessage(`{"name":"Broken","level":"high"}`)
+	fields["currentDeck"], _ = json.Marshal(cards)
+	data, _ := json.Marshal(fields)
+
+	c := &Client{}
+	if err := c.unmarshal(data, &player); err == nil {
+		t.Fatal("strict decoding didn't fail")
+	}
+	player = Player{}
+	err := c.unmarshalLenient(data, &player)
+	errs, ok := err.(MultiError)
+	if !ok || len(errs) != 2 {
+		t.Fatalf("got %v, want a MultiError with 2 errors", err)
+	}
+	if player.Name != "Hello World" || player.Trophies != 0 || len(player.CurrentDeck) != len(cards)-1 {
+		t.Errorf("got %s with %d trophies and %d cards, want Hello World with 0 trophies and %d cards",
+			player.Name, player.Trophies, len(player.CurrentDeck), len(cards)-1)
+	}
+}
@@ -0,0 +1,56 @@
+package goroyale
+
+// MaxCardLevel is the highest level a card can be upgraded to, using common card levels for every rarity.
+const MaxCardLevel = 13
+
+// TournamentCapLevel is the level cards are capped at in tournaments and tournament standard challenges,
+// using common card levels for every rarity.
+const TournamentCapLevel = 9
+
+// rarityOffset returns how many levels a card of the rarity starts above a common,
+// from the rarities in GameData, ex: 8 for legendaries.
+func rarityOffset(rarity string) (offset int, ok bool) {
+	constants, err := GameData()
+	if err != nil {
+		return
+	}
+	for _, r := range constants.Rarities {
+		if r.Name == rarity {
+			return r.RelativeLevel, true
+		}
+	}
+	return
+}
+
+// NormalizedLevel returns the level of a card the way the game shows it,
+// on the same scale for every rarity. The API counts levels from 1 for each rarity,
+// so a level 2 legendary in the API is a level 10 card in the game.
+// It falls back to working it out from MaxLevel if the card's rarity isn't in GameData.
+func NormalizedLevel(c Card) int {
+	if offset, ok := rarityOffset(c.Rarity); ok {
+		return c.Level + offset
+	}
+	return normalizedLevel(c.Level, c.MaxLevel)
+}
+
+// normalizedLevel puts a level on the common card scale using the rarity's max level.
+func normalizedLevel(level, maxLevel int) int {
+	if maxLevel == 0 {
+		return level
+	}
+	return level + MaxCardLevel - maxLevel
+}
+
+// CappedLevel returns the NormalizedLevel of a card in a mode that caps levels at capLevel, ex: TournamentCapLevel.
+// Cards below the cap keep their level.
+func CappedLevel(c Card, capLevel int) int {
+	if level := NormalizedLevel(c); level < capLevel {
+		return level
+	}
+	return capLevel
+}
+
+// TournamentLevel returns the level a card is played at in a tournament.
+func TournamentLevel(c Card) int {
+	return CappedLevel(c, TournamentCapLevel)
+}
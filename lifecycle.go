@@ -0,0 +1,125 @@
+package goroyale
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+)
+
+// ErrClientClosed is returned by requests made after Close or Shutdown,
+// and by watchers whose Client has been closed.
+var ErrClientClosed = errors.New("client is closed")
+
+// lifecycle tracks the requests and background goroutines of a Client so they can be stopped.
+// Clients returned by WithContext share the lifecycle of the Client they came from.
+type lifecycle struct {
+	ctx    context.Context // done once the Client is closed
+	cancel context.CancelFunc
+
+	mu         sync.Mutex
+	closing    bool
+	requests   sync.WaitGroup
+	background sync.WaitGroup
+}
+
+func newLifecycle() *lifecycle {
+	l := &lifecycle{}
+	l.ctx, l.cancel = context.WithCancel(context.Background())
+	return l
+}
+
+// begin registers a request, failing if the Client is closing.
+func (l *lifecycle) begin() error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.closing {
+		return ErrClientClosed
+	}
+	l.requests.Add(1)
+	return nil
+}
+
+// goBackground runs fn in a goroutine that Close waits for.
+// fn should return soon after its ctx is done.
+func (l *lifecycle) goBackground(fn func(ctx context.Context)) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.closing {
+		return
+	}
+	l.background.Add(1)
+	go func() {
+		defer l.background.Done()
+		fn(l.ctx)
+	}()
+}
+
+// bound returns a copy of ctx that is also cancelled when the Client is closed.
+func (c *Client) bound(ctx context.Context) (context.Context, context.CancelFunc) {
+	ctx, cancel := context.WithCancel(ctx)
+	stop := context.AfterFunc(c.life.ctx, cancel)
+	return ctx, func() {
+		stop()
+		cancel()
+	}
+}
+
+// closedErr turns the error of a request cancelled by Close into ErrClientClosed.
+func (c *Client) closedErr(err error) error {
+	if err != nil && c.life.ctx.Err() != nil {
+		return ErrClientClosed
+	}
+	return err
+}
+
+// after calls fn once d has passed on the Client's clock, unless the Client is closed first.
+func (c *Client) after(d time.Duration, fn func()) {
+	c.life.goBackground(func(ctx context.Context) {
+		if sleepContext(ctx, c.clock(), d) == nil {
+			fn()
+		}
+	})
+}
+
+// Shutdown stops the Client from taking new requests and waits for the ones in flight to finish,
+// including ones still waiting for the ratelimit.
+// If ctx is done first the requests left are cancelled and ctx's error is returned.
+// Either way the Client's background goroutines, like the ones waiting out a ratelimit, are stopped,
+// and the last ratelimit state is saved to its RateLimitStore, before it returns.
+// Requests made afterwards, and watchers using the Client, fail with ErrClientClosed.
+func (c *Client) Shutdown(ctx context.Context) (err error) {
+	l := c.life
+	l.mu.Lock()
+	l.closing = true
+	l.mu.Unlock()
+
+	drained := make(chan struct{})
+	go func() {
+		l.requests.Wait()
+		close(drained)
+	}()
+	select {
+	case <-drained:
+	case <-ctx.Done():
+		err = ctx.Err()
+	}
+	l.cancel()
+	<-drained
+	l.background.Wait()
+	c.usage.mu.Lock()
+	saver := c.usage.saver
+	c.usage.mu.Unlock()
+	if saver != nil {
+		saver.wait()
+	}
+	return
+}
+
+// Close is Shutdown without waiting, requests in flight are cancelled.
+func (c *Client) Close() error {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	c.Shutdown(ctx)
+	return nil
+}
@@ -0,0 +1,112 @@
+package goroyale
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// ErrRateLimitWait is matched by errors.Is when a request wasn't sent because it would have had to wait for the ratelimit.
+var ErrRateLimitWait = errors.New("request would wait for the ratelimit")
+
+// RateLimitWaitError is returned instead of waiting for the ratelimit when a request's context was made with NoWait.
+type RateLimitWaitError struct {
+	// Wait is roughly how long until a request can be made.
+	// It is 0 when there's no way to know, ex: when the only request available is being used by one in flight.
+	Wait time.Duration
+}
+
+func (err RateLimitWaitError) Error() string {
+	if err.Wait == 0 {
+		return ErrRateLimitWait.Error()
+	}
+	return fmt.Sprintf("%v for about %v", ErrRateLimitWait, err.Wait)
+}
+
+// Is reports whether target is ErrRateLimitWait.
+func (err RateLimitWaitError) Is(target error) bool {
+	return target == ErrRateLimitWait
+}
+
+type noWaitKey struct{}
+
+// NoWait returns a context that makes requests return a RateLimitWaitError straight away
+// rather than block when the ratelimit has no requests left.
+// Use it with Client.WithContext.
+func NoWait(ctx context.Context) context.Context {
+	return context.WithValue(ctx, noWaitKey{}, true)
+}
+
+func noWait(ctx context.Context) bool {
+	v, _ := ctx.Value(noWaitKey{}).(bool)
+	return v
+}
+
+// WithContext returns a copy of c that makes its requests with ctx.
+// The copy shares c's ratelimit, cache, and settings, so both can be used at once.
+//
+//	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+//	defer cancel()
+//	player, err := c.WithContext(ctx).Player(tag, nil)
+func (c *Client) WithContext(ctx context.Context) *Client {
+	clone := *c
+	clone.ctx = ctx
+	return &clone
+}
+
+func (c *Client) context() context.Context {
+	if c.ctx == nil {
+		return context.Background()
+	}
+	return c.ctx
+}
+
+// acquire takes a request from the rateBucket, giving up when ctx is done.
+func (c *Client) acquire(ctx context.Context) error {
+	if noWait(ctx) {
+		select {
+		case <-c.rateBucket:
+			return nil
+		default:
+			var wait time.Duration
+			if reset := c.Usage().Reset; !reset.IsZero() {
+				if wait = reset.Sub(c.now()); wait < 0 {
+					wait = 0
+				}
+			}
+			return RateLimitWaitError{wait}
+		}
+	}
+
+	select {
+	case <-c.rateBucket:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// sleepContext sleeps on clock for d, returning early with ctx's error if it is done first.
+func sleepContext(ctx context.Context, clock Clock, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+	if _, ok := clock.(realClock); ok {
+		// a timer can be stopped, unlike time.After's
+		timer := time.NewTimer(d)
+		defer timer.Stop()
+		select {
+		case <-timer.C:
+			return nil
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	select {
+	case <-clock.After(d):
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,65 @@
+package goroyale
+
+import (
+	"net/url"
+	"strings"
+)
+
+// WebsiteBaseURL is where profile and clan links point to.
+// It's a var so it can be changed if the site moves.
+var WebsiteBaseURL = "https://royaleapi.com"
+
+// GameLinkBaseURL opens the game on a phone that has it installed.
+var GameLinkBaseURL = "https://link.clashroyale.com"
+
+// NormalizeTag puts a player or clan tag in the form the API and links use: no leading #, upper case,
+// and with the letter O, which tags never contain, read as a zero.
+func NormalizeTag(tag string) string {
+	tag = strings.ToUpper(strings.TrimSpace(tag))
+	tag = strings.TrimPrefix(tag, "#")
+	return strings.ReplaceAll(tag, "O", "0")
+}
+
+func websiteURL(parts ...string) string {
+	return WebsiteBaseURL + "/" + strings.Join(parts, "/")
+}
+
+// PlayerURL returns the RoyaleAPI profile page of a player.
+func PlayerURL(tag string) string {
+	return websiteURL("player", NormalizeTag(tag))
+}
+
+// PlayerBattlesURL returns the RoyaleAPI page with a player's recent battles.
+// Battles don't have IDs of their own so this is the closest thing to a battle permalink.
+func PlayerBattlesURL(tag string) string {
+	return websiteURL("player", NormalizeTag(tag), "battles")
+}
+
+// ClanURL returns the RoyaleAPI page of a clan.
+func ClanURL(tag string) string {
+	return websiteURL("clan", NormalizeTag(tag))
+}
+
+// ClanWarURL returns the RoyaleAPI page of a clan's current war.
+func ClanWarURL(tag string) string {
+	return websiteURL("clan", NormalizeTag(tag), "war")
+}
+
+// TournamentURL returns the RoyaleAPI page of a tournament.
+func TournamentURL(tag string) string {
+	return websiteURL("tournament", NormalizeTag(tag))
+}
+
+func gameLink(action, tag string) string {
+	return GameLinkBaseURL + "/?" + action + "?id=" + url.QueryEscape(NormalizeTag(tag))
+}
+
+// PlayerGameLink returns a link that opens a player's profile in the game.
+func PlayerGameLink(tag string) string {
+	return gameLink("playerInfo", tag)
+}
+
+// ClanGameLink returns a link that opens a clan in the game, where players can ask to join.
+func ClanGameLink(tag string) string {
+	return gameLink("clanInfo", tag)
+}
@@ -0,0 +1,104 @@
+package goroyale
+
+import (
+	"net/url"
+	"sync"
+)
+
+// Locale is a language the constants endpoint can return names in, see Client.ConstantsIn.
+type Locale string
+
+// Locales supported by the game.
+const (
+	LocaleEnglish    Locale = "en"
+	LocaleFrench     Locale = "fr"
+	LocaleGerman     Locale = "de"
+	LocaleSpanish    Locale = "es"
+	LocaleItalian    Locale = "it"
+	LocaleDutch      Locale = "nl"
+	LocalePortuguese Locale = "pt"
+	LocaleRussian    Locale = "ru"
+	LocaleTurkish    Locale = "tr"
+	LocaleJapanese   Locale = "ja"
+	LocaleKorean     Locale = "ko"
+	LocaleChinese    Locale = "zh"
+)
+
+// ConstantsIn works like Constants but with names and descriptions in locale.
+// It sets the lang param, other params are sent as they are.
+func (c *Client) ConstantsIn(locale Locale, params url.Values) (constants Constants, err error) {
+	p := url.Values{}
+	for k, v := range params {
+		p[k] = v
+	}
+	p.Set("lang", string(locale))
+	return c.Constants(p)
+}
+
+var translations struct {
+	sync.RWMutex
+	locales map[Locale]Constants
+}
+
+// LoadLocale fetches the constants in locale so CardNameIn and ArenaNameIn can use them.
+func LoadLocale(c *Client, locale Locale) error {
+	constants, err := c.ConstantsIn(locale, nil)
+	if err != nil {
+		return err
+	}
+	RegisterLocale(locale, constants)
+	return nil
+}
+
+// RegisterLocale makes constants the source of names in locale, ex: from a file saved with ConstantsIn.
+func RegisterLocale(locale Locale, constants Constants) {
+	translations.Lock()
+	defer translations.Unlock()
+	if translations.locales == nil {
+		translations.locales = make(map[Locale]Constants)
+	}
+	translations.locales[locale] = constants
+}
+
+// localeConstants returns the constants registered for locale, falling back to GameData.
+func localeConstants(locale Locale) []Constants {
+	translations.RLock()
+	localized, ok := translations.locales[locale]
+	translations.RUnlock()
+
+	var sources []Constants
+	if ok {
+		sources = append(sources, localized)
+	}
+	if constants, err := GameData(); err == nil {
+		sources = append(sources, constants)
+	}
+	return sources
+}
+
+// CardNameIn returns the name of the card with the key, ex: "hog-rider", in locale.
+// Names fall back to English if locale hasn't been loaded with LoadLocale or RegisterLocale.
+// It returns "" for cards that aren't known at all.
+func CardNameIn(key string, locale Locale) string {
+	for _, constants := range localeConstants(locale) {
+		for _, card := range constants.Cards {
+			if card.Key == key {
+				return card.Name
+			}
+		}
+	}
+	return ""
+}
+
+// ArenaNameIn returns the name of the arena with the ID, like Arena.ArenaID, in locale.
+// It falls back to English like CardNameIn.
+func ArenaNameIn(arenaID int, locale Locale) string {
+	for _, constants := range localeConstants(locale) {
+		for _, arena := range constants.Arenas {
+			if arena.Arena == arenaID {
+				return arena.Name
+			}
+		}
+	}
+	return ""
+}
@@ -0,0 +1,148 @@
+package goroyale
+
+import (
+	"errors"
+	"sort"
+	"strings"
+)
+
+// ErrUnknownLocation is matched by errors.Is when a location can't be found in GameData.
+var ErrUnknownLocation = errors.New("unknown location")
+
+// UnknownLocationError is returned for locations that aren't in GameData.
+// The API answers unknown locations with an empty leaderboard instead of an error.
+type UnknownLocationError struct {
+	Location    string
+	Suggestions []string // names of locations that are close, best first
+}
+
+func (err UnknownLocationError) Error() string {
+	msg := "unknown location " + err.Location
+	if len(err.Suggestions) > 0 {
+		msg += ", did you mean " + strings.Join(err.Suggestions, " or ") + "?"
+	}
+	return msg
+}
+
+// Is reports whether target is ErrUnknownLocation.
+func (err UnknownLocationError) Is(target error) bool {
+	return target == ErrUnknownLocation
+}
+
+// locationAliases are codes people use that aren't the ISO 3166 codes the API uses.
+// There is no "SA" for South America since that is Saudi Arabia.
+var locationAliases = map[string]string{
+	"UK":     "GB",
+	"EN":     "GB",
+	"USA":    "US",
+	"UAE":    "AE",
+	"KOREA":  "KR",
+	"EU":     "_EU",
+	"NA":     "_NA",
+	"AS":     "_AS",
+	"OC":     "_OC",
+	"AF":     "_AF",
+	"INT":    "_INT",
+	"GLOBAL": "",
+}
+
+// Locations returns every location in GameData.
+// Keys of continents start with an underscore, ex: "_EU", countries use their ISO 3166 code, ex: "GB".
+func Locations() []ConstantsRegion {
+	constants, _ := GameData()
+	return constants.Regions
+}
+
+// LookupLocation finds a location by its key, an ISO 3166 country code, a common alias like "UK",
+// or its name, ex: "united kingdom". Names are matched ignoring case, and a unique prefix is enough.
+func LookupLocation(s string) (region ConstantsRegion, ok bool) {
+	regions := Locations()
+	key := strings.ToUpper(strings.TrimSpace(s))
+	if alias, isAlias := locationAliases[key]; isAlias {
+		key = alias
+	}
+	for _, r := range regions {
+		if r.Key == key || (strings.HasPrefix(r.Key, "_") && r.Key[1:] == key) {
+			return r, true
+		}
+	}
+
+	name := strings.ToLower(strings.TrimSpace(s))
+	if name == "" {
+		return
+	}
+	var prefixed []ConstantsRegion
+	for _, r := range regions {
+		switch lower := strings.ToLower(r.Name); {
+		case lower == name:
+			return r, true
+		case strings.HasPrefix(lower, name):
+			prefixed = append(prefixed, r)
+		}
+	}
+	if len(prefixed) == 1 {
+		return prefixed[0], true
+	}
+	return
+}
+
+// LocationKey returns the key the API uses for a location, see LookupLocation for what s can be.
+// "" and "global" give "", the global leaderboards.
+// Unknown locations return an UnknownLocationError suggesting locations with similar names.
+func LocationKey(s string) (string, error) {
+	if key := strings.ToUpper(strings.TrimSpace(s)); key == "" || key == "GLOBAL" {
+		return "", nil
+	}
+	if r, ok := LookupLocation(s); ok {
+		return r.Key, nil
+	}
+	return "", UnknownLocationError{s, suggestLocations(s, 3)}
+}
+
+// suggestLocations returns the names of up to n locations whose names are within a few edits of s.
+func suggestLocations(s string, n int) (names []string) {
+	s = strings.ToLower(strings.TrimSpace(s))
+	maxDistance := len(s)/3 + 1
+	type match struct {
+		name     string
+		distance int
+	}
+	var matches []match
+	for _, r := range Locations() {
+		lower := strings.ToLower(r.Name)
+		d := editDistance(s, lower)
+		if strings.Contains(lower, s) && len(s) > 2 {
+			d = 0
+		}
+		if d <= maxDistance {
+			matches = append(matches, match{r.Name, d})
+		}
+	}
+	sort.SliceStable(matches, func(i, j int) bool { return matches[i].distance < matches[j].distance })
+	for i := 0; i < len(matches) && i < n; i++ {
+		names = append(names, matches[i].name)
+	}
+	return
+}
+
+// editDistance returns the Levenshtein distance between a and b.
+func editDistance(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	prev := make([]int, len(rb)+1)
+	cur := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(ra); i++ {
+		cur[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			cur[j] = min(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+		}
+		prev, cur = cur, prev
+	}
+	return prev[len(rb)]
+}
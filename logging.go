@@ -0,0 +1,65 @@
+package goroyale
+
+import (
+	"context"
+	"log/slog"
+	"time"
+)
+
+// minLoggedWait is the shortest ratelimit wait worth logging, anything less is just scheduling noise.
+const minLoggedWait = 10 * time.Millisecond
+
+// WithLogger sets Client.Logger.
+func WithLogger(logger *slog.Logger) Option {
+	return func(c *Client) {
+		c.Logger = logger
+	}
+}
+
+// WithSlowRequestThreshold sets Client.SlowRequestThreshold.
+func WithSlowRequestThreshold(d time.Duration) Option {
+	return func(c *Client) {
+		c.SlowRequestThreshold = d
+	}
+}
+
+// logAttrs are the attributes every log record for a request to path starts with.
+func (c *Client) logAttrs(ctx context.Context, path string, attrs ...slog.Attr) []slog.Attr {
+	base := []slog.Attr{slog.String("endpoint", endpointOf(path)), slog.String("path", path)}
+	if c.Label != "" {
+		base = append(base, slog.String("label", c.Label))
+	}
+	if label := RequestLabel(ctx); label != "" {
+		base = append(base, slog.String("request_label", label))
+	}
+	return append(base, attrs...)
+}
+
+func (c *Client) logDelayed(ctx context.Context, path string, wait time.Duration) {
+	if c.Logger == nil || wait < minLoggedWait {
+		return
+	}
+	c.Logger.LogAttrs(ctx, slog.LevelInfo, "goroyale: request delayed by ratelimit", c.logAttrs(ctx, path, slog.Duration("wait", wait))...)
+}
+
+func (c *Client) logRetry(ctx context.Context, path string, attempt int, backoff time.Duration, err error) {
+	if c.Logger == nil {
+		return
+	}
+	c.Logger.LogAttrs(ctx, slog.LevelWarn, "goroyale: retrying request", c.logAttrs(ctx, path,
+		slog.Int("attempt", attempt),
+		slog.Duration("backoff", backoff),
+		slog.String("error", err.Error()),
+	)...)
+}
+
+func (c *Client) logSlow(ctx context.Context, path string, statusCode int, duration time.Duration) {
+	if c.Logger == nil || c.SlowRequestThreshold <= 0 || duration < c.SlowRequestThreshold {
+		return
+	}
+	c.Logger.LogAttrs(ctx, slog.LevelWarn, "goroyale: slow request", c.logAttrs(ctx, path,
+		slog.Int("status", statusCode),
+		slog.Duration("duration", duration),
+		slog.Duration("threshold", c.SlowRequestThreshold),
+	)...)
+}
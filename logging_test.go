@@ -0,0 +1,87 @@
+package goroyale
+
+import (
+	"bytes"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// lockedBuffer is a bytes.Buffer that's safe to log to from many requests at once.
+type lockedBuffer struct {
+	mu  sync.Mutex
+	buf bytes.Buffer
+}
+
+func (b *lockedBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.Write(p)
+}
+
+func (b *lockedBuffer) String() string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.String()
+}
+
+func TestLogDelayedOnlyCountsTheRatelimit(t *testing.T) {
+	started := make(chan struct{}, 2)
+	release := make(chan struct{})
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		started <- struct{}{}
+		if r.URL.Path == "/player/2PP" {
+			<-release
+		}
+		w.Write([]byte(`{"tag":"2PP"}`))
+	}))
+	defer srv.Close()
+	var logs lockedBuffer
+	c, err := New("token", WithBaseURL(srv.URL), WithLogger(slog.New(slog.NewTextHandler(&logs, nil))))
+	if err != nil {
+		t.Fatal(err)
+	}
+	c.SetConcurrencyLimit("/player", 1)
+	for i := 0; i < cap(c.rateBucket); i++ {
+		c.refill()
+	}
+
+	// the second request waits on the concurrency limit, which isn't the ratelimit
+	var wg sync.WaitGroup
+	for _, tag := range []string{"2PP", "8L9L9GL"} {
+		wg.Add(1)
+		go func(tag string) {
+			defer wg.Done()
+			c.Player(tag, nil)
+		}(tag)
+		if tag == "2PP" {
+			<-started
+		}
+	}
+	time.Sleep(50 * time.Millisecond)
+	close(release)
+	wg.Wait()
+	if strings.Contains(logs.String(), "delayed by ratelimit") {
+		t.Errorf("waiting on the concurrency limit was logged as a ratelimit delay:\n%s", logs.String())
+	}
+
+	// with the rateBucket empty the request waits on the ratelimit
+	for len(c.rateBucket) > 0 {
+		<-c.rateBucket
+	}
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		c.Player("8L9L9GL", nil)
+	}()
+	time.Sleep(50 * time.Millisecond)
+	c.refill()
+	<-done
+	if out := logs.String(); !strings.Contains(out, "delayed by ratelimit") || !strings.Contains(out, "endpoint=/player") {
+		t.Errorf("the ratelimit wait wasn't logged:\n%s", out)
+	}
+}
@@ -0,0 +1,178 @@
+package goroyale
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// WinConditions are the keys of cards treated as a deck's win condition.
+// It's a var so you can keep it up to date with the meta.
+var WinConditions = map[string]bool{
+	"balloon":          true,
+	"battle-ram":       true,
+	"giant":            true,
+	"giant-skeleton":   true,
+	"goblin-barrel":    true,
+	"golem":            true,
+	"graveyard":        true,
+	"hog-rider":        true,
+	"lava-hound":       true,
+	"miner":            true,
+	"mortar":           true,
+	"ram-rider":        true,
+	"royal-giant":      true,
+	"three-musketeers": true,
+	"x-bow":            true,
+}
+
+// MetaDeck is one deck composition within a MetaReport.
+type MetaDeck struct {
+	Key           string // the deck's card keys, sorted and joined by commas
+	Hash          string // see DeckHash
+	Cards         []PopularDeckCard
+	DeckLink      string
+	Popularity    int     // combined popularity of every deck with these cards
+	Share         float64 // Popularity out of the popularity of all decks in the report, 0-1
+	AverageElixir float64
+	WinConditions []string // keys of the deck's win conditions
+}
+
+// WinConditionUsage is how much a win condition shows up in a MetaReport.
+type WinConditionUsage struct {
+	Key   string
+	Name  string
+	Decks int     // number of compositions it's in
+	Share float64 // combined Share of the decks it's in, 0-1
+}
+
+// MetaReport summarizes PopularDecks.
+type MetaReport struct {
+	TotalPopularity int
+	AverageElixir   float64 // average of every deck, weighted by popularity
+	Decks           []MetaDeck
+	WinConditions   []WinConditionUsage
+}
+
+// NewMetaReport groups decks that have the same cards and summarizes them.
+// Decks and WinConditions are sorted by Share, most used first.
+func NewMetaReport(decks []PopularDeck) (report MetaReport) {
+	byHash := make(map[string]*MetaDeck)
+	var order []string
+	for _, d := range decks {
+		hash := d.Hash()
+
+		md, ok := byHash[hash]
+		if !ok {
+			keys := make([]string, len(d.Cards))
+			for i, card := range d.Cards {
+				keys[i] = card.Key
+			}
+			md = &MetaDeck{Key: deckKey(keys), Hash: hash, Cards: d.Cards, DeckLink: d.DeckLink}
+			var elixir int
+			for _, card := range d.Cards {
+				elixir += card.Elixir
+				if WinConditions[card.Key] {
+					md.WinConditions = append(md.WinConditions, card.Key)
+				}
+			}
+			if len(d.Cards) > 0 {
+				md.AverageElixir = float64(elixir) / float64(len(d.Cards))
+			}
+			sort.Strings(md.WinConditions)
+			byHash[hash] = md
+			order = append(order, hash)
+		}
+		md.Popularity += d.Popularity.Hits.Int()
+		report.TotalPopularity += d.Popularity.Hits.Int()
+	}
+
+	winConditions := make(map[string]*WinConditionUsage)
+	var weightedElixir float64
+	for _, key := range order {
+		md := byHash[key]
+		if report.TotalPopularity > 0 {
+			md.Share = float64(md.Popularity) / float64(report.TotalPopularity)
+		}
+		weightedElixir += md.AverageElixir * float64(md.Popularity)
+
+		for _, wc := range md.WinConditions {
+			usage, ok := winConditions[wc]
+			if !ok {
+				usage = &WinConditionUsage{Key: wc}
+				for _, card := range md.Cards {
+					if card.Key == wc {
+						usage.Name = card.Name
+					}
+				}
+				winConditions[wc] = usage
+			}
+			usage.Decks++
+			usage.Share += md.Share
+		}
+		report.Decks = append(report.Decks, *md)
+	}
+	if report.TotalPopularity > 0 {
+		report.AverageElixir = weightedElixir / float64(report.TotalPopularity)
+	}
+	for _, usage := range winConditions {
+		report.WinConditions = append(report.WinConditions, *usage)
+	}
+
+	sort.SliceStable(report.Decks, func(i, j int) bool {
+		return report.Decks[i].Share > report.Decks[j].Share
+	})
+	sort.Slice(report.WinConditions, func(i, j int) bool {
+		a, b := report.WinConditions[i], report.WinConditions[j]
+		if a.Share != b.Share {
+			return a.Share > b.Share
+		}
+		return a.Key < b.Key
+	})
+	return
+}
+
+func (d MetaDeck) cardNames() string {
+	names := make([]string, len(d.Cards))
+	for i, card := range d.Cards {
+		names[i] = card.Name
+	}
+	return strings.Join(names, ", ")
+}
+
+// String renders the report as plain text.
+func (r MetaReport) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%d decks, average elixir %.1f\n", len(r.Decks), r.AverageElixir)
+	for i, d := range r.Decks {
+		fmt.Fprintf(&b, "%d. %5.1f%% %.1f elixir: %s\n", i+1, d.Share*100, d.AverageElixir, d.cardNames())
+	}
+	if len(r.WinConditions) > 0 {
+		b.WriteString("Win conditions:\n")
+		for _, wc := range r.WinConditions {
+			fmt.Fprintf(&b, "%s %.1f%% (%d decks)\n", wc.Name, wc.Share*100, wc.Decks)
+		}
+	}
+	return b.String()
+}
+
+// Markdown renders the report as markdown tables.
+func (r MetaReport) Markdown() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "**%d decks, average elixir %.1f**\n\n", len(r.Decks), r.AverageElixir)
+	b.WriteString("| # | Share | Elixir | Cards |\n|---|---|---|---|\n")
+	for i, d := range r.Decks {
+		cards := d.cardNames()
+		if d.DeckLink != "" {
+			cards = "[" + cards + "](" + d.DeckLink + ")"
+		}
+		fmt.Fprintf(&b, "| %d | %.1f%% | %.1f | %s |\n", i+1, d.Share*100, d.AverageElixir, cards)
+	}
+	if len(r.WinConditions) > 0 {
+		b.WriteString("\n| Win condition | Share | Decks |\n|---|---|---|\n")
+		for _, wc := range r.WinConditions {
+			fmt.Fprintf(&b, "| %s | %.1f%% | %d |\n", wc.Name, wc.Share*100, wc.Decks)
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,220 @@
+package goroyale
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Event is something a watcher noticed, ex: a new battle or a change in trophies.
+type Event struct {
+	Type    string      `json:"type"`
+	Tag     string      `json:"tag"` // tag of the player or clan the event is about
+	Time    time.Time   `json:"time"`
+	Summary string      `json:"summary"` // human readable description
+	Data    interface{} `json:"data,omitempty"`
+}
+
+// WebhookFormat is the shape of the JSON sent to a webhook.
+type WebhookFormat int
+
+// Webhook formats.
+// WebhookGeneric sends the Event as JSON, the others send Event.Summary the way the service expects it.
+const (
+	WebhookGeneric WebhookFormat = iota
+	WebhookDiscord
+	WebhookSlack
+)
+
+var webhookFormats = []string{WebhookGeneric: "generic", WebhookDiscord: "discord", WebhookSlack: "slack"}
+
+// String returns the name of the format, ex: "discord".
+func (f WebhookFormat) String() string {
+	if f >= 0 && int(f) < len(webhookFormats) {
+		return webhookFormats[f]
+	}
+	return "WebhookFormat(" + strconv.Itoa(int(f)) + ")"
+}
+
+// MarshalText writes the name of the format.
+func (f WebhookFormat) MarshalText() ([]byte, error) {
+	if f < 0 || int(f) >= len(webhookFormats) {
+		return nil, fmt.Errorf("unknown webhook format %d", int(f))
+	}
+	return []byte(webhookFormats[f]), nil
+}
+
+// UnmarshalText reads the name of a format, "" is WebhookGeneric.
+func (f *WebhookFormat) UnmarshalText(b []byte) error {
+	name := strings.ToLower(string(b))
+	if name == "" {
+		*f = WebhookGeneric
+		return nil
+	}
+	for i, format := range webhookFormats {
+		if name == format {
+			*f = WebhookFormat(i)
+			return nil
+		}
+	}
+	return fmt.Errorf("unknown webhook format %q, use one of %s", b, strings.Join(webhookFormats, ", "))
+}
+
+// SignatureHeader is the header webhook signatures are sent in.
+// Its value is "sha256=" followed by the hex encoded HMAC-SHA256 of the body.
+const SignatureHeader = "X-Goroyale-Signature"
+
+// Webhook is a URL events get POSTed to.
+type Webhook struct {
+	URL    string
+	Format WebhookFormat
+	// Secret signs requests in the SignatureHeader if it's set.
+	Secret string
+	// Types limits which Event.Type values get sent, all events are sent if it's empty.
+	Types []string
+}
+
+func (w Webhook) wants(e Event) bool {
+	if len(w.Types) == 0 {
+		return true
+	}
+	for _, t := range w.Types {
+		if t == e.Type {
+			return true
+		}
+	}
+	return false
+}
+
+func (w Webhook) body(e Event) ([]byte, error) {
+	switch w.Format {
+	case WebhookDiscord:
+		return json.Marshal(map[string]string{"content": e.Summary})
+	case WebhookSlack:
+		return json.Marshal(map[string]string{"text": e.Summary})
+	}
+	return json.Marshal(e)
+}
+
+// Notifier POSTs events to webhooks.
+type Notifier struct {
+	Webhooks []Webhook
+	// HTTPClient is used to send requests, http.DefaultClient is used if it is nil.
+	HTTPClient *http.Client
+	// Attempts is how many times a webhook is tried before giving up, 3 if it is 0.
+	Attempts int
+	// Backoff is the wait before the first retry, it doubles after every attempt. 1 second if it is 0.
+	Backoff time.Duration
+}
+
+// Notify sends e to every webhook that wants it.
+// Webhooks are tried one after another, errors from all of them are joined together.
+func (n *Notifier) Notify(ctx context.Context, e Event) error {
+	var errs []error
+	for _, w := range n.Webhooks {
+		if !w.wants(e) {
+			continue
+		}
+		if err := n.send(ctx, w, e); err != nil {
+			errs = append(errs, fmt.Errorf("webhook %s: %w", w.URL, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// Run sends every event from events until it's closed or ctx is done.
+// Failed notifications are passed to onError if it isn't nil.
+func (n *Notifier) Run(ctx context.Context, events <-chan Event, onError func(Event, error)) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case e, ok := <-events:
+			if !ok {
+				return
+			}
+			if err := n.Notify(ctx, e); err != nil && onError != nil {
+				onError(e, err)
+			}
+		}
+	}
+}
+
+func (n *Notifier) send(ctx context.Context, w Webhook, e Event) error {
+	body, err := w.body(e)
+	if err != nil {
+		return err
+	}
+	client := n.HTTPClient
+	if client == nil {
+		client = http.DefaultClient
+	}
+	attempts := n.Attempts
+	if attempts <= 0 {
+		attempts = 3
+	}
+	backoff := n.Backoff
+	if backoff <= 0 {
+		backoff = time.Second
+	}
+
+	for attempt := 1; ; attempt++ {
+		wait, err := n.post(ctx, client, w, body)
+		if err == nil || attempt >= attempts || wait < 0 {
+			return err
+		}
+		if wait == 0 {
+			wait = backoff
+		}
+		backoff *= 2
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(wait):
+		}
+	}
+}
+
+// post sends body once. wait is negative if the error shouldn't be retried,
+// and positive if the webhook asked to wait a certain amount before retrying.
+func (n *Notifier) post(ctx context.Context, client *http.Client, w Webhook, body []byte) (wait time.Duration, err error) {
+	req, err := http.NewRequestWithContext(ctx, "POST", w.URL, bytes.NewReader(body))
+	if err != nil {
+		return -1, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if w.Secret != "" {
+		mac := hmac.New(sha256.New, []byte(w.Secret))
+		mac.Write(body)
+		req.Header.Set(SignatureHeader, "sha256="+hex.EncodeToString(mac.Sum(nil)))
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	resp.Body.Close()
+
+	switch {
+	case resp.StatusCode < 300:
+		return 0, nil
+	case resp.StatusCode == http.StatusTooManyRequests:
+		if sec, err := strconv.Atoi(resp.Header.Get("Retry-After")); err == nil {
+			wait = time.Duration(sec) * time.Second
+		}
+	case resp.StatusCode < 500:
+		// the webhook won't accept it no matter how many times it's sent
+		wait = -1
+	}
+	return wait, errors.New(resp.Status)
+}
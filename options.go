@@ -0,0 +1,81 @@
+package goroyale
+
+import (
+	"net/http"
+	"time"
+)
+
+// Option configures a Client made with New.
+type Option func(*Client)
+
+// WithTimeout sets the request timeout, 0 keeps DefaultTimeout.
+func WithTimeout(timeout time.Duration) Option {
+	return func(c *Client) {
+		if timeout != 0 {
+			c.client.Timeout = timeout
+		}
+	}
+}
+
+// WithHTTPClient makes requests with a copy of hc, including its timeout and transport.
+func WithHTTPClient(hc *http.Client) Option {
+	return func(c *Client) {
+		c.client = *hc
+	}
+}
+
+// WithTransport is the same as calling SetTransport.
+func WithTransport(rt http.RoundTripper) Option {
+	return func(c *Client) {
+		c.SetTransport(rt)
+	}
+}
+
+// WithBaseURL is the same as calling SetBaseURL.
+func WithBaseURL(url string) Option {
+	return func(c *Client) {
+		c.SetBaseURL(url)
+	}
+}
+
+// WithRetry is the same as calling SetRetryPolicy.
+func WithRetry(p RetryPolicy) Option {
+	return func(c *Client) {
+		c.SetRetryPolicy(p)
+	}
+}
+
+// WithCache is the same as calling SetCache.
+func WithCache(cache Cache, ttl time.Duration) Option {
+	return func(c *Client) {
+		c.SetCache(cache, ttl)
+	}
+}
+
+// WithCodec sets Client.Codec.
+func WithCodec(codec Codec) Option {
+	return func(c *Client) {
+		c.Codec = codec
+	}
+}
+
+// WithClock sets Client.Clock.
+func WithClock(clock Clock) Option {
+	return func(c *Client) {
+		c.Clock = clock
+	}
+}
+
+// WithLabel sets Client.Label.
+func WithLabel(label string) Option {
+	return func(c *Client) {
+		c.Label = label
+	}
+}
+
+// WithMaxResponseBytes sets Client.MaxResponseBytes.
+func WithMaxResponseBytes(n int64) Option {
+	return func(c *Client) {
+		c.MaxResponseBytes = n
+	}
+}
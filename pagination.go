@@ -0,0 +1,19 @@
+package goroyale
+
+import (
+	"net/url"
+	"strconv"
+)
+
+// withPage returns a copy of params that asks for the page'th page of max results.
+// Pages start at 0.
+// https://docs.royaleapi.com/#/pagination
+func withPage(params url.Values, page, max int) url.Values {
+	p := url.Values{}
+	for k, v := range params {
+		p[k] = v
+	}
+	p.Set("max", strconv.Itoa(max))
+	p.Set("page", strconv.Itoa(page))
+	return p
+}
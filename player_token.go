@@ -0,0 +1,64 @@
+package goroyale
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+)
+
+// OfficialBaseURL is the base URL of Supercell's official Clash Royale API.
+// Some endpoints, like VerifyPlayerToken, only exist there.
+const OfficialBaseURL = "https://api.clashroyale.com/v1"
+
+// Statuses of a PlayerTokenVerification.
+const (
+	PlayerTokenOK      = "ok"
+	PlayerTokenInvalid = "invalid"
+)
+
+// PlayerTokenVerification is the official API's answer to VerifyPlayerToken.
+type PlayerTokenVerification struct {
+	Tag    string `json:"tag"`
+	Token  string `json:"token"`
+	Status string `json:"status"` // PlayerTokenOK or PlayerTokenInvalid
+}
+
+// Valid reports whether the token belonged to the player.
+func (v PlayerTokenVerification) Valid() bool {
+	return v.Status == PlayerTokenOK
+}
+
+// VerifyPlayerToken checks a player's API token, found in the game's settings, against their tag.
+// A valid token proves the person who gave it to you owns the account, ex: when linking a Discord account to a tag.
+// Tokens only work once and expire quickly, so verify them as soon as you get them.
+//
+// This endpoint is only on the official API, the client must be made with an official API key
+// and WithBaseURL(OfficialBaseURL).
+// It is a POST, so it is never retried.
+// https://developer.clashroyale.com/#/documentation
+func (c *Client) VerifyPlayerToken(ctx context.Context, tag, token string) (verification PlayerTokenVerification, err error) {
+	body, err := json.Marshal(map[string]string{"token": token})
+	if err != nil {
+		return
+	}
+	header := http.Header{}
+	header.Set("Authorization", "Bearer "+c.Token)
+	header.Set("Content-Type", "application/json")
+
+	buf := bufferPool.Get().(*bytes.Buffer)
+	buf.Reset()
+	defer bufferPool.Put(buf)
+
+	path := "/players/%23" + NormalizeTag(tag) + "/verifytoken"
+	resp, err := c.sendRequest(ctx, http.MethodPost, path, nil, body, header, buf)
+	if err != nil {
+		return
+	}
+	if resp.StatusCode != 200 {
+		err = apiError(resp, buf.Bytes())
+		return
+	}
+	err = c.unmarshal(buf.Bytes(), &verification)
+	return
+}
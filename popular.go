@@ -0,0 +1,78 @@
+package goroyale
+
+import (
+	"bytes"
+	"encoding/json"
+	"sort"
+	"strings"
+)
+
+// UnmarshalJSON accepts the {"hits": ..., "hitsPerDayAvg": ...} object most popular endpoints send
+// as well as the bare number of hits the popular decks endpoint sends.
+func (p *Popularity) UnmarshalJSON(b []byte) error {
+	*p = Popularity{}
+	b = bytes.TrimSpace(b)
+	if len(b) == 0 || b[0] != '{' {
+		return p.Hits.UnmarshalJSON(b)
+	}
+	// the alias has no UnmarshalJSON to recurse into
+	type popularity Popularity
+	return json.Unmarshal(b, (*popularity)(p))
+}
+
+// PopularItem is anything returned by the popular endpoints,
+// so one display can list PopularClans, PopularPlayers, PopularTournaments, and PopularDecks.
+type PopularItem interface {
+	ItemKey() string  // the tag, or DeckHash for decks
+	ItemName() string // the name, or the card names for decks
+	ItemPopularity() Popularity
+}
+
+// ItemKey returns the clan's tag.
+func (c PopularClan) ItemKey() string { return c.Tag }
+
+// ItemName returns the clan's name.
+func (c PopularClan) ItemName() string { return c.Name }
+
+// ItemPopularity returns c.Popularity.
+func (c PopularClan) ItemPopularity() Popularity { return c.Popularity }
+
+// ItemKey returns the player's tag.
+func (p PopularPlayer) ItemKey() string { return p.Tag }
+
+// ItemName returns the player's name.
+func (p PopularPlayer) ItemName() string { return p.Name }
+
+// ItemPopularity returns p.Popularity.
+func (p PopularPlayer) ItemPopularity() Popularity { return p.Popularity }
+
+// ItemKey returns the tournament's tag.
+func (t PopularTournament) ItemKey() string { return t.Tag }
+
+// ItemName returns the tournament's name.
+func (t PopularTournament) ItemName() string { return t.Name }
+
+// ItemPopularity returns t.Popularity.
+func (t PopularTournament) ItemPopularity() Popularity { return t.Popularity }
+
+// ItemKey returns the deck's Hash.
+func (d PopularDeck) ItemKey() string { return d.Hash() }
+
+// ItemName returns the names of the deck's cards, separated by commas.
+func (d PopularDeck) ItemName() string {
+	names := make([]string, len(d.Cards))
+	for i, card := range d.Cards {
+		names[i] = card.Name
+	}
+	return strings.Join(names, ", ")
+}
+
+// ItemPopularity returns d.Popularity.
+func (d PopularDeck) ItemPopularity() Popularity { return d.Popularity }
+
+// SortPopular sorts items by hits, most popular first.
+func SortPopular[T PopularItem](items []T) {
+	sort.SliceStable(items, func(i, j int) bool {
+		return items[i].ItemPopularity().Hits.Value > items[j].ItemPopularity().Hits.Value
+	})
+}
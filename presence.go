@@ -0,0 +1,126 @@
+package goroyale
+
+import (
+	"encoding/json"
+	"net/url"
+	"sort"
+	"strings"
+)
+
+// Presence is the set of fields that were in a JSON object, by their JSON path, ex: "trophies" or "clan.tag".
+// Responses requested with the keys or exclude params leave fields out, which decode to the same zero value
+// as a field that really is 0 or "". Presence tells the two apart.
+// Paths don't go into arrays, the elements of "members" have their own Presence in ClanFields.
+type Presence map[string]bool
+
+// Has reports whether the field at the JSON path was in the response.
+func (p Presence) Has(path string) bool {
+	return p[path]
+}
+
+// Paths returns every path in p, sorted.
+func (p Presence) Paths() []string {
+	paths := make([]string, 0, len(p))
+	for path := range p {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+	return paths
+}
+
+// presenceOf returns the Presence of a JSON object. data that isn't an object has no fields.
+func presenceOf(data []byte) Presence {
+	p := make(Presence)
+	p.add("", data)
+	return p
+}
+
+func (p Presence) add(prefix string, data []byte) {
+	var fields map[string]json.RawMessage
+	if json.Unmarshal(data, &fields) != nil {
+		return
+	}
+	for key, raw := range fields {
+		path := key
+		if prefix != "" {
+			path = prefix + "." + key
+		}
+		p[path] = true
+		if trimmed := strings.TrimSpace(string(raw)); strings.HasPrefix(trimmed, "{") {
+			p.add(path, raw)
+		}
+	}
+}
+
+// PlayerFields is a Player along with which of its fields were in the response.
+type PlayerFields struct {
+	Player
+
+	Present Presence `json:"-"`
+}
+
+// UnmarshalJSON decodes the Player and records which fields were present.
+func (p *PlayerFields) UnmarshalJSON(data []byte) error {
+	if err := json.Unmarshal(data, &p.Player); err != nil {
+		return err
+	}
+	p.Present = presenceOf(data)
+	return nil
+}
+
+// ClanMemberFields is a ClanMember along with which of its fields were in the response.
+type ClanMemberFields struct {
+	ClanMember
+
+	Present Presence `json:"-"`
+}
+
+// UnmarshalJSON decodes the ClanMember and records which fields were present.
+func (m *ClanMemberFields) UnmarshalJSON(data []byte) error {
+	if err := json.Unmarshal(data, &m.ClanMember); err != nil {
+		return err
+	}
+	m.Present = presenceOf(data)
+	return nil
+}
+
+// ClanFields is a Clan along with which of its fields, and which fields of each member, were in the response.
+// Members has the same members as Clan.Members.
+type ClanFields struct {
+	Clan
+
+	Present Presence           `json:"-"`
+	Members []ClanMemberFields `json:"-"`
+}
+
+// UnmarshalJSON decodes the Clan and records which fields were present.
+func (c *ClanFields) UnmarshalJSON(data []byte) error {
+	if err := json.Unmarshal(data, &c.Clan); err != nil {
+		return err
+	}
+	c.Present = presenceOf(data)
+	var members struct {
+		Members []ClanMemberFields
+	}
+	if err := json.Unmarshal(data, &members); err != nil {
+		return err
+	}
+	c.Members = members.Members
+	return nil
+}
+
+// PlayerFields works like Player but also returns which fields were in the response,
+// use it with the keys or exclude params.
+func (c *Client) PlayerFields(tag string, params url.Values) (player PlayerFields, err error) {
+	path := "/player/" + tag
+	err = c.getJSON(path, params, &player)
+	return
+}
+
+// ClanFields works like Clan but also returns which fields were in the response,
+// use it with the keys or exclude params.
+func (c *Client) ClanFields(tag string, params url.Values) (clan ClanFields, err error) {
+	path := "/clan/" + tag
+	err = c.getJSON(path, params, &clan)
+	return
+}
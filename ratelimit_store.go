@@ -0,0 +1,155 @@
+package goroyale
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+// RateLimitState is the ratelimit window as last reported by the API.
+type RateLimitState struct {
+	Limit     int
+	Remaining int
+	Reset     time.Time // when requests become available again, zero if Remaining never hit 0
+	UpdatedAt time.Time
+}
+
+// RateLimitStore persists RateLimitState so a restarted Client knows how much of its quota is left.
+type RateLimitStore interface {
+	LoadRateLimit() (RateLimitState, error)
+	SaveRateLimit(state RateLimitState) error
+}
+
+// FileRateLimitStore is a RateLimitStore that keeps the state as JSON in a file.
+type FileRateLimitStore struct {
+	Path string
+}
+
+// LoadRateLimit reads the state from the file.
+// A missing file is not an error, it just returns an empty state.
+func (f FileRateLimitStore) LoadRateLimit() (state RateLimitState, err error) {
+	b, err := ioutil.ReadFile(f.Path)
+	if os.IsNotExist(err) {
+		return state, nil
+	}
+	if err != nil {
+		return
+	}
+	err = json.Unmarshal(b, &state)
+	return
+}
+
+// SaveRateLimit writes the state to the file.
+// It writes to a temporary file first so a crash can't leave half written JSON.
+func (f FileRateLimitStore) SaveRateLimit(state RateLimitState) error {
+	b, err := json.Marshal(state)
+	if err != nil {
+		return err
+	}
+	return writeFileAtomic(f.Path, b)
+}
+
+// writeFileAtomic writes b to a temporary file next to path and renames it over path,
+// so path never holds a partial write.
+func writeFileAtomic(path string, b []byte) error {
+	tmp, err := ioutil.TempFile(filepath.Dir(path), filepath.Base(path)+".tmp")
+	if err != nil {
+		return err
+	}
+	if _, err = tmp.Write(b); err == nil {
+		err = tmp.Close()
+	} else {
+		tmp.Close()
+	}
+	if err != nil {
+		os.Remove(tmp.Name())
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
+
+// rateLimitSaver saves RateLimitStates one at a time from a goroutine that's running while there's something to save.
+// A state handed over while a save is running replaces any other waiting, so saves never land out of order.
+type rateLimitSaver struct {
+	store RateLimitStore
+
+	mu      sync.Mutex
+	pending *RateLimitState
+	running bool
+	idle    chan struct{} // closed when the goroutine stops, nil while none has run
+}
+
+// save saves state once the saves before it are done, unless a newer state comes first.
+func (s *rateLimitSaver) save(state RateLimitState) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.pending = &state
+	if !s.running {
+		s.running = true
+		s.idle = make(chan struct{})
+		go s.run(s.idle)
+	}
+}
+
+func (s *rateLimitSaver) run(idle chan struct{}) {
+	for {
+		s.mu.Lock()
+		state := s.pending
+		s.pending = nil
+		if state == nil {
+			s.running = false
+			close(idle)
+			s.mu.Unlock()
+			return
+		}
+		s.mu.Unlock()
+		// losing a save only means a restart is less accurate
+		s.store.SaveRateLimit(*state)
+	}
+}
+
+// wait returns once everything handed to save has been saved.
+func (s *rateLimitSaver) wait() {
+	s.mu.Lock()
+	idle := s.idle
+	s.mu.Unlock()
+	if idle != nil {
+		<-idle
+	}
+}
+
+// SetRateLimitStore loads the saved ratelimit state from store and saves to it after responses.
+// Saves happen in the background so a slow store doesn't hold up requests, and only the latest state is saved
+// when responses come in faster than the store can keep up. Shutdown and Close wait for the last save.
+// If the saved state says the quota is used up the Client will wait until its reset time before sending a request.
+// It should be called before the Client is used.
+func (c *Client) SetRateLimitStore(store RateLimitStore) error {
+	state, err := store.LoadRateLimit()
+	if err != nil {
+		return err
+	}
+
+	c.usage.mu.Lock()
+	c.usage.saver = &rateLimitSaver{store: store}
+	c.usage.mu.Unlock()
+
+	now := c.now()
+	if state.UpdatedAt.IsZero() {
+		return nil
+	}
+	c.usage.observe(state.Limit, state.Remaining, true, state.Reset, state.UpdatedAt)
+
+	if state.Remaining > 0 || !state.Reset.After(now) {
+		return nil
+	}
+	// take away the initial request and give it back once the saved window resets
+	select {
+	case <-c.rateBucket:
+		c.after(state.Reset.Sub(now), c.refill)
+	default:
+	}
+	return nil
+}
@@ -0,0 +1,116 @@
+package goroyale
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+)
+
+// slowRateLimitStore is a RateLimitStore whose saves wait for release.
+type slowRateLimitStore struct {
+	release chan struct{}
+
+	mu      sync.Mutex
+	saves   int
+	saving  int // saves running at once
+	overlap bool
+	last    RateLimitState
+}
+
+func (s *slowRateLimitStore) LoadRateLimit() (RateLimitState, error) {
+	return RateLimitState{}, nil
+}
+
+func (s *slowRateLimitStore) SaveRateLimit(state RateLimitState) error {
+	s.mu.Lock()
+	s.saving++
+	s.overlap = s.overlap || s.saving > 1
+	s.mu.Unlock()
+	<-s.release
+	s.mu.Lock()
+	s.saving--
+	s.saves++
+	s.last = state
+	s.mu.Unlock()
+	return nil
+}
+
+func TestConcurrentObserveSavesLatest(t *testing.T) {
+	store := &slowRateLimitStore{release: make(chan struct{})}
+	u := &usageTracker{saver: &rateLimitSaver{store: store}}
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	// every response is observed without waiting on the store, which is stuck on the first save
+	var wg sync.WaitGroup
+	for i := 1; i <= 100; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			u.observe(100, 100-i, true, time.Time{}, start.Add(time.Duration(i)*time.Second))
+		}(i)
+	}
+	observed := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(observed)
+	}()
+	select {
+	case <-observed:
+	case <-time.After(5 * time.Second):
+		t.Fatal("observe waited on the store")
+	}
+
+	close(store.release)
+	u.saver.wait()
+	u.mu.Lock()
+	want := RateLimitState{Limit: u.limit, Remaining: u.remaining, Reset: u.reset, UpdatedAt: u.observedAt}
+	u.mu.Unlock()
+	store.mu.Lock()
+	defer store.mu.Unlock()
+	if store.last != want {
+		t.Errorf("the last save was %+v, want the latest state %+v", store.last, want)
+	}
+	if store.saves > 2 {
+		t.Errorf("100 responses during a slow save made %d saves, want the waiting ones coalesced into 1", store.saves)
+	}
+	if store.overlap {
+		t.Error("saves ran at the same time")
+	}
+}
+
+func TestShutdownSavesRateLimit(t *testing.T) {
+	remaining := 10
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		remaining--
+		w.Header().Set("x-ratelimit-limit", "10")
+		w.Header().Set("x-ratelimit-remaining", strconv.Itoa(remaining))
+		w.Write([]byte(`{}`))
+	}))
+	defer srv.Close()
+	c, err := New("token", WithBaseURL(srv.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+	store := &slowRateLimitStore{release: make(chan struct{})}
+	close(store.release)
+	if err := c.SetRateLimitStore(store); err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 3; i++ {
+		if _, err := c.Player("2PP", nil); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := c.Shutdown(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	store.mu.Lock()
+	defer store.mu.Unlock()
+	if store.last.Limit != 10 || store.last.Remaining != 7 {
+		t.Errorf("the state saved by the time Shutdown returned is %+v, want 7 of 10 remaining", store.last)
+	}
+}
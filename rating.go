@@ -0,0 +1,144 @@
+package goroyale
+
+import (
+	"math"
+	"sort"
+	"sync"
+)
+
+// DefaultRatingK is how far a single battle moves ratings in a RatingTable with no K set.
+const DefaultRatingK = 32
+
+// DefaultRating is the rating of a player a RatingTable hasn't seen or been seeded with.
+const DefaultRating = 1500
+
+// RatingTable keeps Elo ratings for players, worked out from the battles they played against each other.
+// Ratings can be seeded from trophies, which already behave roughly like an Elo rating,
+// so players with few battles in the table still get a sensible estimate.
+// The zero value is ready to use.
+type RatingTable struct {
+	K float64 // DefaultRatingK if it is 0
+
+	mu      sync.Mutex
+	ratings map[string]float64
+	seen    map[string]bool // battle keys already rated
+}
+
+// Rating returns tag's rating, DefaultRating if the table doesn't know them.
+func (t *RatingTable) Rating(tag string) float64 {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.rating(tag)
+}
+
+func (t *RatingTable) rating(tag string) float64 {
+	if r, ok := t.ratings[tag]; ok {
+		return r
+	}
+	return DefaultRating
+}
+
+// Seed sets the starting rating of a player the table hasn't rated yet, usually their trophies.
+// It does nothing if the player already has a rating.
+func (t *RatingTable) Seed(tag string, rating float64) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.ratings == nil {
+		t.ratings = make(map[string]float64)
+	}
+	if _, ok := t.ratings[tag]; !ok {
+		t.ratings[tag] = rating
+	}
+}
+
+// AddBattles rates battles oldest first.
+// Battles the table has already rated are skipped so overlapping battle logs can be added safely.
+// Players are seeded with the trophies they started the battle with if they have no rating yet.
+func (t *RatingTable) AddBattles(battles []Battle) {
+	sorted := append([]Battle(nil), battles...)
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].UTCTime < sorted[j].UTCTime })
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.ratings == nil {
+		t.ratings = make(map[string]float64)
+	}
+	if t.seen == nil {
+		t.seen = make(map[string]bool)
+	}
+	for _, b := range sorted {
+		if len(b.Team) == 0 || len(b.Opponent) == 0 {
+			continue
+		}
+		key := BattleKey(b)
+		if t.seen[key] {
+			continue
+		}
+		t.seen[key] = true
+		t.rate(b)
+	}
+}
+
+func (t *RatingTable) teamRating(team []TeamMember) float64 {
+	var total float64
+	for _, m := range team {
+		if _, ok := t.ratings[m.Tag]; !ok && m.StartTrophies > 0 {
+			t.ratings[m.Tag] = float64(m.StartTrophies)
+		}
+		total += t.rating(m.Tag)
+	}
+	return total / float64(len(team))
+}
+
+func (t *RatingTable) rate(b Battle) {
+	change := RatingChange(t.K, BattleScore(b), t.teamRating(b.Team), t.teamRating(b.Opponent))
+	for _, m := range b.Team {
+		t.ratings[m.Tag] = t.rating(m.Tag) + change
+	}
+	for _, m := range b.Opponent {
+		t.ratings[m.Tag] = t.rating(m.Tag) - change
+	}
+}
+
+// PredictOutcome returns the chance, 0-1, that player a beats player b.
+func (t *RatingTable) PredictOutcome(a, b string) float64 {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return ExpectedScore(t.rating(a), t.rating(b))
+}
+
+// ExpectedScore is the Elo expected score, 0-1, of a rating of a against b.
+func ExpectedScore(a, b float64) float64 {
+	return 1 / (1 + math.Pow(10, (b-a)/400))
+}
+
+// BattleScore is the team's score in b for an Elo update: 1 for a win, 0 for a loss, and 0.5 for a draw.
+func BattleScore(b Battle) float64 {
+	switch {
+	case b.Winner > 0:
+		return 1
+	case b.Winner < 0:
+		return 0
+	}
+	return 0.5
+}
+
+// RatingChange is how far a rating moves after scoring score, ex: from BattleScore, against a rating of opponent.
+// The opponent's rating moves the same amount the other way. k is DefaultRatingK if it is 0.
+// RatingTable rates battles with it, so other ladders can rate them the same way.
+func RatingChange(k, score, rating, opponent float64) float64 {
+	if k == 0 {
+		k = DefaultRatingK
+	}
+	return k * (score - ExpectedScore(rating, opponent))
+}
+
+// PredictMatchup estimates the chance, 0-1, that player a beats player b from each player's trophies and recent battles.
+func PredictMatchup(a Player, aBattles []Battle, b Player, bBattles []Battle) float64 {
+	var t RatingTable
+	t.AddBattles(append(append([]Battle(nil), aBattles...), bBattles...))
+	// only used if the player had no battles to go off of
+	t.Seed(a.Tag, float64(a.Trophies))
+	t.Seed(b.Tag, float64(b.Trophies))
+	return t.PredictOutcome(a.Tag, b.Tag)
+}
@@ -0,0 +1,326 @@
+// Package ratings runs an Elo ladder from battles, for communities that rank their own players
+// from friendly battles instead of trophies.
+//
+//	ladder := &ratings.Ladder{Store: ratings.FileStore{Path: "ladder.json"}}
+//	ladder.Load()
+//	sub := goroyale.Subscribe[goroyale.NewBattle](bus, goroyale.SubscribeOptions{})
+//	go ladder.Run(ctx, sub.C)
+package ratings
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"math"
+	"os"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/jegfish/goroyale"
+)
+
+// Player is a player's standing on a ladder.
+type Player struct {
+	Tag        string    `json:"tag"`
+	Name       string    `json:"name"`
+	Rating     float64   `json:"rating"` // without decay applied
+	Wins       int       `json:"wins"`
+	Losses     int       `json:"losses"`
+	Draws      int       `json:"draws"`
+	LastPlayed time.Time `json:"last_played"`
+}
+
+// Played returns the number of rated battles the player has played.
+func (p Player) Played() int {
+	return p.Wins + p.Losses + p.Draws
+}
+
+// Decay pulls the ratings of inactive players back towards the ladder's initial rating.
+type Decay struct {
+	After  time.Duration // how long a player can go without a rated battle before decay starts
+	PerDay float64       // rating points lost per day past After
+}
+
+// apply returns rating after decaying for the time between lastPlayed and now.
+func (d Decay) apply(rating, initial float64, lastPlayed, now time.Time) float64 {
+	if d.PerDay <= 0 || rating <= initial {
+		return rating
+	}
+	idle := now.Sub(lastPlayed) - d.After
+	if idle <= 0 {
+		return rating
+	}
+	return math.Max(initial, rating-d.PerDay*idle.Hours()/24)
+}
+
+// State is everything a Ladder needs to pick up where it left off.
+type State struct {
+	Players []Player `json:"players"`
+	Rated   []string `json:"rated"` // keys of the battles already rated
+}
+
+// Store persists a ladder's state between runs.
+type Store interface {
+	LoadLadder() (State, error)
+	SaveLadder(State) error
+}
+
+// FileStore is a Store that keeps the state in a JSON file.
+// A missing file is treated as an empty ladder.
+type FileStore struct {
+	Path string
+}
+
+// LoadLadder reads the state from f.Path.
+func (f FileStore) LoadLadder() (state State, err error) {
+	data, err := ioutil.ReadFile(f.Path)
+	if os.IsNotExist(err) {
+		return state, nil
+	}
+	if err != nil {
+		return
+	}
+	err = json.Unmarshal(data, &state)
+	return
+}
+
+// SaveLadder writes the state to f.Path, replacing the old file atomically.
+func (f FileStore) SaveLadder(state State) error {
+	data, err := json.Marshal(state)
+	if err != nil {
+		return err
+	}
+	tmp := f.Path + ".tmp"
+	if err := ioutil.WriteFile(tmp, data, 0644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, f.Path)
+}
+
+// FriendlyOnly is the default Ladder.Filter, it accepts 1v1 friendly and clanmate battles.
+func FriendlyOnly(b goroyale.Battle) bool {
+	return (b.Type == "friendly" || b.Type == "clanMate") && len(b.Team) == 1 && len(b.Opponent) == 1
+}
+
+// Ladder keeps Elo ratings for the players in the battles it is given.
+// Battles are rated the same way as a goroyale.RatingTable, with goroyale.RatingChange,
+// but new players start at Initial instead of their trophies and inactive players decay.
+type Ladder struct {
+	K       float64 // goroyale.DefaultRatingK if it is 0
+	Initial float64 // rating of new players, goroyale.DefaultRating if it is 0
+	Decay   Decay
+	// Filter picks which battles are rated, FriendlyOnly if it is nil.
+	Filter func(goroyale.Battle) bool
+	// Key identifies battles so each is only rated once, goroyale.BattleKey if it is nil.
+	Key goroyale.BattleKeyFunc
+	// Store is saved to after every rated battle if it isn't nil.
+	Store Store
+
+	mu      sync.Mutex
+	players map[string]*Player
+	rated   map[string]bool
+}
+
+// Load replaces the ladder's state with the one in Store.
+func (l *Ladder) Load() error {
+	if l.Store == nil {
+		return nil
+	}
+	state, err := l.Store.LoadLadder()
+	if err != nil {
+		return err
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.players = make(map[string]*Player, len(state.Players))
+	for i := range state.Players {
+		p := state.Players[i]
+		l.players[p.Tag] = &p
+	}
+	l.rated = make(map[string]bool, len(state.Rated))
+	for _, key := range state.Rated {
+		l.rated[key] = true
+	}
+	return nil
+}
+
+// State returns a copy of the ladder's state.
+func (l *Ladder) State() State {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.state()
+}
+
+func (l *Ladder) state() State {
+	var state State
+	for _, p := range l.players {
+		state.Players = append(state.Players, *p)
+	}
+	sort.Slice(state.Players, func(i, j int) bool { return state.Players[i].Tag < state.Players[j].Tag })
+	for key := range l.rated {
+		state.Rated = append(state.Rated, key)
+	}
+	sort.Strings(state.Rated)
+	return state
+}
+
+func (l *Ladder) initial() float64 {
+	if l.Initial == 0 {
+		return goroyale.DefaultRating
+	}
+	return l.Initial
+}
+
+// player returns the ladder's entry for m, adding them if they're new.
+// Decay is applied now so it isn't lost when the rating changes.
+func (l *Ladder) player(m goroyale.TeamMember, at time.Time) *Player {
+	initial := l.initial()
+	p, ok := l.players[m.Tag]
+	if !ok {
+		p = &Player{Tag: m.Tag, Rating: initial}
+		l.players[m.Tag] = p
+	} else if !p.LastPlayed.IsZero() {
+		p.Rating = l.Decay.apply(p.Rating, initial, p.LastPlayed, at)
+	}
+	if m.Name != "" {
+		p.Name = m.Name
+	}
+	return p
+}
+
+// Add rates b and reports whether it was rated.
+// Battles that don't pass Filter or have already been rated are ignored.
+func (l *Ladder) Add(b goroyale.Battle) (bool, error) {
+	filter := l.Filter
+	if filter == nil {
+		filter = FriendlyOnly
+	}
+	if !filter(b) || len(b.Team) == 0 || len(b.Opponent) == 0 {
+		return false, nil
+	}
+	key := goroyale.BattleKey(b)
+	if l.Key != nil {
+		key = l.Key(b)
+	}
+
+	l.mu.Lock()
+	if l.players == nil {
+		l.players = make(map[string]*Player)
+		l.rated = make(map[string]bool)
+	}
+	if l.rated[key] {
+		l.mu.Unlock()
+		return false, nil
+	}
+	l.rated[key] = true
+
+	at := time.Unix(int64(b.UTCTime), 0)
+	team := make([]*Player, len(b.Team))
+	opponent := make([]*Player, len(b.Opponent))
+	for i, m := range b.Team {
+		team[i] = l.player(m, at)
+	}
+	for i, m := range b.Opponent {
+		opponent[i] = l.player(m, at)
+	}
+
+	score := goroyale.BattleScore(b)
+	change := goroyale.RatingChange(l.K, score, average(team), average(opponent))
+	update := func(players []*Player, change, score float64) {
+		for _, p := range players {
+			p.Rating += change
+			switch score {
+			case 1:
+				p.Wins++
+			case 0:
+				p.Losses++
+			default:
+				p.Draws++
+			}
+			if at.After(p.LastPlayed) {
+				p.LastPlayed = at
+			}
+		}
+	}
+	update(team, change, score)
+	update(opponent, -change, 1-score)
+
+	var state State
+	if l.Store != nil {
+		state = l.state()
+	}
+	l.mu.Unlock()
+
+	if l.Store != nil {
+		return true, l.Store.SaveLadder(state)
+	}
+	return true, nil
+}
+
+// Run rates the battles sent on battles until it is closed or ctx is done.
+// Errors saving to Store are passed to onError if it isn't nil.
+func (l *Ladder) Run(ctx context.Context, battles <-chan goroyale.NewBattle, onError func(error)) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case e, ok := <-battles:
+			if !ok {
+				return nil
+			}
+			if _, err := l.Add(e.Battle); err != nil && onError != nil {
+				onError(err)
+			}
+		}
+	}
+}
+
+// Rating returns a player's rating with decay applied as of now.
+func (l *Ladder) Rating(tag string, now time.Time) (p Player, ok bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	entry, ok := l.players[tag]
+	if !ok {
+		return
+	}
+	p = l.decayed(*entry, now)
+	return
+}
+
+func (l *Ladder) decayed(p Player, now time.Time) Player {
+	p.Rating = l.Decay.apply(p.Rating, l.initial(), p.LastPlayed, now)
+	return p
+}
+
+// Leaderboard returns the top n players by rating with decay applied as of now, or every player if n < 1.
+// Players need at least minPlayed rated battles to show up.
+func (l *Ladder) Leaderboard(n, minPlayed int, now time.Time) []Player {
+	l.mu.Lock()
+	var board []Player
+	for _, p := range l.players {
+		if p.Played() >= minPlayed {
+			board = append(board, l.decayed(*p, now))
+		}
+	}
+	l.mu.Unlock()
+
+	sort.Slice(board, func(i, j int) bool {
+		if board[i].Rating != board[j].Rating {
+			return board[i].Rating > board[j].Rating
+		}
+		return board[i].Tag < board[j].Tag
+	})
+	if n > 0 && n < len(board) {
+		board = board[:n]
+	}
+	return board
+}
+
+func average(players []*Player) float64 {
+	var total float64
+	for _, p := range players {
+		total += p.Rating
+	}
+	return total / float64(len(players))
+}
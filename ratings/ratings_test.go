@@ -0,0 +1,68 @@
+package ratings
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jegfish/goroyale"
+)
+
+func friendly(utc, winner int, a, b string) goroyale.Battle {
+	return goroyale.Battle{
+		Type:    "friendly",
+		UTCTime: utc,
+		Winner:  winner,
+		// no StartTrophies, so a RatingTable starts them at goroyale.DefaultRating like a Ladder does
+		Team:     []goroyale.TeamMember{{Tag: a, Name: a}},
+		Opponent: []goroyale.TeamMember{{Tag: b, Name: b}},
+	}
+}
+
+// TestLadderMatchesRatingTable checks a Ladder without decay rates battles exactly like a goroyale.RatingTable.
+func TestLadderMatchesRatingTable(t *testing.T) {
+	battles := []goroyale.Battle{
+		friendly(100, 1, "A", "B"),
+		friendly(200, -2, "A", "C"),
+		friendly(300, 0, "B", "C"),
+		friendly(400, 3, "C", "A"),
+	}
+	for _, k := range []float64{0, 16} {
+		ladder := &Ladder{K: k}
+		table := &goroyale.RatingTable{K: k}
+		for _, b := range battles {
+			if ok, err := ladder.Add(b); !ok || err != nil {
+				t.Fatalf("Add(%+v) = %v, %v", b, ok, err)
+			}
+		}
+		table.AddBattles(battles)
+		for _, tag := range []string{"A", "B", "C"} {
+			p, _ := ladder.Rating(tag, time.Unix(400, 0))
+			if want := table.Rating(tag); p.Rating != want {
+				t.Errorf("K %v: ladder rates %s %v, RatingTable %v", k, tag, p.Rating, want)
+			}
+		}
+	}
+
+	ladder := &Ladder{}
+	ladder.Add(friendly(100, 1, "A", "B"))
+	if a, _ := ladder.Rating("A", time.Unix(100, 0)); a.Rating != goroyale.DefaultRating+goroyale.DefaultRatingK/2 || a.Wins != 1 {
+		t.Errorf("after one win between new players A is %+v, want a rating of %v", a, goroyale.DefaultRating+goroyale.DefaultRatingK/2)
+	}
+}
+
+func TestLadderDecay(t *testing.T) {
+	ladder := &Ladder{Decay: Decay{After: 24 * time.Hour, PerDay: 2}}
+	ladder.Add(friendly(0, 1, "A", "B"))
+	a, _ := ladder.Rating("A", time.Unix(0, 0))
+	later, _ := ladder.Rating("A", time.Unix(0, 0).Add(4*24*time.Hour))
+	if want := a.Rating - 6; later.Rating != want {
+		t.Errorf("after 3 days of decay A is %v, want %v", later.Rating, want)
+	}
+	// decay stops at the initial rating, and losing players aren't pulled up
+	if b, _ := ladder.Rating("B", time.Unix(0, 0).Add(365*24*time.Hour)); b.Rating >= goroyale.DefaultRating {
+		t.Errorf("B decayed up to %v", b.Rating)
+	}
+	if a, _ := ladder.Rating("A", time.Unix(0, 0).Add(365*24*time.Hour)); a.Rating != goroyale.DefaultRating {
+		t.Errorf("A decayed to %v, want %v", a.Rating, goroyale.DefaultRating)
+	}
+}
@@ -0,0 +1,26 @@
+package goroyale
+
+import "context"
+
+type requestLabelKey struct{}
+
+// Labeled returns a context that tags the requests made with it with label, ex: the name of the bot command making them.
+// The label shows up in Usage.Labels, AuditRecord.RequestLabel, and Logger records,
+// so you can see which feature is using up the quota.
+// Use it with Client.WithContext, Get, or WithRequestLabel.
+func Labeled(ctx context.Context, label string) context.Context {
+	return context.WithValue(ctx, requestLabelKey{}, label)
+}
+
+// RequestLabel returns the label set on ctx with Labeled, or "" if there isn't one.
+func RequestLabel(ctx context.Context) string {
+	label, _ := ctx.Value(requestLabelKey{}).(string)
+	return label
+}
+
+// WithRequestLabel tags a request made with Get with label, the same as using a context from Labeled.
+func WithRequestLabel(label string) RequestOption {
+	return func(o *requestOptions) {
+		o.label = label
+	}
+}
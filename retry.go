@@ -0,0 +1,109 @@
+package goroyale
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+)
+
+// RetryPolicy controls how failed requests are retried.
+// Network errors, 429s, and 5xx responses are retried, other errors are returned right away.
+// Only requests that are safe to send twice are retried, see Client.RetrySafe.
+// The zero value doesn't retry.
+type RetryPolicy struct {
+	Attempts   int           // total tries including the first, 0 or 1 turns retrying off
+	Backoff    time.Duration // wait before the first retry, doubled for each one after
+	MaxBackoff time.Duration // longest wait between tries, no limit if it is 0
+}
+
+// backoff returns how long to wait after the attempt-th try failed.
+func (p RetryPolicy) backoff(attempt int) time.Duration {
+	wait := p.Backoff
+	for i := 1; i < attempt && (p.MaxBackoff == 0 || wait < p.MaxBackoff); i++ {
+		wait *= 2
+	}
+	if p.MaxBackoff != 0 && wait > p.MaxBackoff {
+		wait = p.MaxBackoff
+	}
+	return wait
+}
+
+// retryable reports whether a request that failed with err is worth trying again.
+func retryable(ctx context.Context, err error) bool {
+	if err == nil || ctx.Err() != nil {
+		return false
+	}
+	if errors.Is(err, ErrResponseTooLarge) || errors.Is(err, ErrClientClosed) {
+		// it'll be just as big next time, and a closed client stays closed
+		return false
+	}
+	var apiErr APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.StatusCode == 429 || apiErr.StatusCode >= 500
+	}
+	return true
+}
+
+// unsafeEndpoints are endpoints that change something on the API's side even though they're requested with GET,
+// so sending them twice isn't the same as sending them once. There aren't any yet.
+var unsafeEndpoints = map[string]bool{}
+
+// IsRetrySafe reports whether a request with method to path can be sent again without side effects,
+// which is what the retry layer goes by.
+// GET, HEAD, and OPTIONS requests are safe unless their endpoint is known to do something, everything else isn't.
+func IsRetrySafe(method, path string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return !unsafeEndpoints[endpointOf(path)]
+	}
+	return false
+}
+
+// SetRetrySafe overrides IsRetrySafe for every request to endpoint, ex: "/player".
+// It's for endpoints newer than this package, it must be used before making requests like the other Set methods.
+func (c *Client) SetRetrySafe(endpoint string, safe bool) {
+	if c.retrySafe == nil {
+		c.retrySafe = make(map[string]bool)
+	}
+	c.retrySafe[endpointOf(endpoint)] = safe
+}
+
+type retrySafeKey struct{}
+
+// RetrySafeContext returns a context that marks the requests made with it as safe, or not, to retry,
+// overriding the Client's classification. Use it with Client.WithContext or Get.
+func RetrySafeContext(ctx context.Context, safe bool) context.Context {
+	return context.WithValue(ctx, retrySafeKey{}, safe)
+}
+
+// WithRetrySafe marks a request made with Get as safe, or not, to retry, the same as using a context from RetrySafeContext.
+func WithRetrySafe(safe bool) RequestOption {
+	return func(o *requestOptions) {
+		o.retrySafe = &safe
+	}
+}
+
+// RetrySafe reports whether a request with method to path will be retried when it fails,
+// going by the context, then SetRetrySafe, then IsRetrySafe.
+func (c *Client) RetrySafe(ctx context.Context, method, path string) bool {
+	if safe, ok := ctx.Value(retrySafeKey{}).(bool); ok {
+		return safe
+	}
+	if safe, ok := c.retrySafe[endpointOf(path)]; ok {
+		return safe
+	}
+	return IsRetrySafe(method, path)
+}
+
+// SetRetryPolicy sets how failed requests are retried.
+// Retries go through ratelimiting like any other request.
+func (c *Client) SetRetryPolicy(p RetryPolicy) {
+	c.retry = p
+}
+
+// SetBaseURL sends requests to url instead of DefaultBaseURL, ex: to a goroyale-proxy or a mock server.
+// url shouldn't end with a slash.
+func (c *Client) SetBaseURL(url string) {
+	c.baseURL = url
+}
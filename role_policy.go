@@ -0,0 +1,195 @@
+package goroyale
+
+import (
+	"fmt"
+	"sort"
+	"time"
+)
+
+// Clan roles, as in ClanMember.Role.
+const (
+	RoleMember   = "member"
+	RoleElder    = "elder"
+	RoleCoLeader = "coLeader"
+	RoleLeader   = "leader"
+)
+
+// RoleRequirements are what a member needs for a role in a Policy.
+// Fields that are 0 aren't required, a RoleRequirements that's all 0 is never met so the role is never recommended.
+type RoleRequirements struct {
+	Donations int // ClanMember.Donations, which covers the current week
+	Trophies  int
+	// WarParticipation is the fraction of the wars in the war log the member took part in, 0-1.
+	WarParticipation float64
+	// Tenure is how long the member has been in the clan.
+	Tenure time.Duration
+}
+
+// Policy is a clan's rules for who gets promoted and demoted, see Evaluate.
+// The leader is never evaluated, and nobody is recommended for more than one role up or down at a time.
+type Policy struct {
+	Elder    RoleRequirements // for members to be promoted to elder
+	CoLeader RoleRequirements // for elders to be promoted to co-leader
+	// Keep is what elders and co-leaders need to keep their role, they're recommended for demotion when they fall
+	// below it. Keeping it lower than Elder stops members from going back and forth every week.
+	Keep RoleRequirements
+}
+
+// PolicyInput is what a Policy is evaluated against.
+type PolicyInput struct {
+	Clan   Clan
+	WarLog []ClanWarLogEntry
+	// Joined is when each member was first seen in the clan, by tag, see JoinTimes and WarTracker.JoinTimes.
+	// Members missing from it fail any Tenure requirement.
+	Joined map[string]time.Time
+	Now    time.Time // time.Now() if it is zero
+}
+
+// RoleChange is a recommended promotion or demotion.
+type RoleChange struct {
+	Tag       string
+	Name      string
+	From, To  string // roles, ex: RoleMember and RoleElder
+	Promotion bool
+	// Reasons are the requirements the member met for a promotion, or missed for a demotion.
+	Reasons []string
+}
+
+// String describes the change, ex: "Promote Bob from member to elder: 320 donations (needs 300)".
+func (c RoleChange) String() string {
+	verb := "Demote"
+	if c.Promotion {
+		verb = "Promote"
+	}
+	s := fmt.Sprintf("%s %s from %s to %s", verb, c.Name, c.From, c.To)
+	for i, reason := range c.Reasons {
+		if i == 0 {
+			s += ": " + reason
+		} else {
+			s += ", " + reason
+		}
+	}
+	return s
+}
+
+// Evaluate checks every member of the clan against p and returns the recommended role changes,
+// promotions first. Members who already have the right role aren't included.
+func (p Policy) Evaluate(in PolicyInput) (changes []RoleChange) {
+	now := in.Now
+	if now.IsZero() {
+		now = time.Now()
+	}
+	wars := make(map[string]int)
+	for _, war := range in.WarLog {
+		for _, participant := range war.Participants {
+			wars[NormalizeTag(participant.Tag)]++
+		}
+	}
+
+	for _, m := range in.Clan.Members {
+		tag := NormalizeTag(m.Tag)
+		joined, known := in.Joined[tag]
+		if !known {
+			joined, known = in.Joined[m.Tag]
+		}
+		check := func(r RoleRequirements) (met, missed []string) {
+			return r.check(m, wars[tag], len(in.WarLog), joined, known, now)
+		}
+
+		change := RoleChange{Tag: m.Tag, Name: m.Name, From: m.Role}
+		switch m.Role {
+		case RoleMember:
+			if met, missed := check(p.Elder); len(met) > 0 && len(missed) == 0 {
+				change.To, change.Promotion, change.Reasons = RoleElder, true, met
+			}
+		case RoleElder:
+			if met, missed := check(p.CoLeader); len(met) > 0 && len(missed) == 0 {
+				change.To, change.Promotion, change.Reasons = RoleCoLeader, true, met
+			} else if _, missed := check(p.Keep); len(missed) > 0 {
+				change.To, change.Reasons = RoleMember, missed
+			}
+		case RoleCoLeader:
+			if _, missed := check(p.Keep); len(missed) > 0 {
+				change.To, change.Reasons = RoleElder, missed
+			}
+		}
+		if change.To != "" {
+			changes = append(changes, change)
+		}
+	}
+	sort.SliceStable(changes, func(i, j int) bool { return changes[i].Promotion && !changes[j].Promotion })
+	return
+}
+
+// check returns a reason for each requirement m met and each one it missed.
+func (r RoleRequirements) check(m ClanMember, wars, totalWars int, joined time.Time, knownJoin bool, now time.Time) (met, missed []string) {
+	add := func(ok bool, reason string) {
+		if ok {
+			met = append(met, reason)
+		} else {
+			missed = append(missed, reason)
+		}
+	}
+	if r.Donations > 0 {
+		add(m.Donations >= r.Donations, fmt.Sprintf("%d donations (needs %d)", m.Donations, r.Donations))
+	}
+	if r.Trophies > 0 {
+		add(m.Trophies >= r.Trophies, fmt.Sprintf("%d trophies (needs %d)", m.Trophies, r.Trophies))
+	}
+	if r.WarParticipation > 0 {
+		var share float64
+		if totalWars > 0 {
+			share = float64(wars) / float64(totalWars)
+		}
+		add(share >= r.WarParticipation, fmt.Sprintf("%d of %d wars (needs %.0f%%)", wars, totalWars, r.WarParticipation*100))
+	}
+	if r.Tenure > 0 {
+		if knownJoin {
+			tenure := now.Sub(joined)
+			add(tenure >= r.Tenure, fmt.Sprintf("in the clan for %s (needs %s)", formatDays(tenure), formatDays(r.Tenure)))
+		} else {
+			add(false, "unknown time in the clan")
+		}
+	}
+	return
+}
+
+func formatDays(d time.Duration) string {
+	days := int(d / (24 * time.Hour))
+	if days == 1 {
+		return "1 day"
+	}
+	return fmt.Sprintf("%d days", days)
+}
+
+// JoinTimes returns when each member, by tag, first shows up in history.
+// History only goes back so far, so members who were in the clan before it started have the time of its first entry.
+func JoinTimes(history ClanHistory) map[string]time.Time {
+	joined := make(map[string]time.Time)
+	for _, entry := range history {
+		for _, m := range entry.Members {
+			tag := NormalizeTag(m.Tag)
+			if t, ok := joined[tag]; !ok || entry.Time.Before(t) {
+				joined[tag] = entry.Time
+			}
+		}
+	}
+	return joined
+}
+
+// JoinTimes returns when each member of the clan was first seen in it, by tag.
+// It needs TrackMembers to be set, ok is false until the clan has been polled with it.
+// Members who were in the clan on the first poll have the time of that poll.
+func (w *WarTracker) JoinTimes(tag string) (joined map[string]time.Time, ok bool) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	members, ok := w.members[tag]
+	if !ok {
+		return
+	}
+	joined = make(map[string]time.Time, len(members))
+	for memberTag, seen := range members {
+		joined[NormalizeTag(memberTag)] = seen.before
+	}
+	return
+}
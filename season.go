@@ -0,0 +1,167 @@
+package goroyale
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Season is a trophy season.
+type Season struct {
+	ID    string // year and month the season started, ex: "2018-11"
+	Start time.Time
+	End   time.Time // when the next season starts, zero for the last season in a calendar
+}
+
+// Contains reports whether t is within the season.
+func (s Season) Contains(t time.Time) bool {
+	return !t.Before(s.Start) && (s.End.IsZero() || t.Before(s.End))
+}
+
+// SeasonCalendar is a list of seasons, oldest first.
+// The API doesn't publish season dates, so it either comes from ParseSeasonCalendar or is filled in by hand.
+type SeasonCalendar []Season
+
+// ParseSeasonCalendar reads a season calendar with the start of one season on each line.
+// Starts are RFC 3339 times, ex: "2018-11-05T09:00:00Z", or dates, ex: "2018-11-05", which are taken as midnight UTC.
+// Blank lines and lines starting with # are skipped. Each season ends when the next one starts.
+func ParseSeasonCalendar(r io.Reader) (calendar SeasonCalendar, err error) {
+	scanner := bufio.NewScanner(r)
+	line := 0
+	for scanner.Scan() {
+		line++
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+		start, parseErr := time.Parse(time.RFC3339, text)
+		if parseErr != nil {
+			if start, parseErr = time.Parse("2006-01-02", text); parseErr != nil {
+				return nil, fmt.Errorf("season calendar line %d: %q isn't a date or RFC 3339 time", line, text)
+			}
+		}
+		calendar = append(calendar, Season{Start: start.UTC()})
+	}
+	if err = scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	sort.Slice(calendar, func(i, j int) bool { return calendar[i].Start.Before(calendar[j].Start) })
+	for i := range calendar {
+		calendar[i].ID = calendar[i].Start.Format("2006-01")
+		if i+1 < len(calendar) {
+			calendar[i].End = calendar[i+1].Start
+		}
+	}
+	return
+}
+
+// At returns the season t falls within.
+func (c SeasonCalendar) At(t time.Time) (Season, bool) {
+	for _, s := range c {
+		if s.Contains(t) {
+			return s, true
+		}
+	}
+	return Season{}, false
+}
+
+// Battles returns the battles played during the season.
+func (s Season) Battles(battles []Battle) []Battle {
+	return FilterBattles(battles, func(b Battle) bool { return s.Contains(b.Time()) })
+}
+
+// SeasonRules works out season dates when there is no calendar for them.
+// Seasons start on the first Weekday of every month at Hour:00 in Location.
+type SeasonRules struct {
+	Weekday  time.Weekday
+	Hour     int
+	Location *time.Location // nil means UTC
+}
+
+// DefaultSeasonRules are the rules trophy seasons have followed so far,
+// starting on the first Monday of the month at 09:00 UTC.
+// The API doesn't publish them, so change them here or pass your own SeasonRules if the game does.
+var DefaultSeasonRules = SeasonRules{Weekday: time.Monday, Hour: 9}
+
+func (r SeasonRules) location() *time.Location {
+	if r.Location == nil {
+		return time.UTC
+	}
+	return r.Location
+}
+
+// start returns when the season starting in the month begins.
+func (r SeasonRules) start(year int, month time.Month) time.Time {
+	first := time.Date(year, month, 1, r.Hour, 0, 0, 0, r.location())
+	days := (int(r.Weekday) - int(first.Weekday()) + 7) % 7
+	return first.AddDate(0, 0, days)
+}
+
+// season returns the season starting in the month.
+func (r SeasonRules) season(year int, month time.Month) Season {
+	start := r.start(year, month)
+	next := time.Date(year, month+1, 1, 0, 0, 0, 0, r.location())
+	return Season{
+		ID:    start.Format("2006-01"),
+		Start: start,
+		End:   r.start(next.Year(), next.Month()),
+	}
+}
+
+// At returns the season t falls within.
+func (r SeasonRules) At(t time.Time) Season {
+	t = t.In(r.location())
+	s := r.season(t.Year(), t.Month())
+	if t.Before(s.Start) {
+		// still in the season that started last month
+		prev := time.Date(t.Year(), t.Month()-1, 1, 0, 0, 0, 0, r.location())
+		s = r.season(prev.Year(), prev.Month())
+	}
+	return s
+}
+
+// Next returns the season after s.
+func (r SeasonRules) Next(s Season) Season {
+	return r.At(s.End)
+}
+
+// Calendar returns every season that overlaps from through to.
+func (r SeasonRules) Calendar(from, to time.Time) (calendar SeasonCalendar) {
+	for s := r.At(from); s.Start.Before(to); s = r.Next(s) {
+		calendar = append(calendar, s)
+	}
+	return
+}
+
+// CurrentSeason returns the season going on now using DefaultSeasonRules.
+func CurrentSeason() Season {
+	return DefaultSeasonRules.At(time.Now())
+}
+
+// CurrentSeasonID returns the ID of the season going on now using DefaultSeasonRules, ex: "2018-11".
+func CurrentSeasonID() string {
+	return CurrentSeason().ID
+}
+
+// UntilReset returns how long after now the season ends.
+// It's 0 once the season is over or if the season has no end.
+func (s Season) UntilReset(now time.Time) time.Duration {
+	if s.End.IsZero() || !now.Before(s.End) {
+		return 0
+	}
+	return s.End.Sub(now)
+}
+
+// DaysLeft returns UntilReset in whole days, rounded up, for "season ends in X days" messages.
+func (s Season) DaysLeft(now time.Time) int {
+	left := s.UntilReset(now)
+	days := int(left / (24 * time.Hour))
+	if left%(24*time.Hour) != 0 {
+		days++
+	}
+	return days
+}
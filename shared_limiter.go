@@ -0,0 +1,104 @@
+package goroyale
+
+import (
+	"context"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// CounterStore holds counters shared by every process using a token, ex: in Redis.
+// With Redis, Incr is INCR followed by PEXPIRE when the result is 1.
+type CounterStore interface {
+	// Incr adds 1 to the counter at key and returns the new value.
+	// A counter that doesn't exist starts at 0 and is deleted after ttl.
+	Incr(ctx context.Context, key string, ttl time.Duration) (int64, error)
+}
+
+// MemoryCounterStore is a CounterStore for processes in the same program, or for testing.
+type MemoryCounterStore struct {
+	mu       sync.Mutex
+	counters map[string]memoryCounter
+}
+
+type memoryCounter struct {
+	n       int64
+	expires time.Time
+}
+
+// Incr adds 1 to the counter at key.
+func (m *MemoryCounterStore) Incr(ctx context.Context, key string, ttl time.Duration) (int64, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	now := time.Now()
+	if m.counters == nil {
+		m.counters = make(map[string]memoryCounter)
+	}
+	for k, c := range m.counters {
+		if now.After(c.expires) {
+			delete(m.counters, k)
+		}
+	}
+	c, ok := m.counters[key]
+	if !ok {
+		c.expires = now.Add(ttl)
+	}
+	c.n++
+	m.counters[key] = c
+	return c.n, nil
+}
+
+// Defaults used when a SharedLimiter's fields are 0.
+const (
+	DefaultSharedLimit  = 5 // RoyaleAPI's default requests per second
+	DefaultSharedWindow = time.Second
+)
+
+// SharedLimiter limits the requests of several processes using the same token.
+// Each process makes its own Client and sets the same SharedLimiter settings with SetSharedLimiter.
+// Requests are counted in fixed windows, so every process needs a roughly synced clock.
+type SharedLimiter struct {
+	Store  CounterStore
+	Prefix string        // prepended to every key, ex: "goroyale:ratelimit:"
+	Limit  int           // requests per Window, DefaultSharedLimit if it is 0
+	Window time.Duration // DefaultSharedWindow if it is 0
+	Clock  Clock         // the wall clock is used if it is nil
+}
+
+// Wait blocks until a request can be made without going over Limit, or ctx is done.
+func (l *SharedLimiter) Wait(ctx context.Context) error {
+	limit, window := l.Limit, l.Window
+	if limit == 0 {
+		limit = DefaultSharedLimit
+	}
+	if window == 0 {
+		window = DefaultSharedWindow
+	}
+	clock := l.Clock
+	if clock == nil {
+		clock = realClock{}
+	}
+
+	for {
+		now := clock.Now()
+		start := now.Truncate(window)
+		// keep the key around a little past the window in case clocks are slightly off
+		n, err := l.Store.Incr(ctx, l.Prefix+strconv.FormatInt(start.UnixNano(), 10), 2*window)
+		if err != nil {
+			return err
+		}
+		if n <= int64(limit) {
+			return nil
+		}
+
+		if err := sleepContext(ctx, clock, start.Add(window).Sub(now)); err != nil {
+			return err
+		}
+	}
+}
+
+// SetSharedLimiter makes every request wait on l as well as the client's own ratelimiting.
+// Pass nil to stop sharing.
+func (c *Client) SetSharedLimiter(l *SharedLimiter) {
+	c.shared = l
+}
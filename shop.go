@@ -0,0 +1,46 @@
+package goroyale
+
+import "sort"
+
+// ShopChestValue is how much a shop chest is worth for its price in gems.
+// Cards are valued at their rarity's GoldConversionValue, what the shop trades spare cards for.
+type ShopChestValue struct {
+	ChestContents
+
+	GoldValue   float64 // the cards' gold value plus the chest's average gold
+	GoldPerGem  float64
+	GemsPerCard float64
+}
+
+// ShopChestValues returns the value of every chest sold in the shop in the arena, best value first.
+// Arenas work the same as in ChestOdds.
+func ShopChestValues(arena int) (values []ShopChestValue) {
+	constants, err := GameData()
+	if err != nil {
+		return
+	}
+	goldPerCard := make(map[string]float64)
+	for _, r := range constants.Rarities {
+		goldPerCard[r.Name] = float64(r.GoldConversionValue)
+	}
+
+	for _, c := range constants.TreasureChests.Shop {
+		contents, ok := ChestOdds(c.Name, arena)
+		if !ok || contents.Gems == 0 {
+			continue
+		}
+		v := ShopChestValue{ChestContents: contents}
+		v.GoldValue = contents.Common*goldPerCard["Common"] +
+			contents.Rare*goldPerCard["Rare"] +
+			contents.Epic*goldPerCard["Epic"] +
+			contents.Legendary*goldPerCard["Legendary"] +
+			float64(contents.MinGold+contents.MaxGold)/2
+		v.GoldPerGem = v.GoldValue / float64(contents.Gems)
+		if contents.Cards > 0 {
+			v.GemsPerCard = float64(contents.Gems) / contents.Cards
+		}
+		values = append(values, v)
+	}
+	sort.SliceStable(values, func(i, j int) bool { return values[i].GoldPerGem > values[j].GoldPerGem })
+	return
+}
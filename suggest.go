@@ -0,0 +1,61 @@
+package goroyale
+
+import "sort"
+
+// DeckSuggestion is a popular deck a player has every card for.
+type DeckSuggestion struct {
+	Deck         PopularDeck
+	Cards        []Card  // the player's copies of the deck's cards
+	AverageLevel float64 // see NormalizedLevel
+	LowestLevel  int
+}
+
+// SuggestDecks ranks the popular decks player could use, highest card levels first.
+// Levels are compared with NormalizedLevel so rarities compare fairly.
+// Decks are left out if the player is missing a card, or if any card is more than minLevelDelta levels below MaxCardLevel.
+// Pass MaxCardLevel as minLevelDelta to allow every level.
+func SuggestDecks(player Player, decks []PopularDeck, minLevelDelta int) []DeckSuggestion {
+	owned := make(map[string]Card, len(player.Cards))
+	for _, c := range player.Cards {
+		owned[c.Key] = c
+	}
+
+	var suggestions []DeckSuggestion
+nextDeck:
+	for _, deck := range decks {
+		s := DeckSuggestion{Deck: deck, LowestLevel: MaxCardLevel}
+		var total int
+		for _, dc := range deck.Cards {
+			c, ok := owned[dc.Key]
+			if !ok {
+				continue nextDeck
+			}
+			level := NormalizedLevel(c)
+			if MaxCardLevel-level > minLevelDelta {
+				continue nextDeck
+			}
+			if level < s.LowestLevel {
+				s.LowestLevel = level
+			}
+			total += level
+			s.Cards = append(s.Cards, c)
+		}
+		if len(s.Cards) == 0 {
+			continue
+		}
+		s.AverageLevel = float64(total) / float64(len(s.Cards))
+		suggestions = append(suggestions, s)
+	}
+
+	sort.SliceStable(suggestions, func(i, j int) bool {
+		a, b := suggestions[i], suggestions[j]
+		if a.AverageLevel != b.AverageLevel {
+			return a.AverageLevel > b.AverageLevel
+		}
+		if a.LowestLevel != b.LowestLevel {
+			return a.LowestLevel > b.LowestLevel
+		}
+		return a.Deck.Popularity.Hits.Value > b.Deck.Popularity.Hits.Value
+	})
+	return suggestions
+}
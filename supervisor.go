@@ -0,0 +1,469 @@
+package goroyale
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// WatchConfig declares what a Supervisor watches and where it sends what it sees,
+// so monitoring can change without recompiling. It's usually a JSON or YAML file read by LoadWatchConfig, ex:
+//
+//	{
+//		"interval": "5m",
+//		"clans": [{"tag": "2CCCP", "events": ["war_started", "war_ended"]}],
+//		"players": [{"tag": "8L9L9GL", "goals": [5000, 6000]}],
+//		"webhooks": [{"name": "clan-chat", "url": "https://discord.com/api/webhooks/...", "format": "discord"}],
+//		"alerts": [{"events": ["war_started", "trophy_goal"], "webhooks": ["clan-chat"]}]
+//	}
+//
+// or the same in YAML:
+//
+//	interval: 5m
+//	clans:
+//	  - tag: 2CCCP
+//	    events: [war_started, war_ended]
+//	players:
+//	  - tag: 8L9L9GL
+//	    goals: [5000, 6000]
+//	webhooks:
+//	  - name: clan-chat
+//	    url: https://discord.com/api/webhooks/...
+//	    format: discord
+//	alerts:
+//	  - events: [war_started, trophy_goal]
+//	    webhooks: [clan-chat]
+type WatchConfig struct {
+	// Interval is how often everything is polled unless it has its own, DefaultWatchInterval if it is 0.
+	Interval ConfigDuration `json:"interval"`
+	// CrawlInterval paces Crawls run alongside the Supervisor, see Supervisor.CrawlInterval.
+	CrawlInterval ConfigDuration `json:"crawl_interval"`
+
+	Clans       []WatchedClan       `json:"clans"`
+	Players     []WatchedPlayer     `json:"players"`
+	Tournaments []WatchedTournament `json:"tournaments"`
+	Webhooks    []NamedWebhook      `json:"webhooks"`
+	Alerts      []AlertRule         `json:"alerts"`
+}
+
+// WatchedClan is a clan in a WatchConfig.
+type WatchedClan struct {
+	Tag string `json:"tag"`
+	// Events are the ClanEvents to watch for, all of them if it's empty.
+	Events []string `json:"events"`
+	// TrackMembers sets WarTracker.TrackMembers.
+	TrackMembers bool           `json:"track_members"`
+	Interval     ConfigDuration `json:"interval"`
+}
+
+// WatchedPlayer is a player in a WatchConfig.
+type WatchedPlayer struct {
+	Tag string `json:"tag"`
+	// Events are the PlayerEvents to watch for, all of them if it's empty.
+	Events []string `json:"events"`
+	// Goals are the trophy counts announced by trophy_goal events.
+	Goals    []int          `json:"goals"`
+	Interval ConfigDuration `json:"interval"`
+}
+
+// WatchedTournament is a tournament in a WatchConfig, it's watched until it ends.
+type WatchedTournament struct {
+	Tag string `json:"tag"`
+	// Events are the TournamentEvents to watch for, all of them if it's empty.
+	Events   []string       `json:"events"`
+	Interval ConfigDuration `json:"interval"`
+}
+
+// NamedWebhook is a Webhook that AlertRules refer to by Name.
+type NamedWebhook struct {
+	Name   string        `json:"name"`
+	URL    string        `json:"url"`
+	Format WebhookFormat `json:"format"` // "generic", "discord", or "slack"
+	Secret string        `json:"secret"`
+}
+
+// AlertRule sends the events it matches to webhooks.
+type AlertRule struct {
+	// Events limits the rule to these event types, it matches every type if it's empty.
+	Events []string `json:"events"`
+	// Tags limits the rule to events about these players, clans, or tournaments, it matches every tag if it's empty.
+	Tags []string `json:"tags"`
+	// Webhooks are the names of the NamedWebhooks to send to.
+	Webhooks []string `json:"webhooks"`
+}
+
+// Event types each kind of WatchConfig entry can watch for.
+var (
+	ClanEvents       = []string{EventWarStarted, EventWarDayStarted, EventWarEnded}
+	PlayerEvents     = []string{EventNewBattle, EventTrophyChange, EventPersonalBest, EventArenaPromoted, EventTrophyGoal}
+	TournamentEvents = []string{EventTournamentStatus, EventTournamentJoin, EventTournamentFinished}
+)
+
+// LoadWatchConfig reads a WatchConfig from a JSON or YAML file and validates it, see LoadConfig.
+func LoadWatchConfig(path string) (cfg WatchConfig, err error) {
+	if err = decodeConfigFile(path, &cfg); err != nil {
+		return
+	}
+	if err = cfg.Validate(); err != nil {
+		err = fmt.Errorf("%s: %w", path, err)
+	}
+	return
+}
+
+// Validate checks the tags, event types, and webhook names in cfg and returns every problem found.
+func (cfg WatchConfig) Validate() error {
+	var errs []error
+	problem := func(format string, args ...interface{}) {
+		errs = append(errs, fmt.Errorf(format, args...))
+	}
+	tag := func(where, tag string) {
+		if p := tagProblem(NormalizeTag(tag)); p != "" {
+			problem("%s: %s", where, p)
+		}
+	}
+	events := func(where string, events, allowed []string) {
+		for _, e := range events {
+			if !containsString(allowed, e) {
+				problem("%s: unknown event %q, use one of %s", where, e, strings.Join(allowed, ", "))
+			}
+		}
+	}
+
+	for i, c := range cfg.Clans {
+		where := fmt.Sprintf("clans[%d]", i)
+		tag(where, c.Tag)
+		events(where, c.Events, ClanEvents)
+	}
+	for i, p := range cfg.Players {
+		where := fmt.Sprintf("players[%d]", i)
+		tag(where, p.Tag)
+		events(where, p.Events, PlayerEvents)
+	}
+	for i, t := range cfg.Tournaments {
+		where := fmt.Sprintf("tournaments[%d]", i)
+		tag(where, t.Tag)
+		events(where, t.Events, TournamentEvents)
+	}
+
+	webhooks := make(map[string]bool, len(cfg.Webhooks))
+	for i, w := range cfg.Webhooks {
+		where := fmt.Sprintf("webhooks[%d]", i)
+		switch {
+		case w.Name == "":
+			problem("%s: missing name", where)
+		case webhooks[w.Name]:
+			problem("%s: there's already a webhook named %q", where, w.Name)
+		}
+		if w.URL == "" {
+			problem("%s: missing url", where)
+		}
+		webhooks[w.Name] = true
+	}
+
+	all := append(append(append([]string(nil), ClanEvents...), PlayerEvents...), TournamentEvents...)
+	for i, a := range cfg.Alerts {
+		where := fmt.Sprintf("alerts[%d]", i)
+		events(where, a.Events, all)
+		for _, t := range a.Tags {
+			tag(where, t)
+		}
+		if len(a.Webhooks) == 0 {
+			problem("%s: no webhooks to send to", where)
+		}
+		for _, name := range a.Webhooks {
+			if !webhooks[name] {
+				problem("%s: no webhook named %q", where, name)
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func containsString(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
+// Supervisor runs the watchers and notifiers a WatchConfig asks for on a single Client and EventBus.
+// Entries that share an interval share a watcher, so the Client's caching and ratelimiting cover all of them.
+// Once it's running, change its config with Reload instead of setting Config.
+type Supervisor struct {
+	Client *Client
+	Config WatchConfig
+	// Bus is what the watchers publish on, Run creates one if it is nil.
+	// Other code can subscribe to it to see everything the Supervisor sees.
+	Bus *EventBus
+	// OnError gets WatchErrors, failed notifications, and failed reloads, they're dropped if it is nil.
+	OnError func(error)
+
+	mu      sync.Mutex
+	reloads chan struct{}
+	state   watchState
+}
+
+// Run validates the config and runs everything it asks for until ctx is done
+// or one of the watchers stops with an error.
+// Tournaments stop being watched once they end, the rest keeps running.
+func (s *Supervisor) Run(ctx context.Context) error {
+	s.mu.Lock()
+	cfg := s.Config
+	if err := cfg.Validate(); err != nil {
+		s.mu.Unlock()
+		return err
+	}
+	if s.Bus == nil {
+		s.Bus = new(EventBus)
+	}
+	s.reloads = make(chan struct{}, 1)
+	s.mu.Unlock()
+	defer func() {
+		s.mu.Lock()
+		s.reloads = nil
+		s.mu.Unlock()
+	}()
+
+	for {
+		gen := s.generation(cfg)
+		genCtx, cancel := context.WithCancel(ctx)
+		done := make(chan error, 1)
+		go func() { done <- gen.run(genCtx, cancel) }()
+
+		select {
+		case err := <-done:
+			if err == nil {
+				// everything finished on its own, ex: every tournament ended, wait for a reload
+				select {
+				case <-ctx.Done():
+					cancel()
+					return ctx.Err()
+				case <-s.reloads:
+				}
+			} else {
+				cancel()
+				return err
+			}
+		case <-s.reloads:
+			cancel()
+			<-done
+		}
+		cancel()
+
+		s.mu.Lock()
+		s.state = gen.state()
+		cfg = s.Config
+		s.mu.Unlock()
+	}
+}
+
+// supervised is everything started for one version of the config.
+type supervised struct {
+	tasks    []func(context.Context) error
+	battles  []*BattleWatcher
+	players  []*PlayerWatcher
+	trophies []*TrophyTracker
+	wars     []*WarTracker
+}
+
+// run runs every task until they're all done, the first error cancels the rest and is returned.
+func (g *supervised) run(ctx context.Context, cancel context.CancelFunc) error {
+	var (
+		wg    sync.WaitGroup
+		once  sync.Once
+		first error
+	)
+	for _, task := range g.tasks {
+		wg.Add(1)
+		go func(task func(context.Context) error) {
+			defer wg.Done()
+			if err := task(ctx); err != nil {
+				once.Do(func() { first = err })
+				cancel()
+			}
+		}(task)
+	}
+	wg.Wait()
+	return first
+}
+
+// watchGroup is the settings entries must share to be covered by the same watcher.
+type watchGroup struct {
+	interval time.Duration
+	members  bool   // WarTracker.TrackMembers
+	goals    string // TrophyTracker.Goals
+}
+
+// generation builds the watchers and notifiers for cfg, the subscriptions are made before anything starts polling.
+// Watchers pick up where the ones before the last reload left off.
+func (s *Supervisor) generation(cfg WatchConfig) *supervised {
+	gen := new(supervised)
+	s.mu.Lock()
+	state := s.state
+	s.mu.Unlock()
+	interval := func(d ConfigDuration) time.Duration {
+		if d != 0 {
+			return time.Duration(d)
+		}
+		return time.Duration(cfg.Interval)
+	}
+	// wanted is the event types asked for by each tag, so watchers that publish more than one type
+	// don't set off alerts for types nobody asked for
+	wanted := make(map[string]map[string]bool)
+	want := func(tag string, events, all []string) {
+		if len(events) == 0 {
+			events = all
+		}
+		if wanted[tag] == nil {
+			wanted[tag] = make(map[string]bool)
+		}
+		for _, e := range events {
+			wanted[tag][e] = true
+		}
+	}
+	// wants reports whether events asks for any of types
+	wants := func(events []string, types ...string) bool {
+		if len(events) == 0 {
+			return true
+		}
+		for _, t := range types {
+			if containsString(events, t) {
+				return true
+			}
+		}
+		return false
+	}
+
+	var groups []watchGroup
+	grouped := make(map[watchGroup]bool)
+	battles := make(map[watchGroup][]string)
+	trophies := make(map[watchGroup][]string)
+	pushes := make(map[watchGroup][]int)
+	pushTags := make(map[watchGroup][]string)
+	wars := make(map[watchGroup][]string)
+	add := func(tags map[watchGroup][]string, g watchGroup, tag string) {
+		if !grouped[g] {
+			grouped[g] = true
+			groups = append(groups, g)
+		}
+		tags[g] = append(tags[g], tag)
+	}
+
+	for _, p := range cfg.Players {
+		tag := NormalizeTag(p.Tag)
+		want(tag, p.Events, PlayerEvents)
+		g := watchGroup{interval: interval(p.Interval)}
+		if wants(p.Events, EventNewBattle) {
+			add(battles, g, tag)
+		}
+		if wants(p.Events, EventTrophyChange) {
+			add(trophies, g, tag)
+		}
+		if wants(p.Events, EventPersonalBest, EventArenaPromoted, EventTrophyGoal) {
+			goals := append([]int(nil), p.Goals...)
+			sort.Ints(goals)
+			g.goals = fmt.Sprint(goals)
+			add(pushTags, g, tag)
+			pushes[g] = goals
+		}
+	}
+	for _, c := range cfg.Clans {
+		tag := NormalizeTag(c.Tag)
+		want(tag, c.Events, ClanEvents)
+		add(wars, watchGroup{interval: interval(c.Interval), members: c.TrackMembers}, tag)
+	}
+
+	for _, g := range groups {
+		if tags := battles[g]; len(tags) > 0 {
+			w := &BattleWatcher{Client: s.Client, Bus: s.Bus, Tags: tags, Interval: g.interval}
+			state.restoreBattles(w)
+			gen.battles = append(gen.battles, w)
+			gen.tasks = append(gen.tasks, w.Run)
+		}
+		if tags := trophies[g]; len(tags) > 0 {
+			w := &PlayerWatcher{Client: s.Client, Bus: s.Bus, Tags: tags, Interval: g.interval}
+			state.restorePlayers(w)
+			gen.players = append(gen.players, w)
+			gen.tasks = append(gen.tasks, w.Run)
+		}
+		if tags := pushTags[g]; len(tags) > 0 {
+			t := &TrophyTracker{Client: s.Client, Bus: s.Bus, Tags: tags, Goals: pushes[g], Interval: g.interval}
+			state.restoreTrophies(t)
+			gen.trophies = append(gen.trophies, t)
+			gen.tasks = append(gen.tasks, t.Run)
+		}
+		if tags := wars[g]; len(tags) > 0 {
+			w := &WarTracker{Client: s.Client, Bus: s.Bus, Tags: tags, Interval: g.interval, TrackMembers: g.members}
+			state.restoreWars(w)
+			gen.wars = append(gen.wars, w)
+			gen.tasks = append(gen.tasks, w.Run)
+		}
+	}
+	for _, t := range cfg.Tournaments {
+		tag := NormalizeTag(t.Tag)
+		want(tag, t.Events, TournamentEvents)
+		m := &TournamentManager{Client: s.Client, Bus: s.Bus, Tag: tag, Interval: interval(t.Interval)}
+		gen.tasks = append(gen.tasks, m.Run)
+	}
+
+	webhooks := make(map[string]NamedWebhook, len(cfg.Webhooks))
+	for _, w := range cfg.Webhooks {
+		webhooks[w.Name] = w
+	}
+	for _, a := range cfg.Alerts {
+		n := &Notifier{}
+		for _, name := range a.Webhooks {
+			w := webhooks[name]
+			n.Webhooks = append(n.Webhooks, Webhook{URL: w.URL, Format: w.Format, Secret: w.Secret, Types: a.Events})
+		}
+		var tags []string
+		for _, tag := range a.Tags {
+			tags = append(tags, NormalizeTag(tag))
+		}
+		sub := SubscribeAll(s.Bus, SubscribeOptions{Tags: tags})
+		gen.tasks = append(gen.tasks, func(ctx context.Context) error {
+			defer sub.Unsubscribe()
+			for {
+				select {
+				case <-ctx.Done():
+					return nil
+				case e, ok := <-sub.C:
+					if !ok {
+						return nil
+					}
+					if !wanted[e.Tag][e.Type] {
+						continue
+					}
+					if err := n.Notify(ctx, e); err != nil && s.OnError != nil {
+						s.OnError(fmt.Errorf("%s: %w", e.Type, err))
+					}
+				}
+			}
+		})
+	}
+
+	if s.OnError != nil {
+		sub := Subscribe[WatchError](s.Bus, SubscribeOptions{})
+		gen.tasks = append(gen.tasks, func(ctx context.Context) error {
+			defer sub.Unsubscribe()
+			for {
+				select {
+				case <-ctx.Done():
+					return nil
+				case e, ok := <-sub.C:
+					if !ok {
+						return nil
+					}
+					s.OnError(fmt.Errorf("watching %s: %w", e.Tag, e.Err))
+				}
+			}
+		})
+	}
+	return gen
+}
@@ -0,0 +1,202 @@
+package goroyale
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+)
+
+// DefaultReloadInterval is how often ReloadOnChange checks the config file when its interval is 0.
+const DefaultReloadInterval = 10 * time.Second
+
+// Reload switches a running Supervisor to cfg without touching its Client,
+// so cached responses and what's left of the ratelimit carry over.
+// Watchers are restarted with what they knew about tags that are still watched,
+// so a reload doesn't republish old battles or miss a war that started during it.
+// If cfg isn't valid the old config keeps running and the error is returned.
+func (s *Supervisor) Reload(cfg WatchConfig) error {
+	if err := cfg.Validate(); err != nil {
+		return err
+	}
+	s.mu.Lock()
+	s.Config = cfg
+	reloads := s.reloads
+	s.mu.Unlock()
+	if reloads != nil {
+		select {
+		case reloads <- struct{}{}:
+		default:
+			// a reload is already waiting and will pick up cfg
+		}
+	}
+	return nil
+}
+
+// CrawlInterval returns the CrawlInterval of the Supervisor's current config.
+// Pass it as CrawlOptions.Interval so a Crawl keeps the pace of the latest config, a reload takes effect from the next fetch.
+func (s *Supervisor) CrawlInterval() time.Duration {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return time.Duration(s.Config.CrawlInterval)
+}
+
+// ReloadFile reloads the Supervisor with the WatchConfig in the file at path.
+func (s *Supervisor) ReloadFile(path string) error {
+	cfg, err := LoadWatchConfig(path)
+	if err != nil {
+		return err
+	}
+	return s.Reload(cfg)
+}
+
+// ReloadOnSignal reloads the file at path every time the process gets one of sigs, SIGHUP if there are none,
+// until ctx is done. Failed reloads are passed to OnError.
+func (s *Supervisor) ReloadOnSignal(ctx context.Context, path string, sigs ...os.Signal) error {
+	if len(sigs) == 0 {
+		sigs = []os.Signal{syscall.SIGHUP}
+	}
+	c := make(chan os.Signal, 1)
+	signal.Notify(c, sigs...)
+	defer signal.Stop(c)
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-c:
+			s.reloadFile(path)
+		}
+	}
+}
+
+// ReloadOnChange reloads the file at path whenever its size or modification time changes,
+// checking every interval, or DefaultReloadInterval if it is 0, on the Client's Clock until ctx is done.
+// Failed reloads are passed to OnError.
+func (s *Supervisor) ReloadOnChange(ctx context.Context, path string, interval time.Duration) error {
+	if interval <= 0 {
+		interval = DefaultReloadInterval
+	}
+	var clock Clock = realClock{}
+	if s.Client != nil {
+		clock = s.Client.clock()
+	}
+	last, _ := os.Stat(path)
+	for {
+		if err := sleepContext(ctx, clock, interval); err != nil {
+			return err
+		}
+		info, err := os.Stat(path)
+		if err != nil {
+			// editors often replace the file, it'll be back on the next check
+			continue
+		}
+		if last != nil && info.Size() == last.Size() && info.ModTime().Equal(last.ModTime()) {
+			continue
+		}
+		last = info
+		s.reloadFile(path)
+	}
+}
+
+func (s *Supervisor) reloadFile(path string) {
+	if err := s.ReloadFile(path); err != nil && s.OnError != nil {
+		s.OnError(fmt.Errorf("reloading %s: %w", path, err))
+	}
+}
+
+// watchState is what a Supervisor's watchers knew when they were stopped for a reload.
+type watchState struct {
+	battles         map[string]map[string]bool
+	trophies        map[string]int
+	pushes          map[string]TrophyPush
+	wars            map[string]ClanWar
+	collectionStart map[string]time.Time
+	members         map[string]map[string]memberSeen
+	clans           map[string]Clan
+	polled          map[string]time.Time
+}
+
+// state collects what the stopped watchers know.
+func (g *supervised) state() (st watchState) {
+	for _, w := range g.battles {
+		w.mu.Lock()
+		st.battles = mergeState(st.battles, w.seen)
+		w.mu.Unlock()
+	}
+	for _, w := range g.players {
+		w.mu.Lock()
+		st.trophies = mergeState(st.trophies, w.trophies)
+		w.mu.Unlock()
+	}
+	for _, t := range g.trophies {
+		t.mu.Lock()
+		st.pushes = mergeState(st.pushes, t.pushes)
+		t.mu.Unlock()
+	}
+	for _, w := range g.wars {
+		w.mu.Lock()
+		st.wars = mergeState(st.wars, w.wars)
+		st.collectionStart = mergeState(st.collectionStart, w.collectionStart)
+		st.members = mergeState(st.members, w.members)
+		st.clans = mergeState(st.clans, w.clans)
+		st.polled = mergeState(st.polled, w.polled)
+		w.mu.Unlock()
+	}
+	return
+}
+
+// The restore methods are called before the watchers run, so they don't need to lock.
+
+func (st watchState) restoreBattles(w *BattleWatcher) {
+	w.seen = restoreState(st.battles, w.Tags)
+}
+
+func (st watchState) restorePlayers(w *PlayerWatcher) {
+	w.trophies = restoreState(st.trophies, w.Tags)
+}
+
+func (st watchState) restoreTrophies(t *TrophyTracker) {
+	t.pushes = restoreState(st.pushes, t.Tags)
+}
+
+func (st watchState) restoreWars(w *WarTracker) {
+	w.wars = restoreState(st.wars, w.Tags)
+	w.collectionStart = restoreState(st.collectionStart, w.Tags)
+	if w.wars != nil && w.collectionStart == nil {
+		// check only makes both when wars is nil
+		w.collectionStart = make(map[string]time.Time)
+	}
+	if w.TrackMembers {
+		w.members = restoreState(st.members, w.Tags)
+		w.clans = restoreState(st.clans, w.Tags)
+		w.polled = restoreState(st.polled, w.Tags)
+	}
+}
+
+func mergeState[V any](dst, src map[string]V) map[string]V {
+	if len(src) == 0 {
+		return dst
+	}
+	if dst == nil {
+		dst = make(map[string]V, len(src))
+	}
+	for k, v := range src {
+		dst[k] = v
+	}
+	return dst
+}
+
+// restoreState returns the entries of st for tags, nil if there aren't any so the watcher makes its own map.
+func restoreState[V any](st map[string]V, tags []string) (m map[string]V) {
+	for _, tag := range tags {
+		if v, ok := st[tag]; ok {
+			if m == nil {
+				m = make(map[string]V)
+			}
+			m[tag] = v
+		}
+	}
+	return
+}
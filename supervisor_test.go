@@ -0,0 +1,165 @@
+package goroyale
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestLoadWatchConfigYAML(t *testing.T) {
+	dir := t.TempDir()
+	write := func(name, data string) string {
+		path := filepath.Join(dir, name)
+		if err := os.WriteFile(path, []byte(data), 0o644); err != nil {
+			t.Fatal(err)
+		}
+		return path
+	}
+	fromJSON, err := LoadWatchConfig(write("watch.json", `{
+		"interval": "5m",
+		"clans": [{"tag": "2CCCP", "events": ["war_started", "war_ended"], "track_members": true}],
+		"players": [{"tag": "8L9L9GL", "goals": [5000, 6000], "interval": 60}],
+		"webhooks": [{"name": "clan-chat", "url": "https://discord.com/api/webhooks/1#x", "format": "discord"}],
+		"alerts": [{"events": ["war_started", "trophy_goal"], "webhooks": ["clan-chat"]}]
+	}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	fromYAML, err := LoadWatchConfig(write("watch.yaml", `# what the clan bot watches
+interval: 5m
+clans:
+  - tag: 2CCCP
+    events: [war_started, war_ended]
+    track_members: true
+players:
+- tag: "8L9L9GL"
+  goals:
+    - 5000
+    - 6000
+  interval: 60 # seconds
+webhooks:
+  - name: clan-chat
+    url: https://discord.com/api/webhooks/1#x
+    format: discord
+alerts:
+  - events: [war_started, trophy_goal]
+    webhooks: [clan-chat]
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(fromYAML, fromJSON) {
+		t.Errorf("the YAML config is %+v, the JSON one is %+v", fromYAML, fromJSON)
+	}
+	if time.Duration(fromYAML.Players[0].Interval) != time.Minute {
+		t.Errorf("the player's interval is %v, want 1m", time.Duration(fromYAML.Players[0].Interval))
+	}
+
+	// YAML configs are validated like JSON ones
+	if _, err := LoadWatchConfig(write("bad.yml", "clans:\n  - tag: 2CCCP\n    events: [war_strated]\n")); err == nil || !strings.Contains(err.Error(), "war_strated") {
+		t.Errorf("an unknown event in YAML is %v, want an error naming it", err)
+	}
+}
+
+// receive waits for a value from c.
+func receive[T any](t *testing.T, c <-chan T) (v T) {
+	t.Helper()
+	select {
+	case v = <-c:
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out")
+	}
+	return
+}
+
+func TestReloadOnChangeUsesClientClock(t *testing.T) {
+	clock := NewManualClock(testStart)
+	c, err := New("token", WithClock(clock))
+	if err != nil {
+		t.Fatal(err)
+	}
+	path := filepath.Join(t.TempDir(), "watch.json")
+	if err := os.WriteFile(path, []byte(`{"interval": "1m"}`), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	s := &Supervisor{Client: c}
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() { done <- s.ReloadOnChange(ctx, path, time.Minute) }()
+	interval := func() time.Duration {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		return time.Duration(s.Config.Interval)
+	}
+
+	waitSleepers(t, clock, 1)
+	if err := os.WriteFile(path, []byte(`{"interval": "5m", "crawl_interval": "1s"}`), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	clock.Advance(59 * time.Second)
+	if clock.Sleepers() != 1 || interval() != 0 {
+		t.Fatal("the file was checked before the interval passed on the client's clock")
+	}
+	clock.Advance(time.Second)
+	deadline := time.Now().Add(5 * time.Second)
+	for interval() != 5*time.Minute {
+		if time.Now().After(deadline) {
+			t.Fatal("the changed file wasn't reloaded once the clock reached the interval")
+		}
+		time.Sleep(time.Millisecond)
+	}
+	if s.CrawlInterval() != time.Second {
+		t.Errorf("CrawlInterval is %v after the reload, want 1s", s.CrawlInterval())
+	}
+
+	cancel()
+	if err := receive(t, done); !errors.Is(err, context.Canceled) {
+		t.Errorf("got %v, want context.Canceled", err)
+	}
+}
+
+func TestCrawlIntervalReload(t *testing.T) {
+	clock := NewManualClock(testStart)
+	s := &Supervisor{Config: WatchConfig{CrawlInterval: ConfigDuration(time.Minute)}}
+	fetched := make(chan string, 3)
+	done := make(chan error, 1)
+	go func() {
+		_, err := Crawl(context.Background(), []string{"A", "B", "C"}, func(ctx context.Context, tag string) error {
+			fetched <- tag
+			return nil
+		}, &MemoryCheckpointStore{}, CrawlOptions{Workers: 1, Interval: s.CrawlInterval, Clock: clock})
+		done <- err
+	}()
+
+	if tag := receive(t, fetched); tag != "A" {
+		t.Fatalf("fetched %s first", tag)
+	}
+	waitSleepers(t, clock, 1)
+	if err := s.Reload(WatchConfig{CrawlInterval: ConfigDuration(10 * time.Second)}); err != nil {
+		t.Fatal(err)
+	}
+	// the wait already started keeps the old interval
+	clock.Advance(time.Minute)
+	if tag := receive(t, fetched); tag != "B" {
+		t.Fatalf("fetched %s second", tag)
+	}
+	waitSleepers(t, clock, 1)
+	clock.Advance(9 * time.Second)
+	select {
+	case tag := <-fetched:
+		t.Fatalf("fetched %s before the reloaded interval passed", tag)
+	case <-time.After(20 * time.Millisecond):
+	}
+	clock.Advance(time.Second)
+	if tag := receive(t, fetched); tag != "C" {
+		t.Fatalf("fetched %s third", tag)
+	}
+	if err := receive(t, done); err != nil {
+		t.Error(err)
+	}
+}
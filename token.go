@@ -0,0 +1,61 @@
+package goroyale
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// ErrInvalidToken is returned by VerifyToken when the API rejects the client's token.
+var ErrInvalidToken = errors.New("invalid API token")
+
+// TokenInfo describes the client's token, as returned by VerifyToken.
+type TokenInfo struct {
+	Stats APIKeyStats
+	// Limit is how many requests the token can make per second, 0 if the API didn't say.
+	Limit int
+	// Remaining is how many requests were left after verifying, -1 if the API didn't say.
+	Remaining int
+	// Reset is when requests become available again if Remaining was 0, zero if the API didn't send one.
+	Reset time.Time
+}
+
+// VerifyToken makes a cheap request with the client's token so misconfigured tokens can be caught at startup.
+// If the API rejects the token the error will match ErrInvalidToken with errors.Is.
+// https://docs.royaleapi.com/#/endpoints/auth_stats
+func (c *Client) VerifyToken(ctx context.Context) (info TokenInfo, err error) {
+	buf := bufferPool.Get().(*bytes.Buffer)
+	buf.Reset()
+	defer bufferPool.Put(buf)
+
+	path := "/auth/stats"
+	resp, err := c.send(ctx, path, nil, buf)
+	if err != nil {
+		return
+	}
+	if resp.StatusCode != 200 {
+		apiErr := apiError(resp, buf.Bytes())
+		if resp.StatusCode == 401 || resp.StatusCode == 403 {
+			err = fmt.Errorf("%w: %v", ErrInvalidToken, apiErr)
+		} else {
+			err = apiErr
+		}
+		return
+	}
+	if err = c.unmarshal(buf.Bytes(), &info.Stats); err != nil {
+		return
+	}
+
+	info.Limit, _ = strconv.Atoi(resp.Header.Get("x-ratelimit-limit"))
+	info.Remaining = -1
+	if r, convErr := strconv.Atoi(resp.Header.Get("x-ratelimit-remaining")); convErr == nil {
+		info.Remaining = r
+	}
+	if sec, convErr := strconv.ParseInt(resp.Header.Get("x-ratelimit-retry-after"), 10, 64); convErr == nil {
+		info.Reset = c.now().Add(time.Duration(sec) * time.Second)
+	}
+	return
+}
@@ -0,0 +1,187 @@
+package goroyale
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// IntOrString is a value the API sends as a number for some objects and a string for others.
+type IntOrString struct {
+	Int  int    // the number, also set when Text is a number
+	Text string // set when the value was a string
+}
+
+// String returns Text if the value was a string, otherwise Int.
+func (v IntOrString) String() string {
+	if v.Text != "" {
+		return v.Text
+	}
+	return strconv.Itoa(v.Int)
+}
+
+// UnmarshalJSON accepts numbers, strings, and null.
+func (v *IntOrString) UnmarshalJSON(b []byte) error {
+	*v = IntOrString{}
+	n, s, err := decodeFlexibleInt(b)
+	if err != nil {
+		return err
+	}
+	v.Int, v.Text = n, s
+	return nil
+}
+
+// MarshalJSON writes Text if it is set, otherwise Int.
+func (v IntOrString) MarshalJSON() ([]byte, error) {
+	if v.Text != "" {
+		return json.Marshal(v.Text)
+	}
+	return json.Marshal(v.Int)
+}
+
+// FlexibleInt is a count the API sends as a JSON number in some responses and a string in others,
+// ex: Popularity.Hits. It's always a number on the Go side.
+// Value is an int64 so big counters don't overflow or lose precision on the way through a float64.
+type FlexibleInt struct {
+	Value int64
+	Raw   string // the value as the API sent it, without quotes, "" for null
+}
+
+// Int returns Value as an int.
+func (v FlexibleInt) Int() int {
+	return int(v.Value)
+}
+
+// String returns Value in base 10.
+func (v FlexibleInt) String() string {
+	return strconv.FormatInt(v.Value, 10)
+}
+
+// UnmarshalJSON accepts numbers, strings holding a number, and null.
+// Floats are rounded. A string that isn't a number is an error.
+func (v *FlexibleInt) UnmarshalJSON(b []byte) error {
+	*v = FlexibleInt{}
+	b = bytes.TrimSpace(b)
+	if len(b) == 0 || string(b) == "null" {
+		return nil
+	}
+	raw := string(b)
+	if b[0] == '"' {
+		if err := json.Unmarshal(b, &raw); err != nil {
+			return err
+		}
+	}
+	n, ok := parseFlexibleInt64(strings.TrimSpace(raw))
+	if !ok {
+		return fmt.Errorf("expected a number, got %s", b)
+	}
+	v.Value, v.Raw = n, raw
+	return nil
+}
+
+// MarshalJSON always writes Value as a number.
+func (v FlexibleInt) MarshalJSON() ([]byte, error) {
+	return []byte(v.String()), nil
+}
+
+func parseFlexibleInt64(s string) (int64, bool) {
+	if n, err := strconv.ParseInt(s, 10, 64); err == nil {
+		return n, true
+	}
+	if f, err := strconv.ParseFloat(s, 64); err == nil && f >= math.MinInt64 && f < math.MaxInt64 {
+		return int64(math.Round(f)), true
+	}
+	return 0, false
+}
+
+// FlexibleFloat is a float64 that also decodes from a string holding a number, and from null.
+// The API has sent averages as both.
+type FlexibleFloat float64
+
+// UnmarshalJSON accepts numbers, strings holding a number, and null.
+func (f *FlexibleFloat) UnmarshalJSON(b []byte) error {
+	v, err := decodeFlexibleFloat(b)
+	*f = FlexibleFloat(v)
+	return err
+}
+
+// Percent is a percentage from 0 to 100.
+// It decodes from integers, floats, strings like "45.2" or "45.2%", and null,
+// since different endpoints, and sometimes the same one, have sent each of them.
+type Percent float64
+
+// UnmarshalJSON accepts numbers, strings holding a number with or without a trailing %, and null.
+func (p *Percent) UnmarshalJSON(b []byte) error {
+	v, err := decodeFlexibleFloat(b)
+	*p = Percent(v)
+	return err
+}
+
+// Fraction returns p from 0 to 1.
+func (p Percent) Fraction() float64 {
+	return float64(p) / 100
+}
+
+// decodeFlexibleFloat reads a JSON number, a string holding one, or null.
+// Strings can end with a %, empty strings, null, and NaN are 0.
+func decodeFlexibleFloat(b []byte) (f float64, err error) {
+	b = bytes.TrimSpace(b)
+	if len(b) == 0 || string(b) == "null" {
+		return
+	}
+	if b[0] != '"' {
+		err = json.Unmarshal(b, &f)
+		return
+	}
+	var s string
+	if err = json.Unmarshal(b, &s); err != nil {
+		return
+	}
+	s = strings.TrimSpace(strings.TrimSuffix(strings.TrimSpace(s), "%"))
+	if s == "" {
+		return
+	}
+	if f, err = strconv.ParseFloat(s, 64); err != nil {
+		return 0, fmt.Errorf("expected a number, got %s", b)
+	}
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		f = 0
+	}
+	return
+}
+
+// decodeFlexibleInt reads a JSON number, string, or null.
+// Strings holding a number, ex: "12", set n as well as s. Floats are rounded.
+// Anything else, like an object, is an error.
+func decodeFlexibleInt(b []byte) (n int, s string, err error) {
+	b = bytes.TrimSpace(b)
+	if len(b) == 0 || string(b) == "null" {
+		return
+	}
+	if b[0] == '"' {
+		if err = json.Unmarshal(b, &s); err != nil {
+			return
+		}
+		n, _ = parseFlexibleInt(s)
+		return
+	}
+	var f float64
+	if err = json.Unmarshal(b, &f); err != nil {
+		return
+	}
+	n = int(math.Round(f))
+	return
+}
+
+func parseFlexibleInt(s string) (int, bool) {
+	if n, err := strconv.Atoi(s); err == nil {
+		return n, true
+	}
+	if f, err := strconv.ParseFloat(s, 64); err == nil && !math.IsInf(f, 0) && !math.IsNaN(f) {
+		return int(math.Round(f)), true
+	}
+	return 0, false
+}
@@ -0,0 +1,156 @@
+package goroyale
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"strings"
+	"testing"
+)
+
+var flexibleSeeds = []string{`12`, `-3`, `"12"`, `"12.5"`, `12.5`, `1e3`, `null`, `"Maxed"`, `""`, `" 7 "`, `{}`, `[]`, `true`, `"45.2%"`}
+
+func FuzzIntOrString(f *testing.F) {
+	for _, seed := range flexibleSeeds {
+		f.Add([]byte(seed))
+	}
+	f.Fuzz(func(t *testing.T, data []byte) {
+		var v IntOrString
+		if err := json.Unmarshal(data, &v); err != nil {
+			return
+		}
+		out, err := json.Marshal(v)
+		if err != nil {
+			t.Fatalf("MarshalJSON of %+v from %q: %v", v, data, err)
+		}
+		var again IntOrString
+		if err := json.Unmarshal(out, &again); err != nil {
+			t.Fatalf("can't decode %q, MarshalJSON of %q: %v", out, data, err)
+		}
+		if again != v {
+			t.Fatalf("%q decoded to %+v, that encoded as %q decodes to %+v", data, v, out, again)
+		}
+	})
+}
+
+func FuzzRequiredForUpgrade(f *testing.F) {
+	for _, seed := range flexibleSeeds {
+		f.Add([]byte(seed))
+	}
+	f.Fuzz(func(t *testing.T, data []byte) {
+		var r requiredForUpgrade
+		if err := json.Unmarshal(data, &r); err != nil {
+			return
+		}
+		var s string
+		if json.Unmarshal(data, &s) == nil {
+			if _, ok := parseFlexibleInt(s); s != "" && !ok && r != -1 {
+				t.Fatalf("%q isn't a number so it should be maxed (-1), got %d", data, r)
+			}
+		}
+	})
+}
+
+func FuzzPercent(f *testing.F) {
+	for _, seed := range flexibleSeeds {
+		f.Add([]byte(seed))
+	}
+	f.Fuzz(func(t *testing.T, data []byte) {
+		var p Percent
+		if err := json.Unmarshal(data, &p); err != nil {
+			return
+		}
+		if math.IsNaN(float64(p)) || math.IsInf(float64(p), 0) {
+			t.Fatalf("%q decoded to %v", data, p)
+		}
+	})
+}
+
+// percentVariant is a value the API has sent for a percent or average, from testdata/percent_variants.json.
+type percentVariant struct {
+	Field string          // the endpoint and field it was seen in
+	Value json.RawMessage // as the API sent it
+	Want  *float64        // nil if it should fail to decode
+}
+
+func readPercentVariants(t *testing.T) (variants []percentVariant) {
+	if err := json.Unmarshal(readTestdata(t, "percent_variants.json"), &variants); err != nil {
+		t.Fatal(err)
+	}
+	return
+}
+
+func TestPercentVariants(t *testing.T) {
+	for _, v := range readPercentVariants(t) {
+		t.Run(v.Field+"="+string(v.Value), func(t *testing.T) {
+			var p Percent
+			err := json.Unmarshal(v.Value, &p)
+			switch {
+			case v.Want == nil && err == nil:
+				t.Errorf("got %v, want an error", p)
+			case v.Want != nil && err != nil:
+				t.Errorf("got %v, want %v", err, *v.Want)
+			case v.Want != nil && float64(p) != *v.Want:
+				t.Errorf("got %v, want %v", p, *v.Want)
+			}
+
+			var f FlexibleFloat
+			if err := json.Unmarshal(v.Value, &f); (err == nil) != (v.Want != nil) || (err == nil && float64(f) != *v.Want) {
+				t.Errorf("FlexibleFloat got %v, %v", f, err)
+			}
+		})
+	}
+}
+
+// TestPercentFields decodes every variant in the field it was seen in, so a field that loses its tolerant type fails.
+func TestPercentFields(t *testing.T) {
+	for _, v := range readPercentVariants(t) {
+		t.Run(v.Field+"="+string(v.Value), func(t *testing.T) {
+			var got float64
+			var err error
+			name := v.Field[strings.LastIndexByte(v.Field, '.')+1:]
+			data := []byte(fmt.Sprintf(`{%q:%s}`, name, v.Value))
+			switch {
+			case strings.HasPrefix(v.Field, "/clan "):
+				var m ClanMember
+				err = json.Unmarshal(data, &m)
+				got = float64(m.DonationsPercent)
+			case strings.HasPrefix(v.Field, "/player "):
+				var g PlayerGames
+				err = json.Unmarshal(data, &g)
+				got = float64(g.WinsPercent + g.LossesPercent + g.DrawsPercent)
+			case strings.HasPrefix(v.Field, "/popular/"):
+				var p Popularity
+				err = json.Unmarshal(data, &p)
+				got = float64(p.HitsPerDayAvg)
+			default:
+				t.Fatalf("no struct for %s", v.Field)
+			}
+			if v.Want == nil {
+				if err == nil {
+					t.Errorf("got %v, want an error", got)
+				}
+				return
+			}
+			if err != nil || got != *v.Want {
+				t.Errorf("got %v, %v, want %v", got, err, *v.Want)
+			}
+		})
+	}
+}
+
+func TestPercentFraction(t *testing.T) {
+	for _, tt := range []struct {
+		p    Percent
+		want float64
+	}{
+		{0, 0},
+		{50, 0.5},
+		{12.5, 0.125},
+		{100, 1},
+	} {
+		if got := tt.p.Fraction(); got != tt.want {
+			t.Errorf("Percent(%v).Fraction() = %v, want %v", float64(tt.p), got, tt.want)
+		}
+	}
+}
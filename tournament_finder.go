@@ -0,0 +1,133 @@
+package goroyale
+
+import (
+	"sync"
+	"time"
+)
+
+// DefaultRecheckInterval is how long JoinableFinder waits before verifying a rejected tournament again.
+const DefaultRecheckInterval = 10 * time.Minute
+
+// JoinableTournaments returns the tournaments that can still be joined at now:
+// no password, free slots, and either in preparation or started no more than startedWithin ago.
+func JoinableTournaments(tournaments []Tournament, now time.Time, startedWithin time.Duration) (joinable []Tournament) {
+	for _, t := range tournaments {
+		if t.joinable(now, startedWithin) {
+			joinable = append(joinable, t)
+		}
+	}
+	return
+}
+
+func (t Tournament) joinable(now time.Time, startedWithin time.Duration) bool {
+	if !t.Open || t.SlotsFree() == 0 {
+		return false
+	}
+	switch t.PhaseAt(now) {
+	case PhasePreparation:
+		return true
+	case PhaseInProgress:
+		return now.Sub(t.Starts()) <= startedWithin
+	}
+	return false
+}
+
+// JoinableFinder polls OpenTournaments for tournaments that can be joined,
+// only returning each tournament the first time it's found.
+// The open tournaments list lags behind, so with Verify set each candidate is requested
+// to make sure it hasn't filled up or had a password added.
+type JoinableFinder struct {
+	Client *Client
+	// StartedWithin lets in-progress tournaments through if they started this recently, 0 only allows ones in preparation.
+	StartedWithin time.Duration
+	Verify        bool
+	// RecheckInterval is how long to wait before verifying a tournament that failed verification again,
+	// ex: in case its password is taken off. DefaultRecheckInterval if it is 0.
+	RecheckInterval time.Duration
+
+	mu       sync.Mutex
+	reported map[string]bool
+	rejected map[string]time.Time // when each tournament last failed verification
+}
+
+// Find returns the joinable tournaments that haven't been returned by an earlier call.
+func (f *JoinableFinder) Find() (found []Tournament, err error) {
+	open, err := f.Client.OpenTournaments(nil)
+	if err != nil {
+		return
+	}
+	now := f.Client.now()
+	recheck := f.RecheckInterval
+	if recheck == 0 {
+		recheck = DefaultRecheckInterval
+	}
+
+	f.mu.Lock()
+	if f.reported == nil {
+		f.reported = make(map[string]bool)
+		f.rejected = make(map[string]time.Time)
+	}
+	var candidates []Tournament
+	for _, t := range JoinableTournaments(open, now, f.StartedWithin) {
+		if f.reported[t.Tag] {
+			continue
+		}
+		if at, ok := f.rejected[t.Tag]; ok && now.Sub(at) < recheck {
+			continue
+		}
+		candidates = append(candidates, t)
+	}
+	f.mu.Unlock()
+
+	if f.Verify {
+		if candidates, err = f.verify(candidates, now); err != nil {
+			return
+		}
+	}
+
+	f.mu.Lock()
+	for _, t := range candidates {
+		f.reported[t.Tag] = true
+		delete(f.rejected, t.Tag)
+	}
+	f.mu.Unlock()
+	return candidates, nil
+}
+
+// verify requests the candidates and keeps the ones that are still joinable.
+func (f *JoinableFinder) verify(candidates []Tournament, now time.Time) (verified []Tournament, err error) {
+	// keep the request small, the multiple tournaments endpoint gets slow with a lot of tags
+	const batch = 10
+	for start := 0; start < len(candidates); start += batch {
+		end := start + batch
+		if end > len(candidates) {
+			end = len(candidates)
+		}
+		tags := make([]string, 0, end-start)
+		for _, t := range candidates[start:end] {
+			tags = append(tags, t.Tag)
+		}
+		var fresh []SpecificTournament
+		if fresh, err = f.Client.Tournaments(tags, nil); err != nil {
+			return
+		}
+		f.mu.Lock()
+		for _, t := range fresh {
+			if t.joinable(now, f.StartedWithin) {
+				verified = append(verified, t.Tournament)
+			} else {
+				f.rejected[t.Tag] = now
+			}
+		}
+		f.mu.Unlock()
+	}
+	return
+}
+
+// Forget lets a tournament be returned by Find again.
+func (f *JoinableFinder) Forget(tag string) {
+	f.mu.Lock()
+	delete(f.reported, tag)
+	delete(f.rejected, tag)
+	f.mu.Unlock()
+}
@@ -0,0 +1,199 @@
+package goroyale
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+)
+
+// TournamentStatusChanged is published when a managed tournament moves to a new status.
+type TournamentStatusChanged struct {
+	Tag        string
+	Old, New   string // TournamentInPreparation, TournamentInProgress, or TournamentEnded
+	Time       time.Time
+	Tournament SpecificTournament
+}
+
+// EventTag returns the tag of the tournament.
+func (e TournamentStatusChanged) EventTag() string { return e.Tag }
+
+// Event turns e into a generic Event.
+func (e TournamentStatusChanged) Event() Event {
+	return Event{Type: EventTournamentStatus, Tag: e.Tag, Time: e.Time, Summary: fmt.Sprintf("%s: %s => %s", e.Tournament.Name, e.Old, e.New), Data: e.Tournament}
+}
+
+// TournamentMemberJoined is published when a player joins a managed tournament.
+type TournamentMemberJoined struct {
+	Tag    string // the tournament
+	Member TournamentMember
+	Time   time.Time
+}
+
+// EventTag returns the tag of the tournament.
+func (e TournamentMemberJoined) EventTag() string { return e.Tag }
+
+// Event turns e into a generic Event.
+func (e TournamentMemberJoined) Event() Event {
+	return Event{Type: EventTournamentJoin, Tag: e.Tag, Time: e.Time, Summary: e.Member.Name + " joined the tournament", Data: e.Member}
+}
+
+// TournamentFinished is published once when a managed tournament ends.
+type TournamentFinished struct {
+	Tag        string
+	Time       time.Time
+	Standings  []TournamentStanding
+	Tournament SpecificTournament
+}
+
+// EventTag returns the tag of the tournament.
+func (e TournamentFinished) EventTag() string { return e.Tag }
+
+// Event turns e into a generic Event.
+func (e TournamentFinished) Event() Event {
+	summary := e.Tournament.Name + " ended"
+	if len(e.Standings) > 0 {
+		summary += ", won by " + e.Standings[0].Member.Name
+	}
+	return Event{Type: EventTournamentFinished, Tag: e.Tag, Time: e.Time, Summary: summary, Data: e.Standings}
+}
+
+// TournamentStanding is a member's place in a tournament.
+type TournamentStanding struct {
+	Rank   int // 1 is first place
+	Member TournamentMember
+	Cards  int // prize cards for the rank, 0 if there's no prize
+}
+
+// TournamentStandings ranks the members of t by score, ties share a rank.
+// Prizes are looked up in tournaments by MaxPlayers, usually from GameData.
+// Each prize covers the ranks from its own up to the next prize's.
+func TournamentStandings(t SpecificTournament, tournaments []ConstantsTournament) []TournamentStanding {
+	members := append([]TournamentMember(nil), t.Members...)
+	sort.SliceStable(members, func(i, j int) bool { return members[i].Score > members[j].Score })
+
+	var prizes []tournamentPrize
+	for _, ct := range tournaments {
+		if ct.MaxPlayers == t.MaxPlayers {
+			for _, p := range ct.Prizes {
+				prizes = append(prizes, tournamentPrize{p.Rank, p.Cards})
+			}
+			break
+		}
+	}
+	sort.Slice(prizes, func(i, j int) bool { return prizes[i].rank < prizes[j].rank })
+
+	standings := make([]TournamentStanding, len(members))
+	for i, m := range members {
+		rank := i + 1
+		if i > 0 && m.Score == members[i-1].Score {
+			rank = standings[i-1].Rank
+		}
+		standings[i] = TournamentStanding{rank, m, prizeFor(prizes, rank)}
+	}
+	return standings
+}
+
+type tournamentPrize struct {
+	rank, cards int
+}
+
+func prizeFor(prizes []tournamentPrize, rank int) int {
+	for i, p := range prizes {
+		if rank == p.rank || (rank > p.rank && i+1 < len(prizes) && rank < prizes[i+1].rank) {
+			return p.cards
+		}
+	}
+	return 0
+}
+
+// TournamentManager follows a tournament, usually one you created, from preparation until it ends.
+// It publishes TournamentStatusChanged and TournamentMemberJoined events while it runs
+// and TournamentFinished with the final standings when it ends.
+type TournamentManager struct {
+	Client   *Client
+	Bus      *EventBus
+	Tag      string
+	Interval time.Duration // DefaultWatchInterval if it is 0, tournaments are short so something like a minute works better
+
+	mu         sync.Mutex
+	tournament SpecificTournament
+	polled     bool
+	members    map[string]bool
+}
+
+// Run polls the tournament until it ends or ctx is done.
+func (m *TournamentManager) Run(ctx context.Context) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	err := poll(ctx, m.Client, m.Interval, func() {
+		if m.check() {
+			cancel()
+		}
+	})
+	if m.Ended() {
+		return nil
+	}
+	return err
+}
+
+// Tournament returns the last state of the tournament seen.
+func (m *TournamentManager) Tournament() SpecificTournament {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.tournament
+}
+
+// Ended reports whether the tournament has been seen to end.
+func (m *TournamentManager) Ended() bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.polled && m.tournament.Status == TournamentEnded
+}
+
+// Standings ranks the members as of the last poll, with prizes from GameData.
+func (m *TournamentManager) Standings() []TournamentStanding {
+	constants, _ := GameData()
+	return TournamentStandings(m.Tournament(), constants.Tournaments)
+}
+
+// check polls once and reports whether the tournament has ended.
+func (m *TournamentManager) check() bool {
+	t, err := m.Client.Tournament(m.Tag, nil)
+	if err != nil {
+		m.Bus.Publish(WatchError{m.Tag, err})
+		return false
+	}
+	now := m.Client.now()
+
+	m.mu.Lock()
+	old, polled := m.tournament, m.polled
+	m.tournament, m.polled = t, true
+	if m.members == nil {
+		m.members = make(map[string]bool)
+	}
+	var joined []TournamentMember
+	for _, member := range t.Members {
+		if !m.members[member.Tag] {
+			m.members[member.Tag] = true
+			// members there on the first poll didn't just join
+			if polled {
+				joined = append(joined, member)
+			}
+		}
+	}
+	m.mu.Unlock()
+
+	for _, member := range joined {
+		m.Bus.Publish(TournamentMemberJoined{m.Tag, member, now})
+	}
+	if polled && old.Status != t.Status {
+		m.Bus.Publish(TournamentStatusChanged{m.Tag, old.Status, t.Status, now, t})
+	}
+	if t.Status == TournamentEnded {
+		m.Bus.Publish(TournamentFinished{m.Tag, now, m.Standings(), t})
+		return true
+	}
+	return false
+}
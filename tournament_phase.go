@@ -0,0 +1,76 @@
+package goroyale
+
+import "time"
+
+// TournamentPhase is where a tournament is in its schedule, the values match the API's statuses.
+type TournamentPhase string
+
+// Tournament phases.
+const (
+	PhasePreparation TournamentPhase = TournamentInPreparation
+	PhaseInProgress  TournamentPhase = TournamentInProgress
+	PhaseEnded       TournamentPhase = TournamentEnded
+)
+
+// Starts returns when the tournament starts, or started.
+// Tournaments start on their own once their preparation time is over.
+func (t Tournament) Starts() time.Time {
+	if t.StartTime != 0 {
+		return time.Unix(int64(t.StartTime), 0)
+	}
+	return time.Unix(int64(t.CreateTime+t.PrepTime), 0)
+}
+
+// Ends returns when the tournament ends, or ended.
+func (t Tournament) Ends() time.Time {
+	if t.EndTime != 0 {
+		return time.Unix(int64(t.EndTime), 0)
+	}
+	return t.Starts().Add(time.Duration(t.Duration) * time.Second)
+}
+
+// PhaseAt returns the tournament's phase at now, worked out from its schedule.
+// An ended Status always wins since tournaments can be ended early.
+func (t Tournament) PhaseAt(now time.Time) TournamentPhase {
+	switch {
+	case t.Status == TournamentEnded || !now.Before(t.Ends()):
+		return PhaseEnded
+	case !now.Before(t.Starts()):
+		return PhaseInProgress
+	default:
+		return PhasePreparation
+	}
+}
+
+// Phase returns the tournament's phase right now.
+func (t Tournament) Phase() TournamentPhase {
+	return t.PhaseAt(time.Now())
+}
+
+// TimeUntilStart returns how long until the tournament starts, 0 if it already has.
+func (t Tournament) TimeUntilStart() time.Duration {
+	if d := time.Until(t.Starts()); d > 0 {
+		return d
+	}
+	return 0
+}
+
+// TimeRemaining returns how long until the tournament ends, 0 if it already has.
+func (t Tournament) TimeRemaining() time.Duration {
+	if t.Status == TournamentEnded {
+		return 0
+	}
+	if d := time.Until(t.Ends()); d > 0 {
+		return d
+	}
+	return 0
+}
+
+// SlotsFree returns how many more players can join the tournament.
+func (t Tournament) SlotsFree() int {
+	free := t.MaxPlayers - t.CurrentPlayers
+	if free < 0 {
+		return 0
+	}
+	return free
+}
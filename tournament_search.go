@@ -0,0 +1,91 @@
+package goroyale
+
+import (
+	"errors"
+	"net/url"
+	"sort"
+)
+
+// Tournament statuses used by the API.
+const (
+	TournamentInPreparation = "inPreparation"
+	TournamentInProgress    = "inProgress"
+	TournamentEnded         = "ended"
+)
+
+// TournamentSearchOptions holds the name to search for plus filters applied to the results.
+// The endpoint can only search by name, everything else is filtered after the response comes back.
+type TournamentSearchOptions struct {
+	Name string
+
+	// Params are added to the request as they are, ex: for field filtering.
+	Params url.Values
+
+	OnlyOpen   bool // leave out tournaments with a password
+	NotFull    bool
+	NotStarted bool // only tournaments still in preparation
+	// Joinable is shorthand for OnlyOpen, NotFull, and NotStarted.
+	Joinable bool
+
+	// SortByFreeCapacity puts tournaments with the most free slots first.
+	SortByFreeCapacity bool
+}
+
+// FreeSlots returns how many more players can join t.
+func (t SearchedTournament) FreeSlots() int {
+	free := t.MaxPlayers - t.CurrentPlayers
+	if free < 0 {
+		return 0
+	}
+	return free
+}
+
+// match reports whether t passes the filters of opts.
+func (opts TournamentSearchOptions) match(t SearchedTournament) bool {
+	if (opts.OnlyOpen || opts.Joinable) && !t.Open {
+		return false
+	}
+	if (opts.NotFull || opts.Joinable) && t.FreeSlots() == 0 {
+		return false
+	}
+	if (opts.NotStarted || opts.Joinable) && t.Status != TournamentInPreparation {
+		return false
+	}
+	return true
+}
+
+// FilterTournaments returns the tournaments that pass the filters of opts, sorted if opts asks for it.
+// opts.Name and opts.Params are ignored.
+func FilterTournaments(tournaments []SearchedTournament, opts TournamentSearchOptions) []SearchedTournament {
+	filtered := []SearchedTournament{}
+	for _, t := range tournaments {
+		if opts.match(t) {
+			filtered = append(filtered, t)
+		}
+	}
+	if opts.SortByFreeCapacity {
+		sort.SliceStable(filtered, func(i, j int) bool {
+			return filtered[i].FreeSlots() > filtered[j].FreeSlots()
+		})
+	}
+	return filtered
+}
+
+// SearchTournaments works like TournamentSearch but applies the filters in opts to the results.
+// https://docs.royaleapi.com/#/endpoints/tournaments_search
+func (c *Client) SearchTournaments(opts TournamentSearchOptions) (tournaments []SearchedTournament, err error) {
+	if opts.Name == "" {
+		err = errors.New("tournament search requires a name")
+		return
+	}
+	params := url.Values{}
+	for k, v := range opts.Params {
+		params[k] = v
+	}
+	params.Set("name", opts.Name)
+
+	if tournaments, err = c.TournamentSearch(params); err == nil {
+		tournaments = FilterTournaments(tournaments, opts)
+	}
+	return
+}
@@ -0,0 +1,52 @@
+package goroyale
+
+import (
+	"crypto/tls"
+	"net"
+	"net/http"
+	"time"
+)
+
+// TransportOptions configures the connection pooling of a Client.
+// Zero values keep the defaults of http.DefaultTransport.
+type TransportOptions struct {
+	MaxIdleConns        int
+	MaxIdleConnsPerHost int // http.DefaultTransport only keeps 2, which is far too few for a crawler
+	MaxConnsPerHost     int
+	IdleConnTimeout     time.Duration
+	DialTimeout         time.Duration
+	DisableHTTP2        bool
+}
+
+// NewTransport creates an http.Transport with the given options applied.
+func NewTransport(opts TransportOptions) *http.Transport {
+	t := http.DefaultTransport.(*http.Transport).Clone()
+	if opts.MaxIdleConns != 0 {
+		t.MaxIdleConns = opts.MaxIdleConns
+	}
+	if opts.MaxIdleConnsPerHost != 0 {
+		t.MaxIdleConnsPerHost = opts.MaxIdleConnsPerHost
+	}
+	if opts.MaxConnsPerHost != 0 {
+		t.MaxConnsPerHost = opts.MaxConnsPerHost
+	}
+	if opts.IdleConnTimeout != 0 {
+		t.IdleConnTimeout = opts.IdleConnTimeout
+	}
+	if opts.DialTimeout != 0 {
+		dialer := &net.Dialer{Timeout: opts.DialTimeout, KeepAlive: 30 * time.Second}
+		t.DialContext = dialer.DialContext
+	}
+	if opts.DisableHTTP2 {
+		t.ForceAttemptHTTP2 = false
+		// a non-nil empty map is how net/http is told not to upgrade
+		t.TLSNextProto = map[string]func(string, *tls.Conn) http.RoundTripper{}
+	}
+	return t
+}
+
+// SetTransport replaces the RoundTripper used for requests.
+// Use NewTransport to tune the default one, or pass your own.
+func (c *Client) SetTransport(rt http.RoundTripper) {
+	c.client.Transport = rt
+}
@@ -0,0 +1,164 @@
+package goroyale
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+)
+
+// PersonalBest is published when a tracked player passes their highest trophy count.
+type PersonalBest struct {
+	Tag    string
+	Name   string
+	Old    int // the previous best
+	New    int
+	Time   time.Time
+	Player Player
+}
+
+// EventTag returns the tag of the player.
+func (e PersonalBest) EventTag() string { return e.Tag }
+
+// Event turns e into a generic Event.
+func (e PersonalBest) Event() Event {
+	return Event{
+		Type:    EventPersonalBest,
+		Tag:     e.Tag,
+		Time:    e.Time,
+		Summary: fmt.Sprintf("%s: new personal best of %d trophies (was %d)", e.Name, e.New, e.Old),
+		Data:    e.Player,
+	}
+}
+
+// ArenaPromoted is published when a tracked player moves up to a higher arena.
+type ArenaPromoted struct {
+	Tag    string
+	Name   string
+	From   Arena
+	To     Arena
+	Time   time.Time
+	Player Player
+}
+
+// EventTag returns the tag of the player.
+func (e ArenaPromoted) EventTag() string { return e.Tag }
+
+// Event turns e into a generic Event.
+func (e ArenaPromoted) Event() Event {
+	return Event{
+		Type:    EventArenaPromoted,
+		Tag:     e.Tag,
+		Time:    e.Time,
+		Summary: fmt.Sprintf("%s: promoted from %s to %s", e.Name, e.From.Name, e.To.Name),
+		Data:    e.Player,
+	}
+}
+
+// TrophyGoalReached is published when a tracked player crosses one of the tracker's goals.
+type TrophyGoalReached struct {
+	Tag      string
+	Name     string
+	Goal     int
+	Trophies int
+	Time     time.Time
+	Player   Player
+}
+
+// EventTag returns the tag of the player.
+func (e TrophyGoalReached) EventTag() string { return e.Tag }
+
+// Event turns e into a generic Event.
+func (e TrophyGoalReached) Event() Event {
+	return Event{
+		Type:    EventTrophyGoal,
+		Tag:     e.Tag,
+		Time:    e.Time,
+		Summary: fmt.Sprintf("%s: crossed %d trophies (now %d)", e.Name, e.Goal, e.Trophies),
+		Data:    e.Player,
+	}
+}
+
+// TrophyTracker follows the trophy pushes of a set of players and publishes
+// PersonalBest, ArenaPromoted, and TrophyGoalReached events.
+// Nothing is published for the first poll of a player, it only sets where they started.
+type TrophyTracker struct {
+	Client   *Client
+	Bus      *EventBus
+	Tags     []string
+	Goals    []int // trophy counts to announce, ex: 4000, 5000, 6000
+	Interval time.Duration
+
+	mu     sync.Mutex
+	pushes map[string]TrophyPush
+}
+
+// TrophyPush is what a TrophyTracker knows about a player.
+type TrophyPush struct {
+	Start    int // trophies when tracking started
+	Trophies int
+	High     int // highest trophies ever, including before tracking started
+	Arena    Arena
+}
+
+// Run polls until ctx is done.
+func (t *TrophyTracker) Run(ctx context.Context) error {
+	return poll(ctx, t.Client, t.Interval, func() {
+		for _, tag := range t.Tags {
+			t.check(tag)
+		}
+	})
+}
+
+// Push returns what the tracker knows about a player.
+func (t *TrophyTracker) Push(tag string) (push TrophyPush, ok bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	push, ok = t.pushes[tag]
+	return
+}
+
+func (t *TrophyTracker) check(tag string) {
+	p, err := t.Client.Player(tag, nil)
+	if err != nil {
+		t.Bus.Publish(WatchError{tag, err})
+		return
+	}
+	now := t.Client.now()
+
+	t.mu.Lock()
+	if t.pushes == nil {
+		t.pushes = make(map[string]TrophyPush)
+	}
+	old, seen := t.pushes[tag]
+	push := old
+	if !seen {
+		push = TrophyPush{Start: p.Trophies, High: p.Stats.MaxTrophies}
+	}
+	push.Trophies = p.Trophies
+	push.Arena = p.Arena
+	if p.Trophies > push.High {
+		push.High = p.Trophies
+	}
+	t.pushes[tag] = push
+	t.mu.Unlock()
+
+	if !seen {
+		return
+	}
+	if push.High > old.High {
+		t.Bus.Publish(PersonalBest{tag, p.Name, old.High, push.High, now, p})
+	}
+	if p.Arena.ArenaID > old.Arena.ArenaID {
+		t.Bus.Publish(ArenaPromoted{tag, p.Name, old.Arena, p.Arena, now, p})
+	}
+
+	goals := append([]int(nil), t.Goals...)
+	sort.Ints(goals)
+	for _, goal := range goals {
+		if old.Trophies < goal && p.Trophies >= goal {
+			t.Bus.Publish(TrophyGoalReached{tag, p.Name, goal, p.Trophies, now, p})
+		}
+	}
+}
@@ -0,0 +1,185 @@
+package goroyale
+
+import (
+	"sync"
+	"time"
+)
+
+// DefaultUsageWindow is how far back Client.Usage counts requests unless SetUsageWindow is called.
+const DefaultUsageWindow = time.Minute
+
+// Usage is a summary of the requests a Client has made recently.
+type Usage struct {
+	Window    time.Duration  // how far back the counts go
+	Total     int            // requests made within Window
+	Endpoints map[string]int // requests made within Window by endpoint, ex: "/player"
+	Labels    map[string]int // requests made within Window by the label from Labeled, unlabeled requests aren't included
+	// Responses received within Window, and how many of them were network errors or 5xx responses.
+	Responses int
+	Failed    int
+
+	// Ratelimit info from the most recent response, 0 if the API hasn't sent it yet.
+	Limit     int
+	Remaining int
+	Reset     time.Time // when requests become available again after Remaining hit 0
+}
+
+type usageRecord struct {
+	at       time.Time
+	endpoint string
+	label    string
+}
+
+type outcomeRecord struct {
+	at     time.Time
+	failed bool
+}
+
+// usageTracker keeps a rolling window of requests and the last seen ratelimit headers.
+type usageTracker struct {
+	mu       sync.Mutex
+	window   time.Duration
+	records  []usageRecord
+	outcomes []outcomeRecord
+
+	limit      int
+	remaining  int
+	known      bool // whether remaining has been seen
+	reset      time.Time
+	observedAt time.Time
+
+	saver *rateLimitSaver
+}
+
+func (u *usageTracker) record(endpoint, label string, now time.Time) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	u.records = append(u.records, usageRecord{now, endpoint, label})
+	u.prune(now)
+}
+
+// outcome records how a request went, failed is true for network errors and 5xx responses.
+func (u *usageTracker) outcome(failed bool, now time.Time) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	u.outcomes = append(u.outcomes, outcomeRecord{now, failed})
+	u.prune(now)
+}
+
+// prune drops records older than the window, u.mu must be held.
+func (u *usageTracker) prune(now time.Time) {
+	window := u.window
+	if window == 0 {
+		window = DefaultUsageWindow
+	}
+	cutoff := now.Add(-window)
+	i := 0
+	for i < len(u.records) && u.records[i].at.Before(cutoff) {
+		i++
+	}
+	u.records = u.records[i:]
+
+	i = 0
+	for i < len(u.outcomes) && u.outcomes[i].at.Before(cutoff) {
+		i++
+	}
+	u.outcomes = u.outcomes[i:]
+}
+
+func (u *usageTracker) observe(limit, remaining int, hasRemaining bool, reset time.Time, now time.Time) {
+	u.mu.Lock()
+	if limit > 0 {
+		u.limit = limit
+	}
+	if hasRemaining {
+		u.remaining = remaining
+		u.known = true
+		u.observedAt = now
+	}
+	if !reset.IsZero() {
+		u.reset = reset
+	}
+	if u.saver != nil && (hasRemaining || !reset.IsZero()) {
+		// handed over while u.mu is held so the saver always ends up with the latest state
+		u.saver.save(RateLimitState{
+			Limit:     u.limit,
+			Remaining: u.remaining,
+			Reset:     u.reset,
+			UpdatedAt: now,
+		})
+	}
+	u.mu.Unlock()
+}
+
+func (u *usageTracker) usage(now time.Time) Usage {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	u.prune(now)
+
+	window := u.window
+	if window == 0 {
+		window = DefaultUsageWindow
+	}
+	usage := Usage{
+		Window:    window,
+		Total:     len(u.records),
+		Endpoints: make(map[string]int),
+		Labels:    make(map[string]int),
+		Limit:     u.limit,
+		Remaining: u.remaining,
+		Reset:     u.reset,
+	}
+	for _, r := range u.records {
+		usage.Endpoints[r.endpoint]++
+		if r.label != "" {
+			usage.Labels[r.label]++
+		}
+	}
+	usage.Responses = len(u.outcomes)
+	for _, o := range u.outcomes {
+		if o.failed {
+			usage.Failed++
+		}
+	}
+	return usage
+}
+
+func (u *usageTracker) estimateRemaining(now time.Time) int {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	if !u.known {
+		return -1
+	}
+	if !u.reset.IsZero() && !now.Before(u.reset) && u.limit > 0 {
+		return u.limit
+	}
+
+	// requests sent since the headers were seen haven't been counted by the API yet
+	estimate := u.remaining
+	for i := len(u.records) - 1; i >= 0 && u.records[i].at.After(u.observedAt); i-- {
+		estimate--
+	}
+	if estimate < 0 {
+		estimate = 0
+	}
+	return estimate
+}
+
+// Usage returns how many requests have been made within the usage window along with the latest ratelimit info.
+func (c *Client) Usage() Usage {
+	return c.usage.usage(c.now())
+}
+
+// EstimateRemaining guesses how many requests can be made before hitting the ratelimit.
+// It starts from the last x-ratelimit-remaining header and subtracts requests that haven't been answered yet.
+// It returns -1 if no response with ratelimit headers has been received.
+func (c *Client) EstimateRemaining() int {
+	return c.usage.estimateRemaining(c.now())
+}
+
+// SetUsageWindow changes how far back Usage counts requests.
+func (c *Client) SetUsageWindow(window time.Duration) {
+	c.usage.mu.Lock()
+	c.usage.window = window
+	c.usage.mu.Unlock()
+}
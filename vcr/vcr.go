@@ -0,0 +1,226 @@
+// Package vcr records requests made to the API and replays them later, so code using goroyale can be tested offline.
+//
+//	rec, err := vcr.New("testdata/player.json", vcr.ModeAuto)
+//	c.SetTransport(rec)
+//	// ... make requests ...
+//	err = rec.Save()
+//
+// Tokens are scrubbed from recorded requests, so cassettes are safe to commit.
+package vcr
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"sync"
+)
+
+// Mode controls whether a Recorder makes real requests.
+type Mode int
+
+const (
+	// ModeReplay only replays the cassette, requests not in it fail.
+	ModeReplay Mode = iota
+	// ModeRecord makes real requests and records all of them, replacing the cassette.
+	ModeRecord
+	// ModeAuto replays the cassette if it exists, otherwise it records one.
+	ModeAuto
+)
+
+// Redacted replaces the value of scrubbed headers.
+const Redacted = "REDACTED"
+
+// DefaultScrubHeaders are the request headers scrubbed when Recorder.ScrubHeaders is nil.
+var DefaultScrubHeaders = []string{"auth", "Authorization"}
+
+// Request is the recorded part of a request.
+type Request struct {
+	Method string      `json:"method"`
+	URL    string      `json:"url"`
+	Header http.Header `json:"header,omitempty"`
+}
+
+// Response is a recorded response.
+type Response struct {
+	StatusCode int         `json:"status_code"`
+	Header     http.Header `json:"header,omitempty"`
+	// Body is kept as JSON so cassettes are easy to read and edit,
+	// bodies that aren't valid JSON go in BodyText instead.
+	Body     json.RawMessage `json:"body,omitempty"`
+	BodyText string          `json:"body_text,omitempty"`
+}
+
+// Interaction is a request and the response it got.
+type Interaction struct {
+	Request  Request  `json:"request"`
+	Response Response `json:"response"`
+}
+
+// Cassette is the file a Recorder reads and writes.
+type Cassette struct {
+	Interactions []Interaction `json:"interactions"`
+}
+
+// Recorder is an http.RoundTripper that records or replays a cassette.
+type Recorder struct {
+	Path string
+	Mode Mode
+	// Transport makes real requests while recording, http.DefaultTransport is used if it is nil.
+	Transport http.RoundTripper
+	// ScrubHeaders are request headers that are never written to the cassette, DefaultScrubHeaders if it is nil.
+	ScrubHeaders []string
+
+	mu       sync.Mutex
+	cassette Cassette
+	replayed []bool
+}
+
+// New creates a Recorder for the cassette at path.
+// In ModeAuto the mode is resolved to ModeReplay or ModeRecord depending on whether the cassette exists.
+func New(path string, mode Mode) (*Recorder, error) {
+	r := &Recorder{Path: path, Mode: mode}
+	if mode == ModeAuto {
+		r.Mode = ModeRecord
+		if _, err := os.Stat(path); err == nil {
+			r.Mode = ModeReplay
+		}
+	}
+	if r.Mode != ModeReplay {
+		return r, nil
+	}
+
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(data, &r.cassette); err != nil {
+		return nil, fmt.Errorf("vcr: reading cassette %s: %w", path, err)
+	}
+	r.replayed = make([]bool, len(r.cassette.Interactions))
+	return r, nil
+}
+
+// RoundTrip records or replays req depending on the Recorder's mode.
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	if r.Mode == ModeReplay {
+		return r.replay(req)
+	}
+	return r.record(req)
+}
+
+// replay returns the first interaction for req that hasn't been replayed yet.
+// Repeating a request replays the responses in the order they were recorded.
+func (r *Recorder) replay(req *http.Request) (*http.Response, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for i, in := range r.cassette.Interactions {
+		if r.replayed[i] || in.Request.Method != req.Method || in.Request.URL != req.URL.String() {
+			continue
+		}
+		r.replayed[i] = true
+		return in.Response.http(req), nil
+	}
+	return nil, fmt.Errorf("vcr: no recorded response for %s %s", req.Method, req.URL)
+}
+
+func (r *Recorder) record(req *http.Request) (*http.Response, error) {
+	transport := r.Transport
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+	resp, err := transport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	body, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+	in := Interaction{
+		Request:  Request{req.Method, req.URL.String(), r.scrub(req.Header)},
+		Response: Response{StatusCode: resp.StatusCode, Header: resp.Header.Clone()},
+	}
+	if json.Valid(body) {
+		in.Response.Body = body
+	} else {
+		in.Response.BodyText = string(body)
+	}
+
+	r.mu.Lock()
+	r.cassette.Interactions = append(r.cassette.Interactions, in)
+	r.mu.Unlock()
+	return resp, nil
+}
+
+func (r *Recorder) scrub(h http.Header) http.Header {
+	h = h.Clone()
+	scrub := r.ScrubHeaders
+	if scrub == nil {
+		scrub = DefaultScrubHeaders
+	}
+	for _, name := range scrub {
+		if h.Get(name) != "" {
+			h.Set(name, Redacted)
+		}
+	}
+	return h
+}
+
+// Save writes the recorded interactions to Path.
+// It does nothing when replaying.
+func (r *Recorder) Save() error {
+	if r.Mode == ModeReplay {
+		return nil
+	}
+	r.mu.Lock()
+	data, err := json.MarshalIndent(r.cassette, "", "  ")
+	r.mu.Unlock()
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(r.Path, data, 0644)
+}
+
+// Unplayed returns the recorded interactions that haven't been replayed yet.
+func (r *Recorder) Unplayed() []Interaction {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var unplayed []Interaction
+	for i, in := range r.cassette.Interactions {
+		if i < len(r.replayed) && !r.replayed[i] {
+			unplayed = append(unplayed, in)
+		}
+	}
+	return unplayed
+}
+
+func (resp Response) http(req *http.Request) *http.Response {
+	// undo the indenting Save adds
+	var compact bytes.Buffer
+	json.Compact(&compact, resp.Body)
+	body := compact.Bytes()
+	if resp.BodyText != "" {
+		body = []byte(resp.BodyText)
+	}
+	header := resp.Header.Clone()
+	if header != nil {
+		header.Del("Content-Length")
+	}
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", resp.StatusCode, http.StatusText(resp.StatusCode)),
+		StatusCode:    resp.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          ioutil.NopCloser(bytes.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}
+}
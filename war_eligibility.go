@@ -0,0 +1,118 @@
+package goroyale
+
+import "time"
+
+// CollectionDayLength is how long a clan war's collection day lasts.
+const CollectionDayLength = 24 * time.Hour
+
+// CollectionStartTime returns when collection day started, worked out from CollectionEndTime.
+// It's zero if the API didn't send CollectionEndTime, which it doesn't once war day has started.
+func (w ClanWar) CollectionStartTime() time.Time {
+	if w.CollectionEndTime == 0 {
+		return time.Time{}
+	}
+	return time.Unix(int64(w.CollectionEndTime), 0).Add(-CollectionDayLength)
+}
+
+// memberSeen brackets when a member joined a clan between two polls.
+type memberSeen struct {
+	after  time.Time // the last poll the member wasn't in the clan, zero if they were in it on the first poll
+	before time.Time // the first poll the member was in the clan
+}
+
+// WarEligibility is whether a clan member can take part in the clan's current war.
+// Members who join a clan after collection day has started can't join that war.
+type WarEligibility struct {
+	Member      ClanMember
+	Participant bool
+	// JoinedAfter and JoinedBefore bracket when the member joined the clan, as seen by the WarTracker.
+	// JoinedAfter is zero if they were already in the clan when it was first polled.
+	JoinedAfter  time.Time
+	JoinedBefore time.Time
+	Eligible     bool
+	// Known is false when the member joined sometime between two polls that straddle the start of collection day,
+	// or the start of collection day isn't known. Eligible is true for these members.
+	Known bool
+}
+
+// trackMembers records which members are new since the clan's last poll.
+// w.mu must be held.
+func (w *WarTracker) trackMembers(tag string, clan Clan, now time.Time) {
+	if w.members == nil {
+		w.members = make(map[string]map[string]memberSeen)
+		w.clans = make(map[string]Clan)
+		w.polled = make(map[string]time.Time)
+	}
+	seen, last := w.members[tag], w.polled[tag]
+	current := make(map[string]memberSeen, len(clan.Members))
+	for _, m := range clan.Members {
+		s, ok := seen[m.Tag]
+		if !ok {
+			// on the first poll everyone has been in the clan for an unknown time
+			s = memberSeen{after: last, before: now}
+		}
+		current[m.Tag] = s
+	}
+	w.members[tag], w.clans[tag], w.polled[tag] = current, clan, now
+}
+
+// Eligibility returns whether each member of the clan can take part in its current war.
+// It needs TrackMembers to be set, ok is false until the clan has been polled with it.
+// Participants are always eligible. Outside of a war every member is eligible.
+// On war day a member who was eligible but never joined collection day still can't battle,
+// Eligible only says whether it was their join time that kept them out.
+func (w *WarTracker) Eligibility(tag string) (members []WarEligibility, ok bool) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	clan, ok := w.clans[tag]
+	if !ok {
+		return
+	}
+	war := w.wars[tag]
+	// the API's time is exact, the time the tracker saw the war change state is up to an Interval late
+	start := war.CollectionStartTime()
+	if start.IsZero() {
+		start = w.collectionStart[tag]
+	}
+
+	participants := make(map[string]bool, len(war.Participants))
+	for _, p := range war.Participants {
+		participants[p.Tag] = true
+	}
+	for _, m := range clan.Members {
+		seen := w.members[tag][m.Tag]
+		e := WarEligibility{
+			Member:       m,
+			Participant:  participants[m.Tag],
+			JoinedAfter:  seen.after,
+			JoinedBefore: seen.before,
+			Eligible:     true,
+			Known:        true,
+		}
+		switch {
+		case e.Participant || war.State == WarNotInWar || war.State == "":
+		case seen.after.IsZero() && !start.IsZero() && !seen.before.After(start):
+			// in the clan since before collection day started
+		case start.IsZero():
+			e.Known = false
+		case !seen.after.Before(start):
+			e.Eligible = false
+		case seen.before.After(start):
+			e.Known = false
+		}
+		members = append(members, e)
+	}
+	return
+}
+
+// Ineligible returns the members of the clan who joined after collection day started,
+// so can't take part in the current war.
+func (w *WarTracker) Ineligible(tag string) (members []ClanMember) {
+	eligibility, _ := w.Eligibility(tag)
+	for _, e := range eligibility {
+		if !e.Eligible {
+			members = append(members, e.Member)
+		}
+	}
+	return
+}
@@ -0,0 +1,57 @@
+package goroyale
+
+import "sort"
+
+// WarStandings are the clans fighting in a war day, as the API orders them.
+type WarStandings []ClanWarClan
+
+// IsCollectionDay reports whether w is in collection day.
+func (w ClanWar) IsCollectionDay() bool {
+	return w.State == WarCollectionDay
+}
+
+// IsWarDay reports whether w is in war day.
+func (w ClanWar) IsWarDay() bool {
+	return w.State == WarDay
+}
+
+// HasStandings reports whether w has standings, which the API only sends on war day.
+func (w ClanWar) HasStandings() bool {
+	return w.State == WarDay && len(w.Standings) > 0
+}
+
+// ByWins returns a copy of s sorted the way the game ranks war day: most wins first, then most crowns.
+func (s WarStandings) ByWins() WarStandings {
+	sorted := append(WarStandings(nil), s...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if sorted[i].Wins != sorted[j].Wins {
+			return sorted[i].Wins > sorted[j].Wins
+		}
+		return sorted[i].Crowns > sorted[j].Crowns
+	})
+	return sorted
+}
+
+// ByCrowns returns a copy of s sorted by crowns, most first, then by wins.
+func (s WarStandings) ByCrowns() WarStandings {
+	sorted := append(WarStandings(nil), s...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if sorted[i].Crowns != sorted[j].Crowns {
+			return sorted[i].Crowns > sorted[j].Crowns
+		}
+		return sorted[i].Wins > sorted[j].Wins
+	})
+	return sorted
+}
+
+// Position returns the 1 based place of the clan with tag when s is sorted ByWins.
+// ok is false if the clan isn't in s.
+func (s WarStandings) Position(tag string) (position int, ok bool) {
+	tag = NormalizeTag(tag)
+	for i, clan := range s.ByWins() {
+		if NormalizeTag(clan.Tag) == tag {
+			return i + 1, true
+		}
+	}
+	return 0, false
+}
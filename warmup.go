@@ -0,0 +1,105 @@
+package goroyale
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"time"
+)
+
+// WarmupOptions are what Client.Warmup fetches and how fast.
+type WarmupOptions struct {
+	ClanTags   []string
+	PlayerTags []string
+	// ClanWars and PlayerBattles also fetch the current war of each clan and the battle log of each player.
+	ClanWars      bool
+	PlayerBattles bool
+
+	// Interval is the average time between requests, DefaultWarmupInterval if it is 0.
+	Interval time.Duration
+	// Jitter spreads requests out by up to this fraction of Interval either way, so instances
+	// started together don't line up. 0 means no jitter, 0.5 means anywhere from half to one and a half Intervals.
+	Jitter float64
+	// After a failed request the wait doubles for each failure in a row, up to MaxBackoff.
+	// DefaultWarmupMaxBackoff if it is 0.
+	MaxBackoff time.Duration
+}
+
+// Defaults used when WarmupOptions fields are 0.
+const (
+	DefaultWarmupInterval   = 250 * time.Millisecond
+	DefaultWarmupMaxBackoff = 30 * time.Second
+)
+
+// WarmupResult counts what happened during a warmup.
+type WarmupResult struct {
+	Requests int
+	Failed   int
+	Errors   []error
+}
+
+// Warmup fills the client's cache with the clans and players in opts so the first real requests after starting are fast.
+// Requests are spaced out so a fleet of instances restarting at once doesn't hit the API all together.
+// It returns early if ctx is done, failed requests don't stop it.
+func (c *Client) Warmup(ctx context.Context, opts WarmupOptions) (result WarmupResult, err error) {
+	if c.cache == nil {
+		err = errors.New("client has no cache to warm, use SetCache first")
+		return
+	}
+	interval, maxBackoff := opts.Interval, opts.MaxBackoff
+	if interval == 0 {
+		interval = DefaultWarmupInterval
+	}
+	if maxBackoff == 0 {
+		maxBackoff = DefaultWarmupMaxBackoff
+	}
+
+	var fetches []func(*Client) error
+	for _, tag := range opts.ClanTags {
+		tag := tag
+		fetches = append(fetches, func(c *Client) error { _, err := c.Clan(tag, nil); return err })
+		if opts.ClanWars {
+			fetches = append(fetches, func(c *Client) error { _, err := c.ClanWar(tag, nil); return err })
+		}
+	}
+	for _, tag := range opts.PlayerTags {
+		tag := tag
+		fetches = append(fetches, func(c *Client) error { _, err := c.Player(tag, nil); return err })
+		if opts.PlayerBattles {
+			fetches = append(fetches, func(c *Client) error { _, err := c.PlayerBattles(tag, nil); return err })
+		}
+	}
+
+	cc := c.WithContext(ctx)
+	failures := 0
+	for i, fetch := range fetches {
+		if i > 0 {
+			wait := interval
+			for n := 0; n < failures && wait < maxBackoff; n++ {
+				wait *= 2
+			}
+			if wait > maxBackoff {
+				wait = maxBackoff
+			}
+			if opts.Jitter > 0 {
+				wait += time.Duration((rand.Float64()*2 - 1) * opts.Jitter * float64(wait))
+			}
+			if err = sleepContext(ctx, c.clock(), wait); err != nil {
+				return
+			}
+		}
+
+		result.Requests++
+		if fetchErr := fetch(cc); fetchErr != nil {
+			if err = ctx.Err(); err != nil {
+				return
+			}
+			result.Failed++
+			result.Errors = append(result.Errors, fetchErr)
+			failures++
+		} else {
+			failures = 0
+		}
+	}
+	return
+}
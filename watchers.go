@@ -0,0 +1,320 @@
+package goroyale
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+)
+
+// DefaultWatchInterval is how often watchers poll when their Interval is 0.
+const DefaultWatchInterval = 5 * time.Minute
+
+// Event types used by Event.Type.
+const (
+	EventNewBattle     = "new_battle"
+	EventTrophyChange  = "trophy_change"
+	EventWarStarted    = "war_started"
+	EventWarDayStarted = "war_day_started"
+	EventWarEnded      = "war_ended"
+	EventPersonalBest  = "personal_best"
+	EventArenaPromoted = "arena_promoted"
+	EventTrophyGoal    = "trophy_goal"
+
+	EventTournamentStatus   = "tournament_status"
+	EventTournamentJoin     = "tournament_join"
+	EventTournamentFinished = "tournament_finished"
+)
+
+// NewBattle is published when a watched player has played a battle.
+type NewBattle struct {
+	Tag    string // the watched player
+	Battle Battle
+}
+
+// EventTag returns the tag of the watched player.
+func (e NewBattle) EventTag() string { return e.Tag }
+
+// Event turns e into a generic Event.
+func (e NewBattle) Event() Event {
+	return Event{Type: EventNewBattle, Tag: e.Tag, Time: time.Unix(int64(e.Battle.UTCTime), 0), Summary: e.Battle.String(), Data: e.Battle}
+}
+
+// TrophyChange is published when a watched player's trophies change.
+type TrophyChange struct {
+	Tag    string
+	Name   string
+	Old    int
+	New    int
+	Time   time.Time
+	Player Player
+}
+
+// EventTag returns the tag of the player.
+func (e TrophyChange) EventTag() string { return e.Tag }
+
+// Event turns e into a generic Event.
+func (e TrophyChange) Event() Event {
+	return Event{
+		Type:    EventTrophyChange,
+		Tag:     e.Tag,
+		Time:    e.Time,
+		Summary: fmt.Sprintf("%s: %d => %d trophies (%+d)", e.Name, e.Old, e.New, e.New-e.Old),
+		Data:    e.Player,
+	}
+}
+
+// WarStarted is published when a watched clan enters collection day.
+type WarStarted struct {
+	ClanTag string
+	Time    time.Time
+	War     ClanWar
+}
+
+// EventTag returns the tag of the clan.
+func (e WarStarted) EventTag() string { return e.ClanTag }
+
+// Event turns e into a generic Event.
+func (e WarStarted) Event() Event {
+	return Event{Type: EventWarStarted, Tag: e.ClanTag, Time: e.Time, Summary: e.War.Clan.Name + ": collection day started", Data: e.War}
+}
+
+// WarDayStarted is published when a watched clan enters war day.
+type WarDayStarted struct {
+	ClanTag string
+	Time    time.Time
+	War     ClanWar
+}
+
+// EventTag returns the tag of the clan.
+func (e WarDayStarted) EventTag() string { return e.ClanTag }
+
+// Event turns e into a generic Event.
+func (e WarDayStarted) Event() Event {
+	return Event{Type: EventWarDayStarted, Tag: e.ClanTag, Time: e.Time, Summary: e.War.Clan.Name + ": war day started", Data: e.War}
+}
+
+// WarEnded is published when a watched clan's war day is over.
+// War is the last state of the war seen before it ended.
+type WarEnded struct {
+	ClanTag string
+	Time    time.Time
+	War     ClanWar
+}
+
+// EventTag returns the tag of the clan.
+func (e WarEnded) EventTag() string { return e.ClanTag }
+
+// Event turns e into a generic Event.
+func (e WarEnded) Event() Event {
+	return Event{Type: EventWarEnded, Tag: e.ClanTag, Time: e.Time, Summary: e.War.Clan.Name + ": war ended", Data: e.War}
+}
+
+// WatchError is published when a watcher's request fails.
+// The watcher keeps going and tries again on its next poll.
+type WatchError struct {
+	Tag string
+	Err error
+}
+
+// EventTag returns the tag that was being requested.
+func (e WatchError) EventTag() string { return e.Tag }
+
+// poll calls fn every interval on c's clock until ctx is done or c is closed, starting right away.
+// Like a time.Ticker, polls that were missed while fn ran long are dropped and the next one runs right away.
+func poll(ctx context.Context, c *Client, interval time.Duration, fn func()) error {
+	if interval <= 0 {
+		interval = DefaultWatchInterval
+	}
+	ctx, cancel := c.bound(ctx)
+	defer cancel()
+	next := c.now()
+	for {
+		fn()
+		next = next.Add(interval)
+		now := c.now()
+		if next.Before(now) {
+			next = now
+		}
+		if err := sleepContext(ctx, c.clock(), next.Sub(now)); err != nil {
+			return c.closedErr(err)
+		}
+	}
+}
+
+// BattleWatcher publishes NewBattle events for a set of players.
+// Battles already in a player's battle log when they're first polled are not published.
+type BattleWatcher struct {
+	Client   *Client
+	Bus      *EventBus
+	Tags     []string
+	Interval time.Duration
+	// Key identifies battles between polls, defaults to BattleKey.
+	Key BattleKeyFunc
+
+	mu   sync.Mutex
+	seen map[string]map[string]bool // keys of the battles in each player's last battle log
+}
+
+// Run polls until ctx is done.
+func (w *BattleWatcher) Run(ctx context.Context) error {
+	return poll(ctx, w.Client, w.Interval, func() {
+		for _, tag := range w.Tags {
+			w.check(tag)
+		}
+	})
+}
+
+func (w *BattleWatcher) check(tag string) {
+	battles, err := w.Client.PlayerBattles(tag, nil)
+	if err != nil {
+		w.Bus.Publish(WatchError{tag, err})
+		return
+	}
+
+	w.mu.Lock()
+	if w.seen == nil {
+		w.seen = make(map[string]map[string]bool)
+	}
+	last, polled := w.seen[tag]
+	keys := make(map[string]bool, len(battles))
+	var fresh []Battle
+	for _, b := range battles {
+		k := w.Key.key(b)
+		keys[k] = true
+		if polled && !last[k] {
+			fresh = append(fresh, b)
+		}
+	}
+	w.seen[tag] = keys
+	w.mu.Unlock()
+
+	// don't rely on the order of the battle log, publish oldest first
+	sort.SliceStable(fresh, func(i, j int) bool { return fresh[i].UTCTime < fresh[j].UTCTime })
+	for _, b := range fresh {
+		w.Bus.Publish(NewBattle{tag, b})
+	}
+}
+
+// PlayerWatcher publishes TrophyChange events for a set of players.
+type PlayerWatcher struct {
+	Client   *Client
+	Bus      *EventBus
+	Tags     []string
+	Interval time.Duration
+
+	mu       sync.Mutex
+	trophies map[string]int
+}
+
+// Run polls until ctx is done.
+func (w *PlayerWatcher) Run(ctx context.Context) error {
+	return poll(ctx, w.Client, w.Interval, func() {
+		for _, tag := range w.Tags {
+			w.check(tag)
+		}
+	})
+}
+
+func (w *PlayerWatcher) check(tag string) {
+	p, err := w.Client.Player(tag, nil)
+	if err != nil {
+		w.Bus.Publish(WatchError{tag, err})
+		return
+	}
+
+	w.mu.Lock()
+	if w.trophies == nil {
+		w.trophies = make(map[string]int)
+	}
+	old, seen := w.trophies[tag]
+	w.trophies[tag] = p.Trophies
+	w.mu.Unlock()
+
+	if seen && old != p.Trophies {
+		w.Bus.Publish(TrophyChange{Tag: tag, Name: p.Name, Old: old, New: p.Trophies, Time: w.Client.now(), Player: p})
+	}
+}
+
+// WarTracker follows the clan wars of a set of clans and publishes
+// WarStarted, WarDayStarted, and WarEnded events.
+type WarTracker struct {
+	Client   *Client
+	Bus      *EventBus
+	Tags     []string
+	Interval time.Duration
+	// TrackMembers also polls each clan's members so Eligibility can tell who joined after collection day started.
+	TrackMembers bool
+
+	mu              sync.Mutex
+	wars            map[string]ClanWar               // latest war seen per clan
+	collectionStart map[string]time.Time             // when each clan was seen entering collection day
+	members         map[string]map[string]memberSeen // per clan, when each member was first seen
+	clans           map[string]Clan                  // latest clan seen
+	polled          map[string]time.Time             // when each clan's members were last polled
+}
+
+// Run polls until ctx is done.
+func (w *WarTracker) Run(ctx context.Context) error {
+	return poll(ctx, w.Client, w.Interval, func() {
+		for _, tag := range w.Tags {
+			w.check(tag)
+		}
+	})
+}
+
+// War returns the latest war seen for the clan.
+func (w *WarTracker) War(tag string) (war ClanWar, ok bool) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	war, ok = w.wars[tag]
+	return
+}
+
+func (w *WarTracker) check(tag string) {
+	war, err := w.Client.ClanWar(tag, nil)
+	if err != nil {
+		w.Bus.Publish(WatchError{tag, err})
+		return
+	}
+	var clan Clan
+	if w.TrackMembers {
+		if clan, err = w.Client.Clan(tag, nil); err != nil {
+			w.Bus.Publish(WatchError{tag, err})
+		}
+	}
+	now := w.Client.now()
+
+	w.mu.Lock()
+	if w.wars == nil {
+		w.wars = make(map[string]ClanWar)
+		w.collectionStart = make(map[string]time.Time)
+	}
+	old, seen := w.wars[tag]
+	w.wars[tag] = war
+	if seen && old.State != war.State && war.State == WarCollectionDay {
+		w.collectionStart[tag] = now
+	}
+	if w.TrackMembers && err == nil {
+		w.trackMembers(tag, clan, now)
+	}
+	w.mu.Unlock()
+
+	if !seen || old.State == war.State {
+		return
+	}
+	switch war.State {
+	case WarCollectionDay:
+		if old.State == WarDay {
+			w.Bus.Publish(WarEnded{tag, now, old})
+		}
+		w.Bus.Publish(WarStarted{tag, now, war})
+	case WarDay:
+		w.Bus.Publish(WarDayStarted{tag, now, war})
+	default:
+		if old.State == WarDay {
+			w.Bus.Publish(WarEnded{tag, now, old})
+		}
+	}
+}
@@ -0,0 +1,153 @@
+package goroyale
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+var testStart = time.Date(2018, 6, 1, 12, 0, 0, 0, time.UTC)
+
+// trophyServer serves a player whose trophies go up by 10 with every request.
+func trophyServer(t *testing.T) *httptest.Server {
+	var requests atomic.Int64
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := requests.Add(1)
+		fmt.Fprintf(w, `{"tag":"2PP","name":"Bob","trophies":%d,"arena":{"arenaID":%d},"stats":{"maxTrophies":4000}}`, 4000+10*n, 10+n)
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func manualClient(t *testing.T, srv *httptest.Server) (*Client, *ManualClock) {
+	clock := NewManualClock(testStart)
+	c, err := New("token", WithBaseURL(srv.URL), WithClock(clock))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return c, clock
+}
+
+// waitSleepers waits for n calls to be waiting on clock, so Advance is sure to wake them.
+func waitSleepers(t *testing.T, clock *ManualClock, n int) {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for clock.Sleepers() < n {
+		if time.Now().After(deadline) {
+			t.Fatalf("%d sleepers, want %d", clock.Sleepers(), n)
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func TestPlayerWatcherUsesClientClock(t *testing.T) {
+	c, clock := manualClient(t, trophyServer(t))
+	bus := &EventBus{}
+	changes := Subscribe[TrophyChange](bus, SubscribeOptions{})
+	w := &PlayerWatcher{Client: c, Bus: bus, Tags: []string{"2PP"}, Interval: time.Minute}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() { done <- w.Run(ctx) }()
+
+	// the first poll only records the trophies, then the watcher waits on the clock
+	waitSleepers(t, clock, 1)
+	select {
+	case ev := <-changes.C:
+		t.Fatalf("got %+v before the clock moved", ev)
+	default:
+	}
+
+	clock.Advance(time.Minute)
+	select {
+	case ev := <-changes.C:
+		if ev.Old != 4010 || ev.New != 4020 {
+			t.Errorf("got %d -> %d, want 4010 -> 4020", ev.Old, ev.New)
+		}
+		if want := testStart.Add(time.Minute); !ev.Time.Equal(want) {
+			t.Errorf("Time is %v, want %v", ev.Time, want)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("no TrophyChange after advancing the clock")
+	}
+
+	cancel()
+	select {
+	case err := <-done:
+		if !errors.Is(err, context.Canceled) {
+			t.Errorf("Run returned %v, want context.Canceled", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("Run didn't return after ctx was cancelled")
+	}
+}
+
+func TestTrophyTrackerUsesClientClock(t *testing.T) {
+	c, clock := manualClient(t, trophyServer(t))
+	bus := &EventBus{}
+	bests := Subscribe[PersonalBest](bus, SubscribeOptions{})
+	promotions := Subscribe[ArenaPromoted](bus, SubscribeOptions{})
+	tracker := &TrophyTracker{Client: c, Bus: bus, Tags: []string{"2PP"}}
+
+	tracker.check("2PP")
+	clock.Advance(time.Hour)
+	tracker.check("2PP")
+
+	want := testStart.Add(time.Hour)
+	if best := <-bests.C; !best.Time.Equal(want) {
+		t.Errorf("PersonalBest.Time is %v, want %v", best.Time, want)
+	}
+	if promotion := <-promotions.C; !promotion.Time.Equal(want) {
+		t.Errorf("ArenaPromoted.Time is %v, want %v", promotion.Time, want)
+	}
+}
+
+func TestSleepContextManualClock(t *testing.T) {
+	clock := NewManualClock(testStart)
+
+	done := make(chan error, 1)
+	go func() { done <- sleepContext(context.Background(), clock, time.Second) }()
+	waitSleepers(t, clock, 1)
+	clock.Advance(999 * time.Millisecond)
+	select {
+	case err := <-done:
+		t.Fatalf("returned %v before the sleep was over", err)
+	default:
+	}
+	clock.Advance(time.Millisecond)
+	if err := <-done; err != nil {
+		t.Errorf("got %v, want nil", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() { done <- sleepContext(ctx, clock, time.Hour) }()
+	waitSleepers(t, clock, 1)
+	cancel()
+	if err := <-done; !errors.Is(err, context.Canceled) {
+		t.Errorf("got %v, want context.Canceled", err)
+	}
+	// the cancelled wait is still registered, advancing past it must not block
+	clock.Advance(time.Hour)
+	if n := clock.Sleepers(); n != 0 {
+		t.Errorf("%d sleepers left after advancing past every wait", n)
+	}
+}
+
+func TestScoreClanMembersAt(t *testing.T) {
+	clan := Clan{Members: []ClanMember{{Tag: "2PP", Name: "Bob"}}}
+	battles := map[string][]Battle{"2PP": {{UTCTime: int(testStart.Unix())}}}
+
+	scores := DefaultActivityWeights.ScoreClanMembersAt(clan, nil, battles, testStart)
+	if len(scores) != 1 || scores[0].Recency != 1 {
+		t.Fatalf("got %+v, want a Recency of 1 for a battle played at now", scores)
+	}
+	later := DefaultActivityWeights.ScoreClanMembersAt(clan, nil, battles, testStart.Add(DefaultActivityWeights.InactiveAfter/2))
+	if r := later[0].Recency; r <= 0 || r >= 1 {
+		t.Errorf("Recency halfway to InactiveAfter is %v, want between 0 and 1", r)
+	}
+}
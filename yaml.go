@@ -0,0 +1,431 @@
+package goroyale
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// yamlToJSON converts a YAML config file to JSON so it can be decoded like a JSON one, with the same field names.
+//
+// Only the YAML that config files need is supported: block mappings and sequences, flow ones like [a, b] and {a: 1},
+// quoted and plain scalars, and comments. Anchors, aliases, tags, block scalars (| and >),
+// and files with more than one document are errors.
+func yamlToJSON(data []byte) ([]byte, error) {
+	lines, err := yamlLines(string(data))
+	if err != nil {
+		return nil, err
+	}
+	p := &yamlParser{lines: lines}
+	var v interface{}
+	if len(lines) > 0 {
+		if v, err = p.block(lines[0].indent); err != nil {
+			return nil, err
+		}
+		if p.i < len(lines) {
+			return nil, p.errorf("expected the end of the document")
+		}
+	}
+	return json.Marshal(v)
+}
+
+type yamlLine struct {
+	num    int // 1-based
+	indent int
+	text   string // without indentation and comments
+}
+
+// yamlLines splits data into the lines that have something on them.
+func yamlLines(data string) (lines []yamlLine, err error) {
+	started := false
+	for i, text := range strings.Split(strings.ReplaceAll(data, "\r\n", "\n"), "\n") {
+		num := i + 1
+		text = strings.TrimRight(stripYAMLComment(text), " \t")
+		trimmed := strings.TrimLeft(text, " ")
+		if trimmed == "" {
+			continue
+		}
+		if strings.HasPrefix(trimmed, "\t") {
+			return nil, fmt.Errorf("line %d: indent with spaces, not tabs", num)
+		}
+		if indent := len(text) - len(trimmed); indent == 0 {
+			switch {
+			case trimmed == "---" && !started && len(lines) == 0:
+				started = true
+				continue
+			case trimmed == "---":
+				return nil, fmt.Errorf("line %d: only one YAML document is supported", num)
+			case trimmed == "...":
+				return
+			case strings.HasPrefix(trimmed, "%"):
+				return nil, fmt.Errorf("line %d: YAML directives aren't supported", num)
+			}
+		}
+		lines = append(lines, yamlLine{num, len(text) - len(trimmed), trimmed})
+	}
+	return
+}
+
+// stripYAMLComment removes a # comment, which starts a line or follows a space outside of quotes.
+func stripYAMLComment(line string) string {
+	var quote byte
+	for i := 0; i < len(line); i++ {
+		switch c := line[i]; {
+		case quote == '"' && c == '\\':
+			i++
+		case quote != 0:
+			if c == quote {
+				quote = 0
+			}
+		case c == '"' || c == '\'':
+			quote = c
+		case c == '#' && (i == 0 || line[i-1] == ' ' || line[i-1] == '\t'):
+			return line[:i]
+		}
+	}
+	return line
+}
+
+type yamlParser struct {
+	lines []yamlLine
+	i     int
+}
+
+func (p *yamlParser) errorf(format string, args ...interface{}) error {
+	num := 0
+	if p.i < len(p.lines) {
+		num = p.lines[p.i].num
+	} else if len(p.lines) > 0 {
+		num = p.lines[len(p.lines)-1].num
+	}
+	return fmt.Errorf("line %d: %s", num, fmt.Sprintf(format, args...))
+}
+
+func isYAMLItem(text string) bool {
+	return text == "-" || strings.HasPrefix(text, "- ")
+}
+
+// block parses the sequence, mapping, or single value starting at the current line, which is indented by indent.
+func (p *yamlParser) block(indent int) (interface{}, error) {
+	line := p.lines[p.i]
+	switch {
+	case isYAMLItem(line.text):
+		return p.sequence(indent)
+	case yamlKeyEnd(line.text) >= 0:
+		return p.mapping(indent)
+	}
+	v, err := p.inline()
+	if err != nil {
+		return nil, err
+	}
+	if p.i < len(p.lines) && p.lines[p.i].indent > indent {
+		return nil, p.errorf("unexpected indentation")
+	}
+	return v, nil
+}
+
+func (p *yamlParser) sequence(indent int) (interface{}, error) {
+	seq := []interface{}{}
+	for p.i < len(p.lines) && p.lines[p.i].indent == indent && isYAMLItem(p.lines[p.i].text) {
+		line := p.lines[p.i]
+		rest := strings.TrimLeft(strings.TrimPrefix(line.text, "-"), " ")
+		if rest == "" {
+			p.i++
+			v, err := p.nested(indent)
+			if err != nil {
+				return nil, err
+			}
+			seq = append(seq, v)
+			continue
+		}
+		// what follows "- " is parsed as if it started its own line, so a mapping can go on below it
+		p.lines[p.i] = yamlLine{line.num, indent + len(line.text) - len(rest), rest}
+		v, err := p.block(p.lines[p.i].indent)
+		if err != nil {
+			return nil, err
+		}
+		seq = append(seq, v)
+	}
+	return seq, nil
+}
+
+func (p *yamlParser) mapping(indent int) (interface{}, error) {
+	m := map[string]interface{}{}
+	for p.i < len(p.lines) && p.lines[p.i].indent == indent {
+		line := p.lines[p.i]
+		if isYAMLItem(line.text) {
+			return nil, p.errorf("expected a key: value, not a list item")
+		}
+		end := yamlKeyEnd(line.text)
+		if end < 0 {
+			return nil, p.errorf("expected a key: value")
+		}
+		key, err := yamlScalar(strings.TrimSpace(line.text[:end]))
+		if err != nil {
+			return nil, p.errorf("%v", err)
+		}
+		name := fmt.Sprint(key)
+		if key == nil {
+			name = ""
+		}
+		if _, ok := m[name]; ok {
+			return nil, p.errorf("%q is set twice", name)
+		}
+		rest := strings.TrimSpace(line.text[end+1:])
+		if rest == "" {
+			p.i++
+			// lists are often written at the same indentation as their key
+			if p.i < len(p.lines) && p.lines[p.i].indent == indent && isYAMLItem(p.lines[p.i].text) {
+				m[name], err = p.sequence(indent)
+			} else {
+				m[name], err = p.nested(indent)
+			}
+		} else {
+			p.lines[p.i].text = rest
+			m[name], err = p.inline()
+			if err == nil && p.i < len(p.lines) && p.lines[p.i].indent > indent {
+				err = p.errorf("unexpected indentation")
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	return m, nil
+}
+
+// nested parses the block indented under the line before, or returns nil if there isn't one.
+func (p *yamlParser) nested(parent int) (interface{}, error) {
+	if p.i >= len(p.lines) || p.lines[p.i].indent <= parent {
+		return nil, nil
+	}
+	return p.block(p.lines[p.i].indent)
+}
+
+// inline parses the value on the current line, joining the lines after it if a flow collection continues onto them.
+func (p *yamlParser) inline() (interface{}, error) {
+	text := p.lines[p.i].text
+	switch text[0] {
+	case '|', '>':
+		return nil, p.errorf("block scalars aren't supported, quote the string instead")
+	case '&', '*', '!':
+		return nil, p.errorf("anchors, aliases, and tags aren't supported")
+	}
+	start := p.i
+	p.i++
+	if text[0] != '[' && text[0] != '{' {
+		v, err := yamlScalar(text)
+		if err != nil {
+			p.i = start
+			return nil, p.errorf("%v", err)
+		}
+		return v, nil
+	}
+	for yamlFlowDepth(text) > 0 && p.i < len(p.lines) {
+		text += " " + p.lines[p.i].text
+		p.i++
+	}
+	f := &yamlFlow{s: text}
+	v, err := f.value()
+	if err == nil {
+		f.space()
+		if f.pos < len(f.s) {
+			err = fmt.Errorf("unexpected %q after the value", f.s[f.pos:])
+		}
+	}
+	if err != nil {
+		p.i = start
+		return nil, p.errorf("%v", err)
+	}
+	return v, nil
+}
+
+// yamlKeyEnd returns the index of the colon ending a mapping key in text, or -1 if text isn't a key: value.
+func yamlKeyEnd(text string) int {
+	if text[0] == '[' || text[0] == '{' {
+		return -1
+	}
+	var quote byte
+	for i := 0; i < len(text); i++ {
+		c := text[i]
+		switch {
+		case quote == '"' && c == '\\':
+			i++
+		case quote != 0:
+			if c == quote {
+				quote = 0
+			}
+		case (c == '"' || c == '\'') && i == 0:
+			quote = c
+		case c == ':' && (i == len(text)-1 || text[i+1] == ' '):
+			return i
+		}
+	}
+	return -1
+}
+
+// yamlFlowDepth is how many brackets text leaves open, outside of quotes.
+func yamlFlowDepth(text string) (depth int) {
+	var quote byte
+	for i := 0; i < len(text); i++ {
+		switch c := text[i]; {
+		case quote == '"' && c == '\\':
+			i++
+		case quote != 0:
+			if c == quote {
+				quote = 0
+			}
+		case c == '"' || c == '\'':
+			quote = c
+		case c == '[' || c == '{':
+			depth++
+		case c == ']' || c == '}':
+			depth--
+		}
+	}
+	return
+}
+
+// yamlFlow parses flow collections, ex: [a, "b", {c: 1}].
+type yamlFlow struct {
+	s   string
+	pos int
+}
+
+func (f *yamlFlow) space() {
+	for f.pos < len(f.s) && f.s[f.pos] == ' ' {
+		f.pos++
+	}
+}
+
+func (f *yamlFlow) value() (interface{}, error) {
+	f.space()
+	if f.pos >= len(f.s) {
+		return nil, fmt.Errorf("unexpected end of the value")
+	}
+	switch f.s[f.pos] {
+	case '[':
+		f.pos++
+		seq := []interface{}{}
+		return seq, f.items(']', func() error {
+			v, err := f.value()
+			seq = append(seq, v)
+			return err
+		}, &seq)
+	case '{':
+		f.pos++
+		m := map[string]interface{}{}
+		return m, f.items('}', func() error {
+			key, err := f.scalar(":,}")
+			if err != nil {
+				return err
+			}
+			name := fmt.Sprint(key)
+			if key == nil {
+				name = ""
+			}
+			f.space()
+			if f.pos >= len(f.s) || f.s[f.pos] != ':' {
+				return fmt.Errorf("expected a colon after %q", name)
+			}
+			f.pos++
+			m[name], err = f.value()
+			return err
+		}, nil)
+	}
+	return f.scalar(",]}")
+}
+
+// items parses the comma separated items of a collection up to end, seq is the sequence being filled if it is one.
+func (f *yamlFlow) items(end byte, item func() error, seq *[]interface{}) error {
+	for {
+		f.space()
+		if f.pos < len(f.s) && f.s[f.pos] == end {
+			f.pos++
+			return nil
+		}
+		if err := item(); err != nil {
+			return err
+		}
+		f.space()
+		switch {
+		case f.pos >= len(f.s):
+			return fmt.Errorf("missing %q", end)
+		case f.s[f.pos] == ',':
+			f.pos++
+		case f.s[f.pos] != end:
+			return fmt.Errorf("expected a comma or %q, found %q", end, f.s[f.pos])
+		}
+	}
+}
+
+// scalar parses a quoted scalar, or a plain one up to one of the characters in stop.
+func (f *yamlFlow) scalar(stop string) (interface{}, error) {
+	f.space()
+	start := f.pos
+	if f.pos < len(f.s) && (f.s[f.pos] == '"' || f.s[f.pos] == '\'') {
+		quote := f.s[f.pos]
+		for f.pos++; f.pos < len(f.s); f.pos++ {
+			if quote == '"' && f.s[f.pos] == '\\' {
+				f.pos++
+				continue
+			}
+			if f.s[f.pos] == quote {
+				// '' is an escaped quote in single quoted strings
+				if quote == '\'' && f.pos+1 < len(f.s) && f.s[f.pos+1] == '\'' {
+					f.pos++
+					continue
+				}
+				f.pos++
+				return yamlScalar(f.s[start:f.pos])
+			}
+		}
+		return nil, fmt.Errorf("unterminated string %s", f.s[start:])
+	}
+	for f.pos < len(f.s) && !strings.ContainsRune(stop, rune(f.s[f.pos])) {
+		f.pos++
+	}
+	return yamlScalar(strings.TrimSpace(f.s[start:f.pos]))
+}
+
+// yamlScalar resolves a scalar the way YAML's core schema does: null, booleans, numbers, or a string.
+// Numbers are json.Numbers so they keep their precision.
+func yamlScalar(s string) (interface{}, error) {
+	if s == "" {
+		return nil, nil
+	}
+	switch s[0] {
+	case '"':
+		if len(s) < 2 || s[len(s)-1] != '"' {
+			return nil, fmt.Errorf("unterminated string %s", s)
+		}
+		v, err := strconv.Unquote(s)
+		if err != nil {
+			return nil, fmt.Errorf("bad string %s", s)
+		}
+		return v, nil
+	case '\'':
+		if len(s) < 2 || s[len(s)-1] != '\'' {
+			return nil, fmt.Errorf("unterminated string %s", s)
+		}
+		return strings.ReplaceAll(s[1:len(s)-1], "''", "'"), nil
+	}
+	switch s {
+	case "~", "null", "Null", "NULL":
+		return nil, nil
+	case "true", "True", "TRUE":
+		return true, nil
+	case "false", "False", "FALSE":
+		return false, nil
+	}
+	if n, err := strconv.ParseInt(s, 10, 64); err == nil {
+		return json.Number(strconv.FormatInt(n, 10)), nil
+	}
+	if strings.ContainsAny(s[:1], "+-.0123456789") && !strings.ContainsAny(s, "xXn_") {
+		if n, err := strconv.ParseFloat(s, 64); err == nil {
+			return json.Number(strconv.FormatFloat(n, 'g', -1, 64)), nil
+		}
+	}
+	return s, nil
+}